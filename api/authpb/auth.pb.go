@@ -11,6 +11,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/golang/protobuf/proto"
+	_ "go.etcd.io/etcd/api/v3/versionpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,18 +31,23 @@ const (
 	READ      Permission_Type = 0
 	WRITE     Permission_Type = 1
 	READWRITE Permission_Type = 2
+	// ADMIN grants read and write access to the key range, and allows
+	// managing users and roles whose permissions are within the range.
+	ADMIN Permission_Type = 3
 )
 
 var Permission_Type_name = map[int32]string{
 	0: "READ",
 	1: "WRITE",
 	2: "READWRITE",
+	3: "ADMIN",
 }
 
 var Permission_Type_value = map[string]int32{
 	"READ":      0,
 	"WRITE":     1,
 	"READWRITE": 2,
+	"ADMIN":     3,
 }
 
 func (x Permission_Type) String() string {
@@ -53,7 +59,10 @@ func (Permission_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type UserAddOptions struct {
-	NoPassword           bool     `protobuf:"varint,1,opt,name=no_password,json=noPassword,proto3" json:"no_password,omitempty"`
+	NoPassword bool `protobuf:"varint,1,opt,name=no_password,json=noPassword,proto3" json:"no_password,omitempty"`
+	// added_by is the delegated admin that added the user. It is set by the
+	// server, and empty for the users added by root.
+	AddedBy              string   `protobuf:"bytes,2,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -138,12 +147,15 @@ var xxx_messageInfo_User proto.InternalMessageInfo
 
 // Permission is a single entity
 type Permission struct {
-	PermType             Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
-	Key                  []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RangeEnd             []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PermType Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
+	Key      []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// deny denies the access of permType to the key range instead of granting it.
+	// Deny permissions take precedence over the permissions granting the access.
+	Deny                 bool     `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Permission) Reset()         { *m = Permission{} }
@@ -232,30 +244,34 @@ func init() {
 func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
 
 var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xc2, 0x40,
-	0x10, 0xc6, 0xbb, 0xb4, 0x60, 0x3b, 0x08, 0x21, 0x1b, 0xa2, 0x0d, 0xc6, 0xda, 0xf4, 0xd4, 0x78,
-	0x68, 0x15, 0x0e, 0x7a, 0xc5, 0xc8, 0xc1, 0x93, 0x64, 0x83, 0x31, 0xf1, 0x42, 0x8a, 0xdd, 0xd4,
-	0x06, 0xd8, 0x6d, 0xda, 0xaa, 0xe1, 0xe2, 0x73, 0x78, 0xf0, 0x81, 0x38, 0xf2, 0x08, 0x82, 0x2f,
-	0x62, 0xba, 0xcb, 0x9f, 0x10, 0x3d, 0xed, 0x37, 0xdf, 0x7c, 0x33, 0xfb, 0xcb, 0x2e, 0x40, 0xf0,
-	0x9a, 0xbf, 0x78, 0x49, 0xca, 0x73, 0x8e, 0x2b, 0x85, 0x4e, 0x46, 0xad, 0x66, 0xc4, 0x23, 0x2e,
-	0x2c, 0xbf, 0x50, 0xb2, 0xeb, 0x5c, 0x42, 0xfd, 0x21, 0xa3, 0x69, 0x37, 0x0c, 0xef, 0x93, 0x3c,
-	0xe6, 0x2c, 0xc3, 0x67, 0x50, 0x65, 0x7c, 0x98, 0x04, 0x59, 0xf6, 0xce, 0xd3, 0xd0, 0x44, 0x36,
-	0x72, 0x75, 0x02, 0x8c, 0xf7, 0xd7, 0x8e, 0xf3, 0x01, 0x5a, 0x31, 0x82, 0x31, 0x68, 0x2c, 0x98,
-	0x52, 0x91, 0x38, 0x24, 0x42, 0xe3, 0x16, 0xe8, 0xdb, 0xc9, 0x92, 0xf0, 0xb7, 0x35, 0x6e, 0x42,
-	0x39, 0xe5, 0x13, 0x9a, 0x99, 0xaa, 0xad, 0xba, 0x06, 0x91, 0x05, 0xbe, 0x80, 0x03, 0x2e, 0x6f,
-	0x36, 0x35, 0x1b, 0xb9, 0xd5, 0xf6, 0x91, 0x27, 0x81, 0xbd, 0x7d, 0x2e, 0xb2, 0x89, 0x39, 0x5f,
-	0x08, 0xa0, 0x4f, 0xd3, 0x69, 0x9c, 0x65, 0x31, 0x67, 0xb8, 0x03, 0x7a, 0x42, 0xd3, 0xe9, 0x60,
-	0x96, 0x48, 0x94, 0x7a, 0xfb, 0x78, 0xb3, 0x61, 0x97, 0xf2, 0x8a, 0x36, 0xd9, 0x06, 0x71, 0x03,
-	0xd4, 0x31, 0x9d, 0xad, 0x11, 0x0b, 0x89, 0x4f, 0xc0, 0x48, 0x03, 0x16, 0xd1, 0x21, 0x65, 0xa1,
-	0xa9, 0x4a, 0x74, 0x61, 0xf4, 0x58, 0xe8, 0x9c, 0x83, 0x26, 0xc6, 0x74, 0xd0, 0x48, 0xaf, 0x7b,
-	0xdb, 0x50, 0xb0, 0x01, 0xe5, 0x47, 0x72, 0x37, 0xe8, 0x35, 0x10, 0xae, 0x81, 0x51, 0x98, 0xb2,
-	0x2c, 0x39, 0x03, 0xd0, 0x08, 0x9f, 0xd0, 0x7f, 0x9f, 0xe7, 0x1a, 0x6a, 0x63, 0x3a, 0xdb, 0x61,
-	0x99, 0x25, 0x5b, 0x75, 0xab, 0x6d, 0xfc, 0x17, 0x98, 0xec, 0x07, 0x6f, 0xae, 0xe6, 0x4b, 0x4b,
-	0x59, 0x2c, 0x2d, 0x65, 0xbe, 0xb2, 0xd0, 0x62, 0x65, 0xa1, 0xef, 0x95, 0x85, 0x3e, 0x7f, 0x2c,
-	0xe5, 0xe9, 0x34, 0xe2, 0x1e, 0xcd, 0x9f, 0x43, 0x2f, 0xe6, 0x7e, 0x71, 0xfa, 0x41, 0x12, 0xfb,
-	0x6f, 0x1d, 0x5f, 0xae, 0x1c, 0x55, 0xc4, 0x3f, 0x77, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x61,
-	0x5a, 0xfe, 0x48, 0x13, 0x02, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6a, 0xdb, 0x40,
+	0x14, 0xf5, 0x58, 0x72, 0x2c, 0x7d, 0x37, 0xc1, 0x7c, 0x42, 0x2b, 0x1c, 0xaa, 0x0a, 0xad, 0xb4,
+	0x92, 0x8a, 0xbd, 0x48, 0x57, 0x05, 0x9b, 0x78, 0x91, 0x45, 0xdb, 0x30, 0x38, 0x14, 0xba, 0x31,
+	0x72, 0x67, 0x70, 0x45, 0xe2, 0x99, 0x61, 0xe4, 0xb6, 0x68, 0xd3, 0x03, 0xf4, 0x04, 0xa5, 0xf7,
+	0xe8, 0x1d, 0xb2, 0x29, 0xe4, 0x08, 0x8d, 0x7b, 0x91, 0x32, 0x33, 0xb1, 0x4d, 0x68, 0x76, 0xef,
+	0xbf, 0xff, 0x78, 0xbc, 0xf7, 0xf9, 0x00, 0xe5, 0xe7, 0xf5, 0xa7, 0x5c, 0x69, 0xb9, 0x96, 0x78,
+	0x60, 0xb0, 0x5a, 0x0c, 0x8e, 0x97, 0x72, 0x29, 0x2d, 0x55, 0x18, 0xe4, 0xb6, 0x83, 0x84, 0xaf,
+	0x3f, 0xb2, 0xa2, 0x54, 0x55, 0xf1, 0x85, 0xeb, 0xba, 0x92, 0x42, 0x2d, 0xb6, 0xc8, 0x29, 0xd2,
+	0x4b, 0x38, 0xba, 0xac, 0xb9, 0x1e, 0x33, 0xf6, 0x4e, 0xad, 0x2b, 0x29, 0x6a, 0x7c, 0x01, 0x3d,
+	0x21, 0xe7, 0xaa, 0xac, 0xeb, 0xaf, 0x52, 0xb3, 0x88, 0x24, 0x24, 0x0b, 0x28, 0x08, 0x79, 0x71,
+	0xcf, 0x60, 0x0a, 0x41, 0xc9, 0x18, 0x67, 0xf3, 0x45, 0x13, 0xb5, 0x13, 0x92, 0x85, 0x93, 0xee,
+	0xf7, 0x5f, 0x91, 0x37, 0xca, 0x4f, 0x69, 0xd7, 0x2e, 0x26, 0x4d, 0xfa, 0x0d, 0x7c, 0x63, 0x8b,
+	0x08, 0xbe, 0x28, 0x57, 0xdc, 0xba, 0x3c, 0xa1, 0x16, 0xe3, 0x00, 0x82, 0x9d, 0x7b, 0xdb, 0xf2,
+	0xbb, 0x19, 0x8f, 0xa1, 0xa3, 0xe5, 0x35, 0xaf, 0x23, 0x2f, 0xf1, 0xb2, 0x90, 0xba, 0x01, 0x5f,
+	0x42, 0x57, 0xba, 0x74, 0x91, 0x9f, 0x90, 0xac, 0x37, 0x7c, 0x9a, 0xbb, 0xda, 0xf9, 0xc3, 0xec,
+	0x74, 0x2b, 0x4b, 0x7f, 0x13, 0x80, 0x0b, 0xae, 0x57, 0x55, 0x6d, 0xba, 0xe2, 0x08, 0x02, 0xc5,
+	0xf5, 0x6a, 0xd6, 0x28, 0x17, 0xe5, 0x68, 0xf8, 0x6c, 0xeb, 0xb0, 0x57, 0xe5, 0x66, 0x4d, 0x77,
+	0x42, 0xec, 0x83, 0x77, 0xc5, 0x9b, 0xfb, 0x88, 0x06, 0xe2, 0x09, 0x84, 0xba, 0x14, 0x4b, 0x3e,
+	0xe7, 0x82, 0x45, 0x9e, 0x8b, 0x6e, 0x89, 0xa9, 0x60, 0x78, 0x02, 0x3e, 0xe3, 0xa2, 0xb1, 0x09,
+	0x83, 0xfd, 0x49, 0x2c, 0x99, 0xbe, 0x06, 0xdf, 0x7a, 0x06, 0xe0, 0xd3, 0xe9, 0xf8, 0xac, 0xdf,
+	0xc2, 0x10, 0x3a, 0xef, 0xe9, 0xf9, 0x6c, 0xda, 0x27, 0x78, 0x08, 0xa1, 0x21, 0xdd, 0xd8, 0x46,
+	0x84, 0xce, 0xf8, 0xec, 0xcd, 0xf9, 0xdb, 0xbe, 0x37, 0xe8, 0xfe, 0x74, 0x2e, 0xe9, 0x0c, 0x7c,
+	0x2a, 0xaf, 0xf9, 0xa3, 0xf7, 0x7c, 0x05, 0x87, 0x57, 0xbc, 0xd9, 0xf7, 0x88, 0xda, 0x89, 0x97,
+	0xf5, 0x86, 0xf8, 0x7f, 0x43, 0xfa, 0x50, 0x38, 0x39, 0xbd, 0xb9, 0x8b, 0x5b, 0xb7, 0x77, 0x71,
+	0xeb, 0x66, 0x13, 0x93, 0xdb, 0x4d, 0x4c, 0xfe, 0x6c, 0x62, 0xf2, 0xe3, 0x6f, 0xdc, 0xfa, 0xf0,
+	0x7c, 0x29, 0x73, 0xf3, 0x3b, 0x79, 0x25, 0x8b, 0xfd, 0x0f, 0x8d, 0x0a, 0x67, 0xb9, 0x38, 0xb0,
+	0xcf, 0x33, 0xfa, 0x37, 0x00, 0x2f, 0x04, 0xd1, 0xfa, 0x8a, 0x02, 0x00, 0x00,
 }
 
 func (m *UserAddOptions) Marshal() (dAtA []byte, err error) {
@@ -282,6 +298,13 @@ func (m *UserAddOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.NoPassword {
 		i--
 		if m.NoPassword {
@@ -381,6 +404,16 @@ func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Deny {
+		i--
+		if m.Deny {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RangeEnd) > 0 {
 		i -= len(m.RangeEnd)
 		copy(dAtA[i:], m.RangeEnd)
@@ -471,6 +504,10 @@ func (m *UserAddOptions) Size() (n int) {
 	if m.NoPassword {
 		n += 2
 	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -524,6 +561,9 @@ func (m *Permission) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if m.Deny {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -607,6 +647,38 @@ func (m *UserAddOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoPassword = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -932,6 +1004,26 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				m.RangeEnd = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deny = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
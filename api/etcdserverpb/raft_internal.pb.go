@@ -31,7 +31,13 @@ type RequestHeader struct {
 	// username is a username that is associated with an auth token of gRPC connection
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// auth_revision is a revision number of auth.authStore. It is not related to mvcc
-	AuthRevision         uint64   `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	AuthRevision uint64 `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	// timestamp is the time the request was proposed, in unix nanoseconds. It
+	// allows to replay the WAL up to a point in time.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// roles are the etcd roles granted by the claims of an externally issued
+	// auth token, in addition to the roles of the user.
+	Roles                []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -85,6 +91,8 @@ type InternalRaftRequest struct {
 	LeaseRevoke              *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
 	Alarm                    *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
 	LeaseCheckpoint          *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
+	PrefixQuota              *PrefixQuotaRequest                       `protobuf:"bytes,12,opt,name=prefix_quota,json=prefixQuota,proto3" json:"prefix_quota,omitempty"`
+	KeyExpire                *KeyExpireRequest                         `protobuf:"bytes,13,opt,name=key_expire,json=keyExpire,proto3" json:"key_expire,omitempty"`
 	AuthEnable               *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
 	AuthStatus               *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
@@ -239,76 +247,82 @@ func init() {
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcb, 0x72, 0x1b, 0x45,
-	0x14, 0x8d, 0x6c, 0xc7, 0xb6, 0x5a, 0xb6, 0xe3, 0xb4, 0x9d, 0xa4, 0xb1, 0xab, 0x8c, 0xe3, 0x90,
-	0x60, 0x20, 0xc8, 0xc1, 0x06, 0xaa, 0x60, 0x03, 0x8a, 0xe5, 0x72, 0x4c, 0x25, 0x29, 0xd7, 0xc4,
-	0x50, 0x29, 0x28, 0x6a, 0x68, 0xcd, 0x5c, 0x4b, 0x13, 0x8f, 0x66, 0x86, 0xee, 0x96, 0xe2, 0x6c,
-	0x59, 0xb2, 0x06, 0x8a, 0x8f, 0x60, 0xc1, 0x2b, 0xff, 0x90, 0x05, 0x8f, 0x00, 0x3f, 0x00, 0x66,
-	0xc3, 0x1e, 0xd8, 0xa7, 0xfa, 0x31, 0x2f, 0xa9, 0xe5, 0xdd, 0xe8, 0xde, 0x73, 0xcf, 0x39, 0xdd,
-	0x7d, 0xbb, 0x75, 0xd1, 0x02, 0xa3, 0x87, 0xc2, 0x0d, 0x22, 0x01, 0x2c, 0xa2, 0x61, 0x3d, 0x61,
-	0xb1, 0x88, 0xf1, 0x0c, 0x08, 0xcf, 0xe7, 0xc0, 0xfa, 0xc0, 0x92, 0xd6, 0xd2, 0x62, 0x3b, 0x6e,
-	0xc7, 0x2a, 0xb1, 0x21, 0xbf, 0x34, 0x66, 0x69, 0x3e, 0xc7, 0x98, 0x48, 0x95, 0x25, 0x9e, 0xf9,
-	0x5c, 0x95, 0xc9, 0x0d, 0x9a, 0x04, 0x1b, 0x7d, 0x60, 0x3c, 0x88, 0xa3, 0xa4, 0x95, 0x7e, 0x19,
-	0xc4, 0xb5, 0x0c, 0xd1, 0x85, 0x6e, 0x0b, 0x18, 0xef, 0x04, 0x49, 0xd2, 0x2a, 0xfc, 0xd0, 0xb8,
-	0x35, 0x86, 0x66, 0x1d, 0xf8, 0xb4, 0x07, 0x5c, 0xdc, 0x02, 0xea, 0x03, 0xc3, 0x73, 0x68, 0x6c,
-	0xaf, 0x49, 0x2a, 0xab, 0x95, 0xf5, 0x09, 0x67, 0x6c, 0xaf, 0x89, 0x97, 0xd0, 0x74, 0x8f, 0x4b,
-	0xf3, 0x5d, 0x20, 0x63, 0xab, 0x95, 0xf5, 0xaa, 0x93, 0xfd, 0xc6, 0xd7, 0xd1, 0x2c, 0xed, 0x89,
-	0x8e, 0xcb, 0xa0, 0x1f, 0x48, 0x6d, 0x32, 0x2e, 0xcb, 0x6e, 0x4e, 0x7d, 0xfe, 0x98, 0x8c, 0x6f,
-	0xd5, 0x5f, 0x73, 0x66, 0x64, 0xd6, 0x31, 0xc9, 0xb7, 0xa7, 0x3e, 0x53, 0xe1, 0x1b, 0x6b, 0x8f,
-	0x17, 0xd0, 0xc2, 0x9e, 0xd9, 0x11, 0x87, 0x1e, 0x0a, 0x63, 0x00, 0x6f, 0xa1, 0xc9, 0x8e, 0x32,
-	0x41, 0xfc, 0xd5, 0xca, 0x7a, 0x6d, 0x73, 0xb9, 0x5e, 0xdc, 0xa7, 0x7a, 0xc9, 0xa7, 0x63, 0xa0,
-	0x43, 0x7e, 0xaf, 0xa2, 0xb1, 0xfe, 0xa6, 0x72, 0x5a, 0xdb, 0xbc, 0x60, 0x25, 0x70, 0xc6, 0xfa,
-	0x9b, 0xf8, 0x06, 0x3a, 0xcb, 0x68, 0xd4, 0x06, 0x65, 0xb9, 0xb6, 0xb9, 0x34, 0x80, 0x94, 0xa9,
-	0x14, 0xae, 0x81, 0xf8, 0x65, 0x34, 0x9e, 0xf4, 0x04, 0x99, 0x50, 0x78, 0x52, 0xc6, 0xef, 0xf7,
-	0xd2, 0x45, 0x38, 0x12, 0x84, 0xb7, 0xd1, 0x8c, 0x0f, 0x21, 0x08, 0x70, 0xb5, 0xc8, 0x59, 0x55,
-	0xb4, 0x5a, 0x2e, 0x6a, 0x2a, 0x44, 0x49, 0xaa, 0xe6, 0xe7, 0x31, 0x29, 0x28, 0x8e, 0x23, 0x32,
-	0x69, 0x13, 0x3c, 0x38, 0x8e, 0x32, 0x41, 0x71, 0x1c, 0xe1, 0x77, 0x10, 0xf2, 0xe2, 0x6e, 0x42,
-	0x3d, 0x21, 0x8f, 0x61, 0x4a, 0x95, 0x3c, 0x5f, 0x2e, 0xd9, 0xce, 0xf2, 0x69, 0x65, 0xa1, 0x04,
-	0xbf, 0x8b, 0x6a, 0x21, 0x50, 0x0e, 0x6e, 0x9b, 0xd1, 0x48, 0x90, 0x69, 0x1b, 0xc3, 0x6d, 0x09,
-	0xd8, 0x95, 0xf9, 0x8c, 0x21, 0xcc, 0x42, 0x72, 0xcd, 0x9a, 0x81, 0x41, 0x3f, 0x3e, 0x02, 0x52,
-	0xb5, 0xad, 0x59, 0x51, 0x38, 0x0a, 0x90, 0xad, 0x39, 0xcc, 0x63, 0xf2, 0x58, 0x68, 0x48, 0x59,
-	0x97, 0x20, 0xdb, 0xb1, 0x34, 0x64, 0x2a, 0x3b, 0x16, 0x05, 0xc4, 0xf7, 0xd1, 0xbc, 0x96, 0xf5,
-	0x3a, 0xe0, 0x1d, 0x25, 0x71, 0x10, 0x09, 0x52, 0x53, 0xc5, 0x2f, 0x58, 0xa4, 0xb7, 0x33, 0x90,
-	0xa1, 0x49, 0x9b, 0xf5, 0x75, 0xe7, 0x5c, 0x58, 0x06, 0xe0, 0x06, 0xaa, 0xa9, 0xee, 0x86, 0x88,
-	0xb6, 0x42, 0x20, 0xff, 0x58, 0x77, 0xb5, 0xd1, 0x13, 0x9d, 0x1d, 0x05, 0xc8, 0xf6, 0x84, 0x66,
-	0x21, 0xdc, 0x44, 0xea, 0x0a, 0xb8, 0x7e, 0xc0, 0x15, 0xc7, 0xbf, 0x53, 0xb6, 0x4d, 0x91, 0x1c,
-	0x4d, 0x8d, 0xc8, 0x36, 0x85, 0xe6, 0x31, 0xfc, 0x9e, 0x31, 0xc2, 0x05, 0x15, 0x3d, 0x4e, 0xfe,
-	0x1f, 0x69, 0xe4, 0x9e, 0x02, 0x0c, 0xac, 0xec, 0x0d, 0xed, 0x48, 0xe7, 0xf0, 0x5d, 0xed, 0x08,
-	0x22, 0x11, 0x78, 0x54, 0x00, 0xf9, 0x4f, 0x93, 0xbd, 0x54, 0x26, 0x4b, 0x6f, 0x67, 0xa3, 0x00,
-	0x4d, 0xad, 0x95, 0xea, 0xf1, 0x8e, 0x79, 0x02, 0xe4, 0x9b, 0xe0, 0x52, 0xdf, 0x27, 0x3f, 0x4d,
-	0x8f, 0x5a, 0xe2, 0xfb, 0x1c, 0x58, 0xc3, 0xf7, 0x4b, 0x4b, 0x34, 0x31, 0x7c, 0x17, 0xcd, 0xe7,
-	0x34, 0xfa, 0x12, 0x90, 0x9f, 0x35, 0xd3, 0x15, 0x3b, 0x93, 0xb9, 0x3d, 0x86, 0x6c, 0x8e, 0x96,
-	0xc2, 0x65, 0x5b, 0x6d, 0x10, 0xe4, 0x97, 0x53, 0x6d, 0xed, 0x82, 0x18, 0xb2, 0xb5, 0x0b, 0x02,
-	0xb7, 0xd1, 0x73, 0x39, 0x8d, 0xd7, 0x91, 0xd7, 0xd2, 0x4d, 0x28, 0xe7, 0x0f, 0x63, 0xe6, 0x93,
-	0x5f, 0x35, 0xe5, 0x2b, 0x76, 0xca, 0x6d, 0x85, 0xde, 0x37, 0xe0, 0x94, 0xfd, 0x22, 0xb5, 0xa6,
-	0xf1, 0x7d, 0xb4, 0x58, 0xf0, 0x2b, 0xef, 0x93, 0xcb, 0xe2, 0x10, 0xc8, 0x53, 0xad, 0x71, 0x6d,
-	0x84, 0x6d, 0x75, 0x17, 0xe3, 0xbc, 0x6d, 0xce, 0xd3, 0xc1, 0x0c, 0xfe, 0x08, 0x5d, 0xc8, 0x99,
-	0xf5, 0xd5, 0xd4, 0xd4, 0xbf, 0x69, 0xea, 0x17, 0xed, 0xd4, 0xe6, 0x8e, 0x16, 0xb8, 0x31, 0x1d,
-	0x4a, 0xe1, 0x5b, 0x68, 0x2e, 0x27, 0x0f, 0x03, 0x2e, 0xc8, 0xef, 0x9a, 0xf5, 0xb2, 0x9d, 0xf5,
-	0x76, 0xc0, 0x45, 0xa9, 0x8f, 0xd2, 0x60, 0xc6, 0x24, 0xad, 0x69, 0xa6, 0x3f, 0x46, 0x32, 0x49,
-	0xe9, 0x21, 0xa6, 0x34, 0x98, 0x1d, 0xbd, 0x62, 0x92, 0x1d, 0xf9, 0x6d, 0x75, 0xd4, 0xd1, 0xcb,
-	0x9a, 0xc1, 0x8e, 0x34, 0xb1, 0xac, 0x23, 0x15, 0x8d, 0xe9, 0xc8, 0xef, 0xaa, 0xa3, 0x3a, 0x52,
-	0x56, 0x59, 0x3a, 0x32, 0x0f, 0x97, 0x6d, 0xc9, 0x8e, 0xfc, 0xfe, 0x54, 0x5b, 0x83, 0x1d, 0x69,
-	0x62, 0xf8, 0x01, 0x5a, 0x2a, 0xd0, 0xa8, 0x46, 0x49, 0x80, 0x75, 0x03, 0xae, 0xfe, 0x7f, 0x7f,
-	0xd0, 0x9c, 0xd7, 0x47, 0x70, 0x4a, 0xf8, 0x7e, 0x86, 0x4e, 0xf9, 0x2f, 0x51, 0x7b, 0x1e, 0x77,
-	0xd1, 0x72, 0xae, 0x65, 0x5a, 0xa7, 0x20, 0xf6, 0xa3, 0x16, 0x7b, 0xd5, 0x2e, 0xa6, 0xbb, 0x64,
-	0x58, 0x8d, 0xd0, 0x11, 0x00, 0xfc, 0x09, 0x5a, 0xf0, 0xc2, 0x1e, 0x17, 0xc0, 0x5c, 0x33, 0xcb,
-	0xb8, 0x1c, 0x04, 0xf9, 0x02, 0x99, 0x2b, 0x50, 0x1c, 0x64, 0xea, 0xdb, 0x1a, 0xf9, 0x81, 0x06,
-	0xde, 0x03, 0x31, 0xf4, 0xea, 0x9d, 0xf7, 0x06, 0x21, 0xf8, 0x01, 0xba, 0x94, 0x2a, 0x68, 0x32,
-	0x97, 0x0a, 0xc1, 0x94, 0xca, 0x97, 0xc8, 0xbc, 0x83, 0x36, 0x95, 0x3b, 0x2a, 0xd6, 0x10, 0x82,
-	0xd9, 0x84, 0x16, 0x3d, 0x0b, 0x0a, 0x7f, 0x8c, 0xb0, 0x1f, 0x3f, 0x8c, 0xda, 0x8c, 0xfa, 0xe0,
-	0x06, 0xd1, 0x61, 0xac, 0x64, 0xbe, 0xd2, 0x32, 0x57, 0xcb, 0x32, 0xcd, 0x14, 0xb8, 0x17, 0x1d,
-	0xc6, 0x36, 0x89, 0x79, 0x7f, 0x00, 0x81, 0x03, 0x74, 0x31, 0xa7, 0x4f, 0xb7, 0x4b, 0x00, 0x17,
-	0xe4, 0x9b, 0x3b, 0xb6, 0x17, 0x3d, 0x93, 0x30, 0xdb, 0x71, 0x00, 0x7c, 0x50, 0xe6, 0x4d, 0x67,
-	0xd1, 0xb7, 0xa0, 0xf2, 0xb9, 0xed, 0x1c, 0x9a, 0xdd, 0xe9, 0x26, 0xe2, 0x91, 0x03, 0x3c, 0x89,
-	0x23, 0x0e, 0x6b, 0x8f, 0xd0, 0xf2, 0x29, 0xff, 0x14, 0x18, 0xa3, 0x09, 0x35, 0x36, 0x56, 0xd4,
-	0xd8, 0xa8, 0xbe, 0xe5, 0x38, 0x99, 0x3d, 0xa0, 0x66, 0x9c, 0x4c, 0x7f, 0xe3, 0xcb, 0x68, 0x86,
-	0x07, 0xdd, 0x24, 0x04, 0x57, 0xc4, 0x47, 0xa0, 0xa7, 0xc9, 0xaa, 0x53, 0xd3, 0xb1, 0x03, 0x19,
-	0xca, 0xbc, 0xdc, 0x7c, 0xeb, 0xc9, 0x5f, 0x2b, 0x67, 0x9e, 0x9c, 0xac, 0x54, 0x9e, 0x9e, 0xac,
-	0x54, 0xfe, 0x3c, 0x59, 0xa9, 0x7c, 0xfd, 0xf7, 0xca, 0x99, 0x0f, 0xaf, 0xb4, 0x63, 0xb5, 0xec,
-	0x7a, 0x10, 0x6f, 0xe4, 0x23, 0xf2, 0xd6, 0x46, 0x71, 0x2b, 0x5a, 0x93, 0x6a, 0xf2, 0xdd, 0x7a,
-	0x16, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xa0, 0x15, 0x1f, 0x9b, 0x0b, 0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0x5b, 0x73, 0x1b, 0x35,
+	0x14, 0xc7, 0xeb, 0xdc, 0x2d, 0x3b, 0x69, 0xaa, 0xa4, 0xad, 0x48, 0x06, 0xe3, 0xa6, 0xa4, 0x04,
+	0x28, 0x4e, 0x71, 0x80, 0x0e, 0xbc, 0x80, 0x1b, 0x87, 0x34, 0x90, 0x76, 0xc2, 0x36, 0x30, 0x1d,
+	0x18, 0x66, 0x91, 0xbd, 0x27, 0xf6, 0x36, 0xeb, 0xdd, 0xad, 0x24, 0xbb, 0xc9, 0x2b, 0x8f, 0x3c,
+	0x03, 0xc3, 0x87, 0xe0, 0x81, 0xeb, 0x0c, 0x1f, 0xa1, 0x33, 0xdc, 0x0a, 0x7c, 0x01, 0x08, 0x2f,
+	0xbc, 0x03, 0xef, 0x1d, 0x49, 0x7b, 0xf1, 0xda, 0x72, 0xde, 0xbc, 0xe7, 0xfc, 0xf5, 0xfb, 0x9f,
+	0xb3, 0x3a, 0x2b, 0x0b, 0x2d, 0x30, 0x7a, 0x20, 0x6c, 0xd7, 0x17, 0xc0, 0x7c, 0xea, 0x55, 0x42,
+	0x16, 0x88, 0x00, 0x17, 0x41, 0x34, 0x1d, 0x0e, 0xac, 0x07, 0x2c, 0x6c, 0x2c, 0x2d, 0xb6, 0x82,
+	0x56, 0xa0, 0x12, 0xeb, 0xf2, 0x97, 0xd6, 0x2c, 0xcd, 0xa7, 0x9a, 0x28, 0x92, 0x67, 0x61, 0x33,
+	0xfa, 0x59, 0x96, 0xc9, 0x75, 0x1a, 0xba, 0xeb, 0x3d, 0x60, 0xdc, 0x0d, 0xfc, 0xb0, 0x11, 0xff,
+	0x8a, 0x14, 0x57, 0x12, 0x45, 0x07, 0x3a, 0x0d, 0x60, 0xbc, 0xed, 0x86, 0x61, 0xa3, 0xef, 0x41,
+	0xeb, 0x56, 0x7e, 0xc8, 0xa1, 0x59, 0x0b, 0xee, 0x77, 0x81, 0x8b, 0x9b, 0x40, 0x1d, 0x60, 0x78,
+	0x0e, 0x8d, 0xed, 0xd4, 0x49, 0xae, 0x9c, 0x5b, 0x9b, 0xb0, 0xc6, 0x76, 0xea, 0x78, 0x09, 0xcd,
+	0x74, 0xb9, 0xac, 0xbe, 0x03, 0x64, 0xac, 0x9c, 0x5b, 0xcb, 0x5b, 0xc9, 0x33, 0xbe, 0x8a, 0x66,
+	0x69, 0x57, 0xb4, 0x6d, 0x06, 0x3d, 0x57, 0x9a, 0x93, 0x71, 0xb9, 0xec, 0xc6, 0xf4, 0x27, 0xdf,
+	0x93, 0xf1, 0x8d, 0xca, 0x8b, 0x56, 0x51, 0x66, 0xad, 0x28, 0x89, 0x57, 0x51, 0x5e, 0xb8, 0x1d,
+	0xe0, 0x82, 0x76, 0x42, 0x32, 0x51, 0xce, 0xad, 0x8d, 0xc7, 0xca, 0xeb, 0x56, 0x9a, 0xc1, 0x4f,
+	0xa2, 0x49, 0x16, 0x78, 0xc0, 0xc9, 0x64, 0x79, 0x7c, 0x2d, 0x9f, 0x4a, 0x74, 0xf4, 0xb5, 0xe9,
+	0x8f, 0xd5, 0xf3, 0xb5, 0x95, 0x1f, 0x17, 0xd1, 0xc2, 0x4e, 0xf4, 0x62, 0x2d, 0x7a, 0x20, 0xa2,
+	0x36, 0xf0, 0x06, 0x9a, 0x6a, 0xab, 0x56, 0x88, 0x53, 0xce, 0xad, 0x15, 0xaa, 0xcb, 0x95, 0xfe,
+	0xd7, 0x5d, 0xc9, 0x74, 0x6b, 0x4d, 0xb5, 0xcd, 0x5d, 0xaf, 0xa2, 0xb1, 0x5e, 0x55, 0xf5, 0x5b,
+	0xa8, 0x9e, 0x37, 0x02, 0xac, 0xb1, 0x5e, 0x15, 0x5f, 0x43, 0x93, 0x8c, 0xfa, 0x2d, 0x50, 0x8d,
+	0x17, 0xaa, 0x4b, 0x03, 0x4a, 0x99, 0x8a, 0xe5, 0x5a, 0x88, 0x9f, 0x43, 0xe3, 0x61, 0x57, 0xa8,
+	0xf6, 0x0b, 0x55, 0x92, 0xd5, 0xef, 0x75, 0xe3, 0x26, 0x2c, 0x29, 0xc2, 0x9b, 0xa8, 0xe8, 0x80,
+	0x07, 0x02, 0x6c, 0x6d, 0x32, 0xa9, 0x16, 0x95, 0xb3, 0x8b, 0xea, 0x4a, 0x91, 0xb1, 0x2a, 0x38,
+	0x69, 0x4c, 0x1a, 0x8a, 0x23, 0x9f, 0x4c, 0x99, 0x0c, 0xf7, 0x8f, 0xfc, 0xc4, 0x50, 0x1c, 0xf9,
+	0xf8, 0x75, 0x84, 0x9a, 0x41, 0x27, 0xa4, 0x4d, 0x21, 0x37, 0x73, 0x5a, 0x2d, 0x79, 0x2a, 0xbb,
+	0x64, 0x33, 0xc9, 0xc7, 0x2b, 0xfb, 0x96, 0xe0, 0x37, 0x50, 0xc1, 0x03, 0xca, 0xc1, 0x6e, 0x31,
+	0xea, 0x0b, 0x32, 0x63, 0x22, 0xec, 0x4a, 0xc1, 0xb6, 0xcc, 0x27, 0x04, 0x2f, 0x09, 0xc9, 0x9e,
+	0x35, 0x81, 0x41, 0x2f, 0x38, 0x04, 0x92, 0x37, 0xf5, 0xac, 0x10, 0x96, 0x12, 0x24, 0x3d, 0x7b,
+	0x69, 0x4c, 0x6e, 0x0b, 0xf5, 0x28, 0xeb, 0x10, 0x64, 0xda, 0x96, 0x9a, 0x4c, 0x25, 0xdb, 0xa2,
+	0x84, 0xf8, 0x2e, 0x9a, 0xd7, 0xb6, 0xcd, 0x36, 0x34, 0x0f, 0xc3, 0xc0, 0xf5, 0x05, 0x29, 0xa8,
+	0xc5, 0x4f, 0x1b, 0xac, 0x37, 0x13, 0x51, 0x84, 0x89, 0xa7, 0xf4, 0x25, 0xeb, 0xac, 0x97, 0x15,
+	0xe0, 0x5d, 0x54, 0x0c, 0x19, 0x1c, 0xb8, 0x47, 0xf6, 0xfd, 0x6e, 0x20, 0x28, 0x29, 0x9a, 0x1a,
+	0xda, 0x53, 0x8a, 0x77, 0xa4, 0x60, 0x80, 0x78, 0xdd, 0x2a, 0x84, 0x69, 0x12, 0xbf, 0x89, 0xd0,
+	0x21, 0x1c, 0xdb, 0x70, 0x14, 0xba, 0x0c, 0xc8, 0xac, 0x62, 0x95, 0xb2, 0xac, 0xb7, 0xe1, 0x78,
+	0x4b, 0xa5, 0x87, 0x48, 0xf9, 0xc3, 0x38, 0x85, 0x6b, 0xa8, 0xa0, 0xbe, 0x5c, 0xf0, 0x69, 0xc3,
+	0x03, 0xf2, 0x8f, 0x71, 0xaf, 0x6b, 0x5d, 0xd1, 0xde, 0x52, 0x82, 0x64, 0xa7, 0x68, 0x12, 0xc2,
+	0x75, 0xa4, 0x3e, 0x6f, 0xdb, 0x71, 0xb9, 0x62, 0xfc, 0x3b, 0x6d, 0xea, 0x4c, 0x32, 0xea, 0x2e,
+	0xef, 0x87, 0x14, 0x68, 0x1a, 0xc3, 0x6f, 0x45, 0x85, 0x70, 0x41, 0x45, 0x97, 0x93, 0xff, 0x47,
+	0x16, 0x72, 0x47, 0x09, 0x06, 0x7a, 0x7a, 0x59, 0x57, 0xa4, 0x73, 0xf8, 0xb6, 0xae, 0x08, 0x7c,
+	0xe1, 0x36, 0xa9, 0x00, 0xf2, 0x9f, 0x86, 0x3d, 0x9b, 0x85, 0xc5, 0x67, 0x46, 0xad, 0x4f, 0x1a,
+	0x97, 0x96, 0x59, 0x8f, 0xb7, 0xa2, 0xe3, 0xad, 0xcb, 0x81, 0xd9, 0xd4, 0x71, 0xc8, 0x4f, 0x33,
+	0xa3, 0x5a, 0x7c, 0x97, 0x03, 0xab, 0x39, 0x4e, 0xa6, 0xc5, 0x28, 0x86, 0x6f, 0xa3, 0xf9, 0x14,
+	0xa3, 0x3f, 0x4d, 0xf2, 0xb3, 0x26, 0x5d, 0x36, 0x93, 0xa2, 0x6f, 0x3a, 0x82, 0xcd, 0xd1, 0x4c,
+	0x38, 0x5b, 0x56, 0x0b, 0x04, 0xf9, 0xe5, 0xd4, 0xb2, 0xb6, 0x41, 0x0c, 0x95, 0xb5, 0x0d, 0x02,
+	0xb7, 0xd0, 0x13, 0x29, 0xa6, 0xd9, 0x96, 0x87, 0x85, 0x1d, 0x52, 0xce, 0x1f, 0x04, 0xcc, 0x21,
+	0xbf, 0x6a, 0xe4, 0xf3, 0x66, 0xe4, 0xa6, 0x52, 0xef, 0x45, 0xe2, 0x98, 0x7e, 0x81, 0x1a, 0xd3,
+	0xf8, 0x2e, 0x5a, 0xec, 0xab, 0x57, 0x7e, 0xe5, 0xb6, 0x3c, 0xca, 0xc9, 0x23, 0xed, 0x71, 0x65,
+	0x44, 0xd9, 0x52, 0x68, 0x05, 0xe9, 0xd8, 0x9c, 0xa3, 0x83, 0x19, 0xfc, 0x01, 0x3a, 0x9f, 0x92,
+	0xf5, 0x81, 0xa1, 0xd1, 0xbf, 0x69, 0xf4, 0x33, 0x66, 0x74, 0x74, 0x72, 0xf4, 0xb1, 0x31, 0x1d,
+	0x4a, 0xe1, 0x9b, 0x68, 0x2e, 0x85, 0x7b, 0x2e, 0x17, 0xe4, 0x77, 0x4d, 0xbd, 0x64, 0xa6, 0xee,
+	0xba, 0x5c, 0x64, 0xe6, 0x28, 0x0e, 0x26, 0x24, 0x59, 0x9a, 0x26, 0xfd, 0x31, 0x92, 0x24, 0xad,
+	0x87, 0x48, 0x71, 0x30, 0xd9, 0x7a, 0x45, 0x92, 0x13, 0xf9, 0x55, 0x7e, 0xd4, 0xd6, 0xcb, 0x35,
+	0x83, 0x13, 0x19, 0xc5, 0x92, 0x89, 0x54, 0x98, 0x68, 0x22, 0xbf, 0xce, 0x8f, 0x9a, 0x48, 0xb9,
+	0xca, 0x30, 0x91, 0x69, 0x38, 0x5b, 0x96, 0x9c, 0xc8, 0x6f, 0x4e, 0x2d, 0x6b, 0x70, 0x22, 0xa3,
+	0x18, 0xbe, 0x87, 0x96, 0xfa, 0x30, 0x6a, 0x50, 0x42, 0x60, 0x1d, 0x97, 0xab, 0xbb, 0xc5, 0xb7,
+	0x9a, 0x79, 0x75, 0x04, 0x53, 0xca, 0xf7, 0x12, 0x75, 0xcc, 0xbf, 0x48, 0xcd, 0x79, 0xdc, 0x41,
+	0xcb, 0xa9, 0x57, 0x34, 0x3a, 0x7d, 0x66, 0xdf, 0x69, 0xb3, 0x17, 0xcc, 0x66, 0x7a, 0x4a, 0x86,
+	0xdd, 0x08, 0x1d, 0x21, 0xc0, 0x1f, 0xa1, 0x85, 0xa6, 0xd7, 0xe5, 0x02, 0x98, 0x1d, 0x5d, 0xd4,
+	0x6c, 0x0e, 0x82, 0x7c, 0x8a, 0xa2, 0x4f, 0xa0, 0xff, 0x96, 0x56, 0xd9, 0xd4, 0xca, 0xf7, 0xb4,
+	0xf0, 0x0e, 0x88, 0xa1, 0x53, 0xef, 0x5c, 0x73, 0x50, 0x82, 0xef, 0xa1, 0x8b, 0xb1, 0x83, 0x86,
+	0xd9, 0x54, 0x08, 0xa6, 0x5c, 0x3e, 0x43, 0xd1, 0x39, 0x68, 0x72, 0xb9, 0xa5, 0x62, 0x35, 0x21,
+	0x98, 0xc9, 0x68, 0xb1, 0x69, 0x50, 0xe1, 0x0f, 0x11, 0x76, 0x82, 0x07, 0x7e, 0x8b, 0x51, 0x07,
+	0x6c, 0xd7, 0x3f, 0x08, 0x94, 0xcd, 0xe7, 0xda, 0x66, 0x35, 0x6b, 0x53, 0x8f, 0x85, 0x3b, 0xfe,
+	0x41, 0x60, 0xb2, 0x98, 0x77, 0x06, 0x14, 0xd8, 0x45, 0x17, 0x52, 0x7c, 0xfc, 0xba, 0x04, 0x70,
+	0x41, 0xbe, 0xbc, 0x65, 0x3a, 0xd1, 0x13, 0x8b, 0xe8, 0x75, 0xec, 0x03, 0x1f, 0xb4, 0x79, 0xc5,
+	0x5a, 0x74, 0x0c, 0xaa, 0xf4, 0x36, 0x79, 0x16, 0xcd, 0x6e, 0x75, 0x42, 0x71, 0x6c, 0x01, 0x0f,
+	0x03, 0x9f, 0xc3, 0xca, 0x31, 0x5a, 0x3e, 0xe5, 0x9f, 0x02, 0x63, 0x34, 0xa1, 0xae, 0xc4, 0x39,
+	0x75, 0x25, 0x56, 0xbf, 0xe5, 0x55, 0x39, 0x39, 0x40, 0xa3, 0xab, 0x72, 0xfc, 0x8c, 0x2f, 0xa1,
+	0x22, 0x77, 0x3b, 0xa1, 0x07, 0xb6, 0x08, 0x0e, 0x41, 0xdf, 0x94, 0xf3, 0x56, 0x41, 0xc7, 0xf6,
+	0x65, 0x28, 0xa9, 0xe5, 0xc6, 0xab, 0x0f, 0xff, 0x2a, 0x9d, 0x79, 0x78, 0x52, 0xca, 0x3d, 0x3a,
+	0x29, 0xe5, 0xfe, 0x3c, 0x29, 0xe5, 0xbe, 0xf8, 0xbb, 0x74, 0xe6, 0xfd, 0xcb, 0xad, 0x40, 0xb5,
+	0x5d, 0x71, 0x83, 0xf5, 0xf4, 0xfe, 0xbf, 0xb1, 0xde, 0xff, 0x2a, 0x1a, 0x53, 0xea, 0x5a, 0xbf,
+	0xf1, 0x78, 0x00, 0x28, 0x3f, 0x46, 0x35, 0x78, 0x0c, 0x00, 0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -335,6 +349,20 @@ func (m *RequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AuthRevision != 0 {
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRevision))
 		i--
@@ -689,6 +717,30 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.KeyExpire != nil {
+		{
+			size, err := m.KeyExpire.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PrefixQuota != nil {
+		{
+			size, err := m.PrefixQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.LeaseCheckpoint != nil {
 		{
 			size, err := m.LeaseCheckpoint.MarshalToSizedBuffer(dAtA[:i])
@@ -919,6 +971,15 @@ func (m *RequestHeader) Size() (n int) {
 	if m.AuthRevision != 0 {
 		n += 1 + sovRaftInternal(uint64(m.AuthRevision))
 	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -974,6 +1035,14 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.LeaseCheckpoint.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.PrefixQuota != nil {
+		l = m.PrefixQuota.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.KeyExpire != nil {
+		l = m.KeyExpire.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1209,6 +1278,57 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
@@ -1639,6 +1759,78 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrefixQuota == nil {
+				m.PrefixQuota = &PrefixQuotaRequest{}
+			}
+			if err := m.PrefixQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyExpire", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyExpire == nil {
+				m.KeyExpire = &KeyExpireRequest{}
+			}
+			if err := m.KeyExpire.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
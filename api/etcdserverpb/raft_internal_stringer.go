@@ -165,6 +165,7 @@ type loggablePutRequest struct {
 	PrevKv      bool   `protobuf:"varint,4,opt,name=prev_kv,proto3"`
 	IgnoreValue bool   `protobuf:"varint,5,opt,name=ignore_value,proto3"`
 	IgnoreLease bool   `protobuf:"varint,6,opt,name=ignore_lease,proto3"`
+	TTL         int64  `protobuf:"varint,7,opt,name=TTL,proto3"`
 }
 
 func NewLoggablePutRequest(request *PutRequest) proto.Message {
@@ -175,6 +176,7 @@ func NewLoggablePutRequest(request *PutRequest) proto.Message {
 		request.PrevKv,
 		request.IgnoreValue,
 		request.IgnoreLease,
+		request.TTL,
 	}
 }
 
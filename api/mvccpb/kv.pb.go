@@ -65,7 +65,11 @@ type KeyValue struct {
 	// lease is the ID of the lease that attached to key.
 	// When the attached lease expires, the key will be deleted.
 	// If lease is 0, then no lease is attached to the key.
-	Lease                int64    `protobuf:"varint,6,opt,name=lease,proto3" json:"lease,omitempty"`
+	Lease int64 `protobuf:"varint,6,opt,name=lease,proto3" json:"lease,omitempty"`
+	// TTL is the time-to-live in seconds the key was put with.
+	// The key will be deleted once its ttl elapses without another put on the key.
+	// If TTL is 0, then the key does not expire on its own.
+	TTL                  int64    `protobuf:"varint,7,opt,name=TTL,proto3" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -164,28 +168,28 @@ func init() {
 func init() { proto.RegisterFile("kv.proto", fileDescriptor_2216fe83c9c12408) }
 
 var fileDescriptor_2216fe83c9c12408 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x6a, 0xfa, 0x40,
-	0x10, 0xc6, 0xb3, 0x46, 0xa3, 0xff, 0x51, 0xfc, 0x87, 0x45, 0x68, 0x28, 0x34, 0xa4, 0x5e, 0x6a,
-	0x29, 0x24, 0xa0, 0x87, 0xde, 0x4b, 0x73, 0xb2, 0x87, 0x12, 0x6c, 0x0f, 0xbd, 0x48, 0x8c, 0x83,
-	0x84, 0xa8, 0x1b, 0x62, 0xba, 0x90, 0x37, 0xe9, 0xbd, 0xf7, 0x3e, 0x87, 0x47, 0x1f, 0xa1, 0xda,
-	0x17, 0x29, 0x3b, 0x5b, 0xed, 0xa5, 0x97, 0xdd, 0x99, 0xef, 0xfb, 0xb1, 0xf3, 0x0d, 0x0b, 0xad,
-	0x4c, 0xfa, 0x79, 0x21, 0x4a, 0xc1, 0xad, 0x95, 0x4c, 0x92, 0x7c, 0x76, 0xde, 0x5b, 0x88, 0x85,
-	0x20, 0x29, 0x50, 0x95, 0x76, 0xfb, 0x1f, 0x0c, 0x5a, 0x63, 0xac, 0x9e, 0xe3, 0xe5, 0x2b, 0x72,
-	0x1b, 0xcc, 0x0c, 0x2b, 0x87, 0x79, 0x6c, 0xd0, 0x89, 0x54, 0xc9, 0xaf, 0xe0, 0x7f, 0x52, 0x60,
-	0x5c, 0xe2, 0xb4, 0x40, 0x99, 0x6e, 0x52, 0xb1, 0x76, 0x6a, 0x1e, 0x1b, 0x98, 0x51, 0x57, 0xcb,
-	0xd1, 0x8f, 0xca, 0x2f, 0xa1, 0xb3, 0x12, 0xf3, 0x5f, 0xca, 0x24, 0xaa, 0xbd, 0x12, 0xf3, 0x13,
-	0xe2, 0x40, 0x53, 0x62, 0x41, 0x6e, 0x9d, 0xdc, 0x63, 0xcb, 0x7b, 0xd0, 0x90, 0x2a, 0x80, 0xd3,
-	0xa0, 0xc9, 0xba, 0x51, 0xea, 0x12, 0xe3, 0x0d, 0x3a, 0x16, 0xd1, 0xba, 0xe9, 0xbf, 0x33, 0x68,
-	0x84, 0x12, 0xd7, 0x25, 0xbf, 0x81, 0x7a, 0x59, 0xe5, 0x48, 0x71, 0xbb, 0xc3, 0x33, 0x5f, 0xef,
-	0xe9, 0x93, 0xa9, 0xcf, 0x49, 0x95, 0x63, 0x44, 0x10, 0xf7, 0xa0, 0x96, 0x49, 0xca, 0xde, 0x1e,
-	0xda, 0x47, 0xf4, 0xb8, 0x78, 0x54, 0xcb, 0x24, 0xbf, 0x86, 0x66, 0x5e, 0xa0, 0x9c, 0x66, 0x92,
-	0xc2, 0xff, 0x85, 0x59, 0x0a, 0x18, 0xcb, 0xbe, 0x07, 0xff, 0x4e, 0xef, 0xf3, 0x26, 0x98, 0x8f,
-	0x4f, 0x13, 0xdb, 0xe0, 0x00, 0xd6, 0x7d, 0xf8, 0x10, 0x4e, 0x42, 0x9b, 0xdd, 0xdd, 0x6e, 0xf7,
-	0xae, 0xb1, 0xdb, 0xbb, 0xc6, 0xf6, 0xe0, 0xb2, 0xdd, 0xc1, 0x65, 0x9f, 0x07, 0x97, 0xbd, 0x7d,
-	0xb9, 0xc6, 0xcb, 0xc5, 0x42, 0xf8, 0x58, 0x26, 0x73, 0x3f, 0x15, 0x81, 0xba, 0x83, 0x38, 0x4f,
-	0x03, 0x39, 0x0a, 0xf4, 0xac, 0x99, 0x45, 0xdf, 0x32, 0xfa, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x78,
-	0x06, 0x46, 0xf5, 0xc0, 0x01, 0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x33, 0x46, 0x13, 0xef, 0x51, 0xbc, 0x61, 0x10, 0xee, 0x70, 0xa1, 0x21, 0x75, 0x53,
+	0x4b, 0x21, 0x01, 0x5d, 0x74, 0x5f, 0x9a, 0x95, 0x2e, 0x4a, 0x48, 0xbb, 0xe8, 0x46, 0x62, 0x3c,
+	0x48, 0x88, 0x3a, 0x21, 0xa6, 0x03, 0x79, 0x93, 0xee, 0xfb, 0x22, 0x5d, 0xba, 0xf4, 0x11, 0xaa,
+	0x7d, 0x91, 0x32, 0x33, 0xd5, 0xae, 0xba, 0x49, 0xce, 0xf9, 0xff, 0x8f, 0x39, 0xe7, 0x9f, 0x81,
+	0x76, 0x2e, 0xfc, 0xa2, 0xe4, 0x15, 0xa7, 0xd6, 0x5a, 0xa4, 0x69, 0x31, 0xff, 0xdf, 0x5f, 0xf2,
+	0x25, 0x57, 0x52, 0x20, 0x2b, 0xed, 0x0e, 0xde, 0x09, 0xb4, 0x27, 0x58, 0x3f, 0x25, 0xab, 0x17,
+	0xa4, 0x0e, 0x98, 0x39, 0xd6, 0x8c, 0x78, 0x64, 0xd8, 0x8d, 0x64, 0x49, 0xaf, 0xe0, 0x6f, 0x5a,
+	0x62, 0x52, 0xe1, 0xac, 0x44, 0x91, 0x6d, 0x33, 0xbe, 0x61, 0x0d, 0x8f, 0x0c, 0xcd, 0xa8, 0xa7,
+	0xe5, 0xe8, 0x5b, 0xa5, 0x97, 0xd0, 0x5d, 0xf3, 0xc5, 0x0f, 0x65, 0x2a, 0xaa, 0xb3, 0xe6, 0x8b,
+	0x33, 0xc2, 0xc0, 0x16, 0x58, 0x2a, 0xb7, 0xa9, 0xdc, 0x53, 0x4b, 0xfb, 0xd0, 0x12, 0x72, 0x01,
+	0xd6, 0x52, 0x93, 0x75, 0x23, 0xd5, 0x15, 0x26, 0x5b, 0x64, 0x96, 0xa2, 0x75, 0x23, 0x77, 0x8c,
+	0xe3, 0x29, 0xb3, 0x95, 0x26, 0xcb, 0xc1, 0x1b, 0x81, 0x56, 0x28, 0x70, 0x53, 0xd1, 0x1b, 0x68,
+	0x56, 0x75, 0x81, 0x2a, 0x40, 0x6f, 0xf4, 0xcf, 0xd7, 0xc9, 0x7d, 0x65, 0xea, 0x6f, 0x5c, 0x17,
+	0x18, 0x29, 0x88, 0x7a, 0xd0, 0xc8, 0x85, 0x4a, 0xd3, 0x19, 0x39, 0x27, 0xf4, 0x74, 0x15, 0x51,
+	0x23, 0x17, 0xf4, 0x1a, 0xec, 0xa2, 0x44, 0x31, 0xcb, 0x05, 0x33, 0x7f, 0xc1, 0x2c, 0x09, 0x4c,
+	0xc4, 0xc0, 0x83, 0x3f, 0xe7, 0xf3, 0xa9, 0x0d, 0xe6, 0xc3, 0x63, 0xec, 0x18, 0x14, 0xc0, 0xba,
+	0x0f, 0xa7, 0x61, 0x1c, 0x3a, 0xe4, 0xee, 0x76, 0x77, 0x70, 0x8d, 0xfd, 0xc1, 0x35, 0x76, 0x47,
+	0x97, 0xec, 0x8f, 0x2e, 0xf9, 0x38, 0xba, 0xe4, 0xf5, 0xd3, 0x35, 0x9e, 0x2f, 0x96, 0xdc, 0xc7,
+	0x2a, 0x5d, 0xf8, 0x19, 0x0f, 0xe4, 0x3f, 0x48, 0x8a, 0x2c, 0x10, 0xe3, 0x40, 0xcf, 0x9a, 0x5b,
+	0xea, 0xa1, 0xc6, 0x5f, 0x03, 0x00, 0x62, 0x15, 0x17, 0xc7, 0xd2, 0x01, 0x00, 0x00,
 }
 
 func (m *KeyValue) Marshal() (dAtA []byte, err error) {
@@ -212,6 +216,11 @@ func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TTL != 0 {
+		i = encodeVarintKv(dAtA, i, uint64(m.TTL))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Lease != 0 {
 		i = encodeVarintKv(dAtA, i, uint64(m.Lease))
 		i--
@@ -342,6 +351,9 @@ func (m *KeyValue) Size() (n int) {
 	if m.Lease != 0 {
 		n += 1 + sovKv(uint64(m.Lease))
 	}
+	if m.TTL != 0 {
+		n += 1 + sovKv(uint64(m.TTL))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -550,6 +562,25 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKv(dAtA[iNdEx:])
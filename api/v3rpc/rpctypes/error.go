@@ -25,6 +25,8 @@ var (
 	ErrGRPCKeyNotFound             = status.Error(codes.InvalidArgument, "etcdserver: key not found")
 	ErrGRPCValueProvided           = status.Error(codes.InvalidArgument, "etcdserver: value is provided")
 	ErrGRPCLeaseProvided           = status.Error(codes.InvalidArgument, "etcdserver: lease is provided")
+	ErrGRPCInvalidKeyTTL           = status.Error(codes.InvalidArgument, "etcdserver: invalid key ttl")
+	ErrGRPCKeyTTLWithLease         = status.Error(codes.InvalidArgument, "etcdserver: key ttl cannot be combined with a lease")
 	ErrGRPCTooManyOps              = status.Error(codes.InvalidArgument, "etcdserver: too many operations in txn request")
 	ErrGRPCDuplicateKey            = status.Error(codes.InvalidArgument, "etcdserver: duplicate key given in txn request")
 	ErrGRPCInvalidClientAPIVersion = status.Error(codes.InvalidArgument, "etcdserver: invalid client api version")
@@ -33,11 +35,19 @@ var (
 	ErrGRPCFutureRev               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
 	ErrGRPCNoSpace                 = status.Error(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
 
+	ErrGRPCInvalidContinueToken   = status.Error(codes.InvalidArgument, "etcdserver: invalid continue token")
+	ErrGRPCContinueTokenCompacted = status.Error(codes.OutOfRange, "etcdserver: revision of continue token has been compacted")
+
+	ErrGRPCPrefixQuotaExceeded = status.Error(codes.ResourceExhausted, "etcdserver: prefix quota exceeded")
+	ErrGRPCPrefixQuotaNotFound = status.Error(codes.NotFound, "etcdserver: prefix quota not found")
+	ErrGRPCInvalidPrefixQuota  = status.Error(codes.InvalidArgument, "etcdserver: invalid prefix quota")
+
 	ErrGRPCLeaseNotFound    = status.Error(codes.NotFound, "etcdserver: requested lease not found")
 	ErrGRPCLeaseExist       = status.Error(codes.FailedPrecondition, "etcdserver: lease already exists")
 	ErrGRPCLeaseTTLTooLarge = status.Error(codes.OutOfRange, "etcdserver: too large lease TTL")
 
-	ErrGRPCWatchCanceled = status.Error(codes.Canceled, "etcdserver: watch canceled")
+	ErrGRPCWatchCanceled             = status.Error(codes.Canceled, "etcdserver: watch canceled")
+	ErrGRPCWatchCoalesceNotSupported = status.Error(codes.InvalidArgument, "etcdserver: watch coalescing is not supported by the grpc proxy")
 
 	ErrGRPCMemberExist            = status.Error(codes.FailedPrecondition, "etcdserver: member ID already exist")
 	ErrGRPCPeerURLExist           = status.Error(codes.FailedPrecondition, "etcdserver: Peer URLs already exists")
@@ -55,6 +65,7 @@ var (
 
 	ErrGRPCRequestTooLarge        = status.Error(codes.InvalidArgument, "etcdserver: request is too large")
 	ErrGRPCRequestTooManyRequests = status.Error(codes.ResourceExhausted, "etcdserver: too many requests")
+	ErrGRPCRateLimited            = status.Error(codes.ResourceExhausted, "etcdserver: request rate limited")
 
 	ErrGRPCRootUserNotExist     = status.Error(codes.FailedPrecondition, "etcdserver: root user does not exist")
 	ErrGRPCRootRoleNotExist     = status.Error(codes.FailedPrecondition, "etcdserver: root user does not have root role")
@@ -91,9 +102,12 @@ var (
 	ErrGRPCWrongDowngradeVersionFormat   = status.Error(codes.InvalidArgument, "etcdserver: wrong downgrade target version format")
 	ErrGRPCInvalidDowngradeTargetVersion = status.Error(codes.InvalidArgument, "etcdserver: invalid downgrade target version")
 	ErrGRPCClusterVersionUnavailable     = status.Error(codes.FailedPrecondition, "etcdserver: cluster version not found during downgrade")
+	ErrGRPCClusterVersionTooLow          = status.Error(codes.FailedPrecondition, "etcdserver: request not supported by the cluster version")
 	ErrGRPCDowngradeInProcess            = status.Error(codes.FailedPrecondition, "etcdserver: cluster has a downgrade job in progress")
 	ErrGRPCNoInflightDowngrade           = status.Error(codes.FailedPrecondition, "etcdserver: no inflight downgrade job")
 
+	ErrGRPCConfigReloadNotSupported = status.Error(codes.FailedPrecondition, "etcdserver: configuration reload is not supported")
+
 	ErrGRPCCanceled         = status.Error(codes.Canceled, "etcdserver: request canceled")
 	ErrGRPCDeadlineExceeded = status.Error(codes.DeadlineExceeded, "etcdserver: context deadline exceeded")
 
@@ -103,6 +117,9 @@ var (
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
 
+		ErrorDesc(ErrGRPCInvalidKeyTTL):   ErrGRPCInvalidKeyTTL,
+		ErrorDesc(ErrGRPCKeyTTLWithLease): ErrGRPCKeyTTLWithLease,
+
 		ErrorDesc(ErrGRPCTooManyOps):        ErrGRPCTooManyOps,
 		ErrorDesc(ErrGRPCDuplicateKey):      ErrGRPCDuplicateKey,
 		ErrorDesc(ErrGRPCInvalidSortOption): ErrGRPCInvalidSortOption,
@@ -110,10 +127,19 @@ var (
 		ErrorDesc(ErrGRPCFutureRev):         ErrGRPCFutureRev,
 		ErrorDesc(ErrGRPCNoSpace):           ErrGRPCNoSpace,
 
+		ErrorDesc(ErrGRPCInvalidContinueToken):   ErrGRPCInvalidContinueToken,
+		ErrorDesc(ErrGRPCContinueTokenCompacted): ErrGRPCContinueTokenCompacted,
+
+		ErrorDesc(ErrGRPCPrefixQuotaExceeded): ErrGRPCPrefixQuotaExceeded,
+		ErrorDesc(ErrGRPCPrefixQuotaNotFound): ErrGRPCPrefixQuotaNotFound,
+		ErrorDesc(ErrGRPCInvalidPrefixQuota):  ErrGRPCInvalidPrefixQuota,
+
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
 		ErrorDesc(ErrGRPCLeaseTTLTooLarge): ErrGRPCLeaseTTLTooLarge,
 
+		ErrorDesc(ErrGRPCWatchCoalesceNotSupported): ErrGRPCWatchCoalesceNotSupported,
+
 		ErrorDesc(ErrGRPCMemberExist):            ErrGRPCMemberExist,
 		ErrorDesc(ErrGRPCPeerURLExist):           ErrGRPCPeerURLExist,
 		ErrorDesc(ErrGRPCMemberNotEnoughStarted): ErrGRPCMemberNotEnoughStarted,
@@ -126,6 +152,7 @@ var (
 
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
+		ErrorDesc(ErrGRPCRateLimited):            ErrGRPCRateLimited,
 
 		ErrorDesc(ErrGRPCRootUserNotExist):     ErrGRPCRootUserNotExist,
 		ErrorDesc(ErrGRPCRootRoleNotExist):     ErrGRPCRootRoleNotExist,
@@ -158,10 +185,13 @@ var (
 		ErrorDesc(ErrGRPCBadLeaderTransferee):        ErrGRPCBadLeaderTransferee,
 
 		ErrorDesc(ErrGRPCClusterVersionUnavailable):     ErrGRPCClusterVersionUnavailable,
+		ErrorDesc(ErrGRPCClusterVersionTooLow):          ErrGRPCClusterVersionTooLow,
 		ErrorDesc(ErrGRPCWrongDowngradeVersionFormat):   ErrGRPCWrongDowngradeVersionFormat,
 		ErrorDesc(ErrGRPCInvalidDowngradeTargetVersion): ErrGRPCInvalidDowngradeTargetVersion,
 		ErrorDesc(ErrGRPCDowngradeInProcess):            ErrGRPCDowngradeInProcess,
 		ErrorDesc(ErrGRPCNoInflightDowngrade):           ErrGRPCNoInflightDowngrade,
+
+		ErrorDesc(ErrGRPCConfigReloadNotSupported): ErrGRPCConfigReloadNotSupported,
 	}
 )
 
@@ -171,6 +201,8 @@ var (
 	ErrKeyNotFound       = Error(ErrGRPCKeyNotFound)
 	ErrValueProvided     = Error(ErrGRPCValueProvided)
 	ErrLeaseProvided     = Error(ErrGRPCLeaseProvided)
+	ErrInvalidKeyTTL     = Error(ErrGRPCInvalidKeyTTL)
+	ErrKeyTTLWithLease   = Error(ErrGRPCKeyTTLWithLease)
 	ErrTooManyOps        = Error(ErrGRPCTooManyOps)
 	ErrDuplicateKey      = Error(ErrGRPCDuplicateKey)
 	ErrInvalidSortOption = Error(ErrGRPCInvalidSortOption)
@@ -178,10 +210,19 @@ var (
 	ErrFutureRev         = Error(ErrGRPCFutureRev)
 	ErrNoSpace           = Error(ErrGRPCNoSpace)
 
+	ErrInvalidContinueToken   = Error(ErrGRPCInvalidContinueToken)
+	ErrContinueTokenCompacted = Error(ErrGRPCContinueTokenCompacted)
+
+	ErrPrefixQuotaExceeded = Error(ErrGRPCPrefixQuotaExceeded)
+	ErrPrefixQuotaNotFound = Error(ErrGRPCPrefixQuotaNotFound)
+	ErrInvalidPrefixQuota  = Error(ErrGRPCInvalidPrefixQuota)
+
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
 	ErrLeaseTTLTooLarge = Error(ErrGRPCLeaseTTLTooLarge)
 
+	ErrWatchCoalesceNotSupported = Error(ErrGRPCWatchCoalesceNotSupported)
+
 	ErrMemberExist            = Error(ErrGRPCMemberExist)
 	ErrPeerURLExist           = Error(ErrGRPCPeerURLExist)
 	ErrMemberNotEnoughStarted = Error(ErrGRPCMemberNotEnoughStarted)
@@ -193,6 +234,7 @@ var (
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
+	ErrRateLimited     = Error(ErrGRPCRateLimited)
 
 	ErrRootUserNotExist     = Error(ErrGRPCRootUserNotExist)
 	ErrRootRoleNotExist     = Error(ErrGRPCRootRoleNotExist)
@@ -230,10 +272,13 @@ var (
 	ErrBadLeaderTransferee        = Error(ErrGRPCBadLeaderTransferee)
 
 	ErrClusterVersionUnavailable     = Error(ErrGRPCClusterVersionUnavailable)
+	ErrClusterVersionTooLow          = Error(ErrGRPCClusterVersionTooLow)
 	ErrWrongDowngradeVersionFormat   = Error(ErrGRPCWrongDowngradeVersionFormat)
 	ErrInvalidDowngradeTargetVersion = Error(ErrGRPCInvalidDowngradeTargetVersion)
 	ErrDowngradeInProcess            = Error(ErrGRPCDowngradeInProcess)
 	ErrNoInflightDowngrade           = Error(ErrGRPCNoInflightDowngrade)
+
+	ErrConfigReloadNotSupported = Error(ErrGRPCConfigReloadNotSupported)
 )
 
 // EtcdError defines gRPC server errors.
@@ -24,24 +24,34 @@ import (
 )
 
 func PurgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) <-chan error {
-	return purgeFile(lg, dirname, suffix, max, interval, stop, nil, nil, true)
+	return purgeFile(lg, dirname, suffix, max, interval, stop, nil, nil, true, nil)
 }
 
 func PurgeFileWithDoneNotify(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
 	doneC := make(chan struct{})
-	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, true)
+	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, true, nil)
 	return doneC, errC
 }
 
 func PurgeFileWithoutFlock(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
 	doneC := make(chan struct{})
-	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, false)
+	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, false, nil)
+	return doneC, errC
+}
+
+// PurgeFileIf is PurgeFileWithDoneNotify, or PurgeFileWithoutFlock if flock is false,
+// that only purges the files for which purgeable returns true. Files are purged
+// oldest first, purging stops at the first file that is not purgeable.
+func PurgeFileIf(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, flock bool, purgeable func(path string) bool) (<-chan struct{}, <-chan error) {
+	doneC := make(chan struct{})
+	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, flock, purgeable)
 	return doneC, errC
 }
 
 // purgeFile is the internal implementation for PurgeFile which can post purged files to purgec if non-nil.
 // if donec is non-nil, the function closes it to notify its exit.
-func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}, flock bool) <-chan error {
+// if purgeable is non-nil, only files it returns true for are purged.
+func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}, flock bool, purgeable func(string) bool) <-chan error {
 	if lg == nil {
 		lg = zap.NewNop()
 	}
@@ -65,6 +75,10 @@ func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval
 			nPurged := 0
 			for nPurged < len(fnamesWithSuffix)-int(max) {
 				f := filepath.Join(dirname, fnamesWithSuffix[nPurged])
+				if purgeable != nil && !purgeable(f) {
+					lg.Debug("file is not purgeable yet", zap.String("path", f))
+					break
+				}
 				var l *LockedFile
 				if flock {
 					l, err = TryLockFile(f, os.O_WRONLY, PrivateFileMode)
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -39,7 +40,7 @@ func TestPurgeFile(t *testing.T) {
 	stop, purgec := make(chan struct{}), make(chan string, 10)
 
 	// keep 3 most recent files
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, false)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, false, nil)
 	select {
 	case f := <-purgec:
 		t.Errorf("unexpected purge on %q", f)
@@ -100,7 +101,7 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 	require.NoError(t, err)
 
 	stop, purgec := make(chan struct{}), make(chan string, 10)
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, true)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, true, nil)
 
 	for i := 0; i < 5; i++ {
 		select {
@@ -155,3 +156,61 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 
 	close(stop)
 }
+
+func TestPurgeFileIfPurgeable(t *testing.T) {
+	dir := t.TempDir()
+
+	for i := 0; i < 10; i++ {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%d.test", i)))
+		require.NoError(t, err)
+		f.Close()
+	}
+
+	// create a purge barrier at 5
+	var barrier atomic.Bool
+	barrier.Store(true)
+	purgeable := func(path string) bool {
+		return !barrier.Load() || filepath.Base(path) != "5.test"
+	}
+
+	stop, purgec := make(chan struct{}), make(chan string, 10)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, false, purgeable)
+
+	for i := 0; i < 5; i++ {
+		select {
+		case <-purgec:
+		case <-time.After(time.Second):
+			t.Fatalf("purge took too long")
+		}
+	}
+
+	fnames, rerr := ReadDir(dir)
+	require.NoError(t, rerr)
+	require.Equal(t, []string{"5.test", "6.test", "7.test", "8.test", "9.test"}, fnames)
+
+	// remove the purge barrier
+	barrier.Store(false)
+
+	// wait for rest of purges (5, 6)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-purgec:
+		case <-time.After(time.Second):
+			t.Fatalf("purge took too long")
+		}
+	}
+
+	fnames, rerr = ReadDir(dir)
+	require.NoError(t, rerr)
+	require.Equal(t, []string{"7.test", "8.test", "9.test"}, fnames)
+
+	select {
+	case f := <-purgec:
+		t.Errorf("unexpected purge on %q", f)
+	case err := <-errch:
+		t.Errorf("unexpected purge error %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(stop)
+}
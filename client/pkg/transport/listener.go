@@ -207,6 +207,11 @@ type TLSInfo struct {
 
 	// LocalAddr is the local IP address to use when communicating with a peer.
 	LocalAddr string
+
+	// Reloader, if set, provides the configs rebuilt from the latest contents
+	// of the files, instead of the ones loaded once by ServerConfig and
+	// ClientConfig. See NewTLSReloader.
+	Reloader *TLSReloader
 }
 
 func (info TLSInfo) String() string {
@@ -527,6 +532,9 @@ func (info TLSInfo) cafiles() []string {
 
 // ServerConfig generates a tls.Config object for use by an HTTP server.
 func (info TLSInfo) ServerConfig() (*tls.Config, error) {
+	if info.Reloader != nil {
+		return info.Reloader.ServerConfig()
+	}
 	cfg, err := info.baseConfig()
 	if err != nil {
 		return nil, err
@@ -560,6 +568,9 @@ func (info TLSInfo) ServerConfig() (*tls.Config, error) {
 
 // ClientConfig generates a tls.Config object for use by an HTTP client.
 func (info TLSInfo) ClientConfig() (*tls.Config, error) {
+	if info.Reloader != nil {
+		return info.Reloader.ClientConfig()
+	}
 	var cfg *tls.Config
 	var err error
 
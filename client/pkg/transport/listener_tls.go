@@ -68,6 +68,9 @@ func newTLSListener(l net.Listener, tlsinfo *TLSInfo, check tlsCheckFunc) (net.L
 			}
 			st := tlsConn.ConnectionState()
 			if certs := st.PeerCertificates; len(certs) > 0 {
+				if tlsinfo.Reloader != nil {
+					return tlsinfo.Reloader.checkCRL(certs)
+				}
 				return checkCRL(tlsinfo.CRLFile, certs)
 			}
 			return nil
@@ -167,19 +170,31 @@ func (l *tlsListener) acceptLoop() {
 }
 
 func checkCRL(crlPath string, cert []*x509.Certificate) error {
-	// TODO: cache
-	crlBytes, err := os.ReadFile(crlPath)
+	revokedSerials, err := loadCRL(crlPath)
 	if err != nil {
 		return err
 	}
+	return checkRevoked(revokedSerials, cert)
+}
+
+// loadCRL returns the revoked serials of the CRL file.
+func loadCRL(crlPath string) (map[string]struct{}, error) {
+	crlBytes, err := os.ReadFile(crlPath)
+	if err != nil {
+		return nil, err
+	}
 	certList, err := x509.ParseRevocationList(crlBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	revokedSerials := make(map[string]struct{})
 	for _, rc := range certList.RevokedCertificateEntries {
 		revokedSerials[string(rc.SerialNumber.Bytes())] = struct{}{}
 	}
+	return revokedSerials, nil
+}
+
+func checkRevoked(revokedSerials map[string]struct{}, cert []*x509.Certificate) error {
 	for _, c := range cert {
 		serial := string(c.SerialNumber.Bytes())
 		if _, ok := revokedSerials[serial]; ok {
@@ -0,0 +1,230 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TLSReloader holds the TLS configs of a TLSInfo and rebuilds them when the
+// contents of its files change, so that the trusted CAs and the CRL can be
+// rotated without a restart. Set it as the Reloader of the TLSInfo: its TLS
+// listeners and transports then use the latest configs for each new
+// connection.
+type TLSReloader struct {
+	info     TLSInfo
+	lg       *zap.Logger
+	onReload func(error)
+
+	// mu serializes the reloads.
+	mu     sync.Mutex
+	hashes map[string][sha256.Size]byte
+
+	server  atomic.Pointer[tls.Config]
+	client  atomic.Pointer[tls.Config]
+	revoked atomic.Pointer[map[string]struct{}]
+}
+
+// NewTLSReloader loads the TLS configs of info. onReload, if not nil, is
+// called with the result of each reload.
+func NewTLSReloader(lg *zap.Logger, info TLSInfo, onReload func(error)) (*TLSReloader, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	info.Reloader = nil
+	r := &TLSReloader{info: info, lg: lg, onReload: onReload}
+	if _, err := r.load(true); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// files returns the files of the TLSInfo.
+func (r *TLSReloader) files() []string {
+	var fs []string
+	for _, f := range []string{r.info.CertFile, r.info.KeyFile, r.info.ClientCertFile, r.info.ClientKeyFile, r.info.TrustedCAFile, r.info.CRLFile} {
+		if f != "" {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// load rebuilds the configs if the contents of the files changed since the
+// last load, or if force is set. It returns the changed files.
+func (r *TLSReloader) load(force bool) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changed []string
+	hashes := make(map[string][sha256.Size]byte)
+	for _, f := range r.files() {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		// an empty pool would reject all the certificates, e.g. while the
+		// file is rewritten.
+		if f == r.info.TrustedCAFile {
+			if block, _ := pem.Decode(b); block == nil {
+				return nil, fmt.Errorf("no certificate in trusted CA file %q", f)
+			}
+		}
+		hashes[f] = sha256.Sum256(b)
+		if h, ok := r.hashes[f]; !ok || h != hashes[f] {
+			changed = append(changed, f)
+		}
+	}
+	if len(changed) == 0 && !force {
+		return nil, nil
+	}
+
+	var server *tls.Config
+	if !r.info.Empty() {
+		var err error
+		if server, err = r.info.ServerConfig(); err != nil {
+			return nil, err
+		}
+	}
+	client, err := r.info.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	revoked := make(map[string]struct{})
+	if r.info.CRLFile != "" {
+		if revoked, err = loadCRL(r.info.CRLFile); err != nil {
+			return nil, err
+		}
+	}
+
+	r.server.Store(server)
+	r.client.Store(client)
+	r.revoked.Store(&revoked)
+	r.hashes = hashes
+	return changed, nil
+}
+
+// Reload rebuilds the TLS configs from the files of the TLSInfo. The previous
+// configs are kept if a file is invalid.
+func (r *TLSReloader) Reload() error {
+	return r.reload(true)
+}
+
+func (r *TLSReloader) reload(force bool) error {
+	changed, err := r.load(force)
+	if err == nil && len(changed) == 0 && !force {
+		return nil
+	}
+	if err != nil {
+		r.lg.Warn("failed to reload TLS configuration; keeping the previous one", zap.Error(err))
+	} else {
+		r.lg.Info("reloaded TLS configuration", zap.Strings("changed-files", changed))
+	}
+	if r.onReload != nil {
+		r.onReload(err)
+	}
+	return err
+}
+
+// Watch checks the files of the TLSInfo every interval, and reloads the TLS
+// configs when their contents change, until stop is closed.
+func (r *TLSReloader) Watch(stop <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.reload(false)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// ServerConfig returns a server config, which uses the latest server config
+// of the reloader for each handshake.
+func (r *TLSReloader) ServerConfig() (*tls.Config, error) {
+	cur := r.server.Load()
+	if cur == nil {
+		// returns the error of the TLSInfo without certificate.
+		return r.info.ServerConfig()
+	}
+	cfg := cur.Clone()
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return r.server.Load(), nil
+	}
+	return cfg, nil
+}
+
+// ClientConfig returns the latest client config of the reloader.
+func (r *TLSReloader) ClientConfig() (*tls.Config, error) {
+	return r.client.Load().Clone(), nil
+}
+
+// dialTLSContext returns a DialTLSContext function for t, which handshakes
+// with the latest client config of the reloader, so that the connections of
+// t verify the servers against the latest trusted CAs.
+func (r *TLSReloader) dialTLSContext(t *http.Transport) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		var conn net.Conn
+		var err error
+		if t.Dial != nil {
+			conn, err = t.Dial(network, addr)
+		} else {
+			conn, err = t.DialContext(ctx, network, addr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		cfg := r.client.Load().Clone()
+		if cfg.ServerName == "" {
+			host, _, herr := net.SplitHostPort(addr)
+			if herr != nil {
+				conn.Close()
+				return nil, herr
+			}
+			cfg.ServerName = host
+		}
+		if t.TLSHandshakeTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, t.TLSHandshakeTimeout)
+			defer cancel()
+		}
+		tlsConn := tls.Client(conn, cfg)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+}
+
+// checkCRL checks the certificates against the latest CRL of the reloader.
+func (r *TLSReloader) checkCRL(certs []*x509.Certificate) error {
+	return checkRevoked(*r.revoked.Load(), certs)
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// serveTLS serves https on a listener of info until the end of the test.
+func serveTLS(t *testing.T, info *TLSInfo) net.Listener {
+	t.Helper()
+	ln, err := NewListener("127.0.0.1:0", "https", info)
+	require.NoError(t, err)
+	go http.Serve(ln, http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(func() { ln.Close() })
+	return ln
+}
+
+// getWithClientCert sends a request to ln with the certificate of client.
+func getWithClientCert(t *testing.T, ln net.Listener, server, client *TLSInfo) error {
+	t.Helper()
+	serverCert, err := os.ReadFile(server.CertFile)
+	require.NoError(t, err)
+	rootCAs := x509.NewCertPool()
+	rootCAs.AppendCertsFromPEM(serverCert)
+	clientCert, err := tls.LoadX509KeyPair(client.CertFile, client.KeyFile)
+	require.NoError(t, err)
+
+	tr := &http.Transport{
+		TLSClientConfig:   &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}},
+		DisableKeepAlives: true,
+	}
+	resp, err := (&http.Client{Transport: tr, Timeout: 5 * time.Second}).Get("https://" + ln.Addr().String())
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// concatFiles writes the contents of the files to path.
+func concatFiles(t *testing.T, path string, files ...string) {
+	t.Helper()
+	var b []byte
+	for _, f := range files {
+		c, err := os.ReadFile(f)
+		require.NoError(t, err)
+		b = append(b, c...)
+	}
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+}
+
+// writeCRL writes to path a CRL of the certificates, signed by the
+// certificate of issuer.
+func writeCRL(t *testing.T, path string, issuer *TLSInfo, revoked ...*x509.Certificate) {
+	t.Helper()
+	cert, err := x509.ParseCertificate(loadPEM(t, issuer.CertFile))
+	require.NoError(t, err)
+	key, err := x509.ParseECPrivateKey(loadPEM(t, issuer.KeyFile))
+	require.NoError(t, err)
+
+	tmpl := &x509.RevocationList{ThisUpdate: time.Now(), NextUpdate: time.Now().Add(time.Hour), Number: big.NewInt(1)}
+	for _, c := range revoked {
+		tmpl.RevokedCertificateEntries = append(tmpl.RevokedCertificateEntries, x509.RevocationListEntry{SerialNumber: c.SerialNumber, RevocationTime: time.Now()})
+	}
+	crl, err := x509.CreateRevocationList(rand.Reader, tmpl, cert, key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, crl, 0o600))
+}
+
+func loadPEM(t *testing.T, path string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	block, _ := pem.Decode(b)
+	return block.Bytes
+}
+
+// TestTLSReloaderTrustedCA tests that the listeners trust the client
+// certificates of the reloaded CA file, and keep the previous CAs if the file
+// is invalid.
+func TestTLSReloaderTrustedCA(t *testing.T) {
+	server, err := createSelfCert(t)
+	require.NoError(t, err)
+	clientA, err := createSelfCertEx(t, "127.0.0.1", x509.ExtKeyUsageClientAuth)
+	require.NoError(t, err)
+	clientB, err := createSelfCertEx(t, "127.0.0.1", x509.ExtKeyUsageClientAuth)
+	require.NoError(t, err)
+
+	server.TrustedCAFile = filepath.Join(t.TempDir(), "ca.crt")
+	concatFiles(t, server.TrustedCAFile, clientA.CertFile)
+	var reloads []error
+	server.Reloader, err = NewTLSReloader(zaptest.NewLogger(t), *server, func(err error) { reloads = append(reloads, err) })
+	require.NoError(t, err)
+	ln := serveTLS(t, server)
+
+	require.NoError(t, getWithClientCert(t, ln, server, clientA))
+	require.Error(t, getWithClientCert(t, ln, server, clientB))
+
+	concatFiles(t, server.TrustedCAFile, clientA.CertFile, clientB.CertFile)
+	require.NoError(t, server.Reloader.Reload())
+	require.NoError(t, getWithClientCert(t, ln, server, clientA))
+	require.NoError(t, getWithClientCert(t, ln, server, clientB))
+
+	require.NoError(t, os.WriteFile(server.TrustedCAFile, []byte("invalid"), 0o600))
+	require.Error(t, server.Reloader.Reload())
+	require.NoError(t, getWithClientCert(t, ln, server, clientB))
+
+	require.Len(t, reloads, 2)
+	require.NoError(t, reloads[0])
+	require.Error(t, reloads[1])
+}
+
+// TestTLSReloaderWatchCRL tests that the listeners reject the client
+// certificates revoked by a CRL file changed after they start.
+func TestTLSReloaderWatchCRL(t *testing.T) {
+	server, err := createSelfCert(t)
+	require.NoError(t, err)
+	client, err := createSelfCertEx(t, "127.0.0.1", x509.ExtKeyUsageClientAuth)
+	require.NoError(t, err)
+	clientCert, err := x509.ParseCertificate(loadPEM(t, client.CertFile))
+	require.NoError(t, err)
+
+	server.TrustedCAFile = client.CertFile
+	server.CRLFile = filepath.Join(t.TempDir(), "revoked.crl")
+	writeCRL(t, server.CRLFile, server)
+
+	var mu sync.Mutex
+	reloads := 0
+	server.Reloader, err = NewTLSReloader(zaptest.NewLogger(t), *server, func(err error) {
+		assert.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		reloads++
+	})
+	require.NoError(t, err)
+	stop := make(chan struct{})
+	defer close(stop)
+	go server.Reloader.Watch(stop, 10*time.Millisecond)
+	ln := serveTLS(t, server)
+
+	require.NoError(t, getWithClientCert(t, ln, server, client))
+	// the files did not change
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	require.Equal(t, 0, reloads)
+	mu.Unlock()
+
+	writeCRL(t, server.CRLFile, server, clientCert)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return reloads == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Error(t, getWithClientCert(t, ln, server, client))
+}
+
+// TestTLSReloaderTransport tests that the transports verify the servers
+// against the reloaded CA file.
+func TestTLSReloaderTransport(t *testing.T) {
+	server, err := createSelfCert(t)
+	require.NoError(t, err)
+	other, err := createSelfCert(t)
+	require.NoError(t, err)
+	ln := serveTLS(t, server)
+
+	client := TLSInfo{TrustedCAFile: filepath.Join(t.TempDir(), "ca.crt")}
+	concatFiles(t, client.TrustedCAFile, other.CertFile)
+	client.Reloader, err = NewTLSReloader(zaptest.NewLogger(t), client, nil)
+	require.NoError(t, err)
+	tr, err := NewTransport(client, time.Second)
+	require.NoError(t, err)
+	cli := &http.Client{Transport: tr, Timeout: 5 * time.Second}
+
+	_, err = cli.Get("https://" + ln.Addr().String())
+	var uerr x509.UnknownAuthorityError
+	require.ErrorAs(t, err, &uerr)
+
+	concatFiles(t, client.TrustedCAFile, server.CertFile)
+	require.NoError(t, client.Reloader.Reload())
+	resp, err := cli.Get("https://" + ln.Addr().String())
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// the server name is still verified
+	_, err = cli.Get(fmt.Sprintf("https://localhost:%d", ln.Addr().(*net.TCPAddr).Port))
+	var herr x509.HostnameError
+	require.ErrorAs(t, err, &herr)
+}
@@ -70,6 +70,10 @@ func NewTransport(info TLSInfo, dialtimeoutd time.Duration) (*http.Transport, er
 		// forward it to 'tu' as well.
 		IdleConnTimeout: time.Microsecond,
 	}
+	if info.Reloader != nil {
+		t.DialTLSContext = info.Reloader.dialTLSContext(t)
+		tu.DialTLSContext = info.Reloader.dialTLSContext(tu)
+	}
 	ut := &unixTransport{tu}
 
 	t.RegisterProtocol("unix", ut)
@@ -52,6 +52,7 @@ const (
 	PermRead      = authpb.READ
 	PermWrite     = authpb.WRITE
 	PermReadWrite = authpb.READWRITE
+	PermAdmin     = authpb.ADMIN
 )
 
 type UserAddOptions authpb.UserAddOptions
@@ -99,6 +100,10 @@ type Auth interface {
 	// RoleGrantPermission grants a permission to a role.
 	RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
 
+	// RoleDenyPermission denies a permission to a role. The deny permission takes
+	// precedence over the permissions of the roles of a user granting the access.
+	RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
+
 	// RoleGet gets a detailed information of a role.
 	RoleGet(ctx context.Context, role string) (*AuthRoleGetResponse, error)
 
@@ -208,6 +213,17 @@ func (auth *authClient) RoleGrantPermission(ctx context.Context, name string, ke
 	return (*AuthRoleGrantPermissionResponse)(resp), ContextError(ctx, err)
 }
 
+func (auth *authClient) RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error) {
+	perm := &authpb.Permission{
+		Key:      []byte(key),
+		RangeEnd: []byte(rangeEnd),
+		PermType: authpb.Permission_Type(permType),
+		Deny:     true,
+	}
+	resp, err := auth.remote.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: name, Perm: perm}, auth.callOpts...)
+	return (*AuthRoleGrantPermissionResponse)(resp), ContextError(ctx, err)
+}
+
 func (auth *authClient) RoleGet(ctx context.Context, role string) (*AuthRoleGetResponse, error) {
 	resp, err := auth.remote.RoleGet(ctx, &pb.AuthRoleGetRequest{Role: role}, auth.callOpts...)
 	return (*AuthRoleGetResponse)(resp), ContextError(ctx, err)
@@ -464,6 +464,10 @@ func (mm mockMaintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotRes
 	return nil, nil
 }
 
+func (mm mockMaintenance) SnapshotDelta(ctx context.Context, sinceRev, rev int64) (*SnapshotResponse, error) {
+	return nil, nil
+}
+
 func (mm mockMaintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 	return nil, nil
 }
@@ -476,6 +480,22 @@ func (mm mockMaintenance) Downgrade(ctx context.Context, action DowngradeAction,
 	return nil, nil
 }
 
+func (mm mockMaintenance) PrefixQuotaList(ctx context.Context) (*PrefixQuotaResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) PrefixQuotaPut(ctx context.Context, prefix string, quotaBytes, quotaKeys int64) (*PrefixQuotaResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) PrefixQuotaDelete(ctx context.Context, prefix string) (*PrefixQuotaResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) ReloadConfig(ctx context.Context, endpoint string) (*ReloadConfigResponse, error) {
+	return nil, nil
+}
+
 type mockAuthServer struct {
 	*etcdserverpb.UnimplementedAuthServer
 }
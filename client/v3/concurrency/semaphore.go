@@ -0,0 +1,220 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+)
+
+var (
+	ErrSemaphoreFull     = errors.New("semaphore: not enough capacity")
+	ErrInvalidWeight     = errors.New("semaphore: weight must be positive and at most the capacity")
+	ErrSemaphoreReleased = errors.New("semaphore: semaphore has already been released")
+)
+
+// Semaphore is a weighted semaphore shared by the sessions using the same
+// prefix and capacity. The sessions acquire it in the order they asked for
+// it: a session holds the semaphore once the weights of the sessions asking
+// before it, plus its own weight, are at most the capacity, so a session
+// asking for a large weight is not starved by later sessions asking for small
+// weights.
+type Semaphore struct {
+	s *Session
+
+	pfx      string
+	capacity int64
+	myKey    string
+	myRev    int64
+	hdr      *pb.ResponseHeader
+}
+
+// NewSemaphore creates a semaphore of the given capacity on the prefix pfx.
+// All the sessions sharing the semaphore must use the same capacity.
+func NewSemaphore(s *Session, pfx string, capacity int64) *Semaphore {
+	return &Semaphore{s: s, pfx: pfx + "/", capacity: capacity, myRev: -1}
+}
+
+// TryAcquire acquires the semaphore with the given weight if there is enough
+// capacity and no session asked for it before, or returns ErrSemaphoreFull.
+// The ctx argument is used for the sending/receiving Txn RPC.
+func (sm *Semaphore) TryAcquire(ctx context.Context, weight int64) error {
+	resp, err := sm.tryAcquire(ctx, weight)
+	if err != nil {
+		return err
+	}
+	ok, err := sm.acquired(resp.Responses[1].GetResponseRange().Kvs)
+	if err != nil || ok {
+		if ok {
+			sm.hdr = resp.Header
+		}
+		return err
+	}
+	client := sm.s.Client()
+	// Cannot acquire, so delete the key
+	if _, err := client.Delete(ctx, sm.myKey); err != nil {
+		return err
+	}
+	sm.myKey = "\x00"
+	sm.myRev = -1
+	return ErrSemaphoreFull
+}
+
+// Acquire acquires the semaphore with the given weight, waiting until the
+// sessions asking before it released enough capacity. If the context is
+// canceled while waiting, the semaphore tries to clean its stale key.
+func (sm *Semaphore) Acquire(ctx context.Context, weight int64) error {
+	resp, err := sm.tryAcquire(ctx, weight)
+	if err != nil {
+		return err
+	}
+	ok, err := sm.acquired(resp.Responses[1].GetResponseRange().Kvs)
+	if err == nil && !ok {
+		err = sm.waitCapacity(ctx, resp.Header.Revision)
+	}
+	client := sm.s.Client()
+	// release the key if wait failed
+	if err != nil {
+		sm.Release(client.Ctx())
+		return err
+	}
+	if ok {
+		sm.hdr = resp.Header
+		return nil
+	}
+
+	// make sure the session is not expired, and the key still exists.
+	gresp, err := client.Get(ctx, sm.myKey)
+	if err != nil {
+		sm.Release(client.Ctx())
+		return err
+	}
+	if len(gresp.Kvs) == 0 { // is the session key lost?
+		return ErrSessionExpired
+	}
+	sm.hdr = gresp.Header
+	return nil
+}
+
+func (sm *Semaphore) tryAcquire(ctx context.Context, weight int64) (*v3.TxnResponse, error) {
+	if weight <= 0 || weight > sm.capacity {
+		return nil, ErrInvalidWeight
+	}
+	s := sm.s
+	client := sm.s.Client()
+
+	sm.myKey = fmt.Sprintf("%s%x", sm.pfx, s.Lease())
+	cmp := v3.Compare(v3.CreateRevision(sm.myKey), "=", 0)
+	// put self in the semaphore waiters via myKey, with its weight as value
+	put := v3.OpPut(sm.myKey, strconv.FormatInt(weight, 10), v3.WithLease(s.Lease()))
+	// reuse key in case this session already holds the semaphore
+	get := v3.OpGet(sm.myKey)
+	// fetch the waiters to complete uncontended path with only one RPC
+	getWaiters := v3.OpGet(sm.pfx, v3.WithPrefix(), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend))
+	resp, err := client.Txn(ctx).If(cmp).Then(put, getWaiters).Else(get, getWaiters).Commit()
+	if err != nil {
+		return nil, err
+	}
+	sm.myRev = resp.Header.Revision
+	if !resp.Succeeded {
+		sm.myRev = resp.Responses[0].GetResponseRange().Kvs[0].CreateRevision
+	}
+	return resp, nil
+}
+
+// acquired returns true if the weights of the waiters up to myKey, sorted by
+// create revision, are at most the capacity.
+func (sm *Semaphore) acquired(kvs []*mvccpb.KeyValue) (bool, error) {
+	var total int64
+	for _, kv := range kvs {
+		if kv.CreateRevision > sm.myRev {
+			break
+		}
+		weight, err := strconv.ParseInt(string(kv.Value), 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("semaphore: invalid weight %q of key %q", kv.Value, kv.Key)
+		}
+		total += weight
+	}
+	return total <= sm.capacity, nil
+}
+
+// waitCapacity waits until the weights of the waiters up to myKey are at
+// most the capacity, checking them again on every key deleted after rev.
+func (sm *Semaphore) waitCapacity(ctx context.Context, rev int64) error {
+	client := sm.s.Client()
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wch := client.Watch(cctx, sm.pfx, v3.WithPrefix(), v3.WithRev(rev+1), v3.WithFilterPut())
+	var wr v3.WatchResponse
+	for wr = range wch {
+		if len(wr.Events) == 0 {
+			continue
+		}
+		resp, err := client.Get(ctx, sm.pfx, v3.WithPrefix(), v3.WithMaxCreateRev(sm.myRev), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend))
+		if err != nil {
+			return err
+		}
+		ok, err := sm.acquired(resp.Kvs)
+		if err != nil || ok {
+			return err
+		}
+	}
+	if err := wr.Err(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.New("lost watcher waiting for capacity")
+}
+
+// Release releases the semaphore.
+func (sm *Semaphore) Release(ctx context.Context) error {
+	if sm.myKey == "" || sm.myRev <= 0 || sm.myKey == "\x00" {
+		return ErrSemaphoreReleased
+	}
+
+	if !strings.HasPrefix(sm.myKey, sm.pfx) {
+		return fmt.Errorf("invalid key %q, it should have prefix %q", sm.myKey, sm.pfx)
+	}
+
+	client := sm.s.Client()
+	if _, err := client.Delete(ctx, sm.myKey); err != nil {
+		return err
+	}
+	sm.myKey = "\x00"
+	sm.myRev = -1
+	return nil
+}
+
+// IsOwner returns a comparison that is true while the session holds the
+// semaphore, to guard the transactions of the holder.
+func (sm *Semaphore) IsOwner() v3.Cmp {
+	return v3.Compare(v3.CreateRevision(sm.myKey), "=", sm.myRev)
+}
+
+func (sm *Semaphore) Key() string { return sm.myKey }
+
+// Header is the response header received from etcd on acquiring the semaphore.
+func (sm *Semaphore) Header() *pb.ResponseHeader { return sm.hdr }
@@ -0,0 +1,275 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	v3 "go.etcd.io/etcd/client/v3"
+)
+
+// workQueueBatchSize is the number of items fetched at once by Dequeue. It
+// stays below the default maximum number of operations in a txn, as the
+// claims of a batch are fetched in a single txn.
+const workQueueBatchSize = 64
+
+// ErrClaimLost is returned when acknowledging a work item whose visibility
+// timeout expired, as the item may have been redelivered.
+var ErrClaimLost = errors.New("work item claim lost")
+
+// WorkQueue implements a multi-reader, multi-writer distributed queue whose
+// items are only deleted once acknowledged. A dequeued item is claimed with a
+// lease of the visibility timeout, and is redelivered once the lease expires
+// or the item is negatively acknowledged. An item delivered the maximum
+// number of attempts without being acknowledged is moved under the dead-letter
+// prefix instead of being redelivered.
+//
+// The items are stored under <keyPrefix>/items, their claims under
+// <keyPrefix>/claims and the dead-letter items under <keyPrefix>/dead.
+type WorkQueue struct {
+	client *v3.Client
+
+	keyPrefix         string
+	visibilityTimeout time.Duration
+	maxAttempts       int
+}
+
+// workItemValue is the stored value of a work item.
+type workItemValue struct {
+	Value    string `json:"value"`
+	Attempts int    `json:"attempts"`
+}
+
+// NewWorkQueue creates a work queue redelivering the items not acknowledged
+// within the visibility timeout, at least one second, up to maxAttempts
+// deliveries. Zero maxAttempts never moves the items to the dead-letter prefix.
+func NewWorkQueue(client *v3.Client, keyPrefix string, visibilityTimeout time.Duration, maxAttempts int) *WorkQueue {
+	return &WorkQueue{client, keyPrefix, visibilityTimeout, maxAttempts}
+}
+
+func (q *WorkQueue) itemsPrefix() string  { return q.keyPrefix + "/items/" }
+func (q *WorkQueue) claimsPrefix() string { return q.keyPrefix + "/claims/" }
+
+// DeadLetterPrefix returns the prefix of the items delivered the maximum
+// number of attempts.
+func (q *WorkQueue) DeadLetterPrefix() string { return q.keyPrefix + "/dead/" }
+
+func (q *WorkQueue) Enqueue(val string) error {
+	v, err := json.Marshal(workItemValue{Value: val})
+	if err != nil {
+		return err
+	}
+	_, err = newUniqueKV(q.client, strings.TrimSuffix(q.itemsPrefix(), "/"), string(v))
+	return err
+}
+
+// Dequeue claims the first Enqueue()'d item that is not claimed. If there is
+// none, Dequeue blocks until an item is enqueued or a claim is released.
+func (q *WorkQueue) Dequeue(ctx context.Context) (*WorkItem, error) {
+	// the items created before minRev are claimed since scanRev, the
+	// revision of the first batch of the scan.
+	minRev, scanRev := int64(0), int64(0)
+	for {
+		resp, err := q.client.Get(ctx, q.itemsPrefix(),
+			v3.WithPrefix(),
+			v3.WithSort(v3.SortByCreateRevision, v3.SortAscend),
+			v3.WithMinCreateRev(minRev),
+			v3.WithLimit(workQueueBatchSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if minRev == 0 {
+			scanRev = resp.Header.Revision
+		}
+		claimed, err := q.claimed(ctx, resp.Kvs)
+		if err != nil {
+			return nil, err
+		}
+		for i, kv := range resp.Kvs {
+			if claimed[i] {
+				continue
+			}
+			item, err := q.claim(ctx, kv)
+			if err != nil || item != nil {
+				return item, err
+			}
+		}
+		if resp.More {
+			minRev = resp.Kvs[len(resp.Kvs)-1].CreateRevision + 1
+			continue
+		}
+
+		// nothing available yet; wait on enqueued items or released claims
+		if err := q.waitAvailable(ctx, scanRev); err != nil {
+			return nil, err
+		}
+		// a released claim makes an older item available again
+		minRev = 0
+	}
+}
+
+// claimed returns whether each item of kvs is claimed.
+func (q *WorkQueue) claimed(ctx context.Context, kvs []*mvccpb.KeyValue) ([]bool, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+	ops := make([]v3.Op, len(kvs))
+	for i, kv := range kvs {
+		ops[i] = v3.OpGet(q.claimsPrefix()+strings.TrimPrefix(string(kv.Key), q.itemsPrefix()), v3.WithCountOnly())
+	}
+	resp, err := q.client.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]bool, len(kvs))
+	for i, r := range resp.Responses {
+		claimed[i] = r.GetResponseRange().Count > 0
+	}
+	return claimed, nil
+}
+
+// claim claims the item of kv, or moves it to the dead-letter prefix if it
+// was delivered the maximum number of attempts. It returns nil if the item
+// was claimed by another client meanwhile.
+func (q *WorkQueue) claim(ctx context.Context, kv *mvccpb.KeyValue) (*WorkItem, error) {
+	var v workItemValue
+	if err := json.Unmarshal(kv.Value, &v); err != nil {
+		return nil, err
+	}
+	id := strings.TrimPrefix(string(kv.Key), q.itemsPrefix())
+	itemKey, claimKey := string(kv.Key), q.claimsPrefix()+id
+	unclaimed := []v3.Cmp{
+		v3.Compare(v3.ModRevision(itemKey), "=", kv.ModRevision),
+		v3.Compare(v3.CreateRevision(claimKey), "=", 0),
+	}
+
+	if q.maxAttempts > 0 && v.Attempts >= q.maxAttempts {
+		_, err := q.client.Txn(ctx).If(unclaimed...).Then(
+			v3.OpDelete(itemKey),
+			v3.OpPut(q.DeadLetterPrefix()+id, v.Value),
+		).Commit()
+		return nil, err
+	}
+
+	lresp, err := q.client.Grant(ctx, max(int64(q.visibilityTimeout/time.Second), 1))
+	if err != nil {
+		return nil, err
+	}
+	v.Attempts++
+	val, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := q.client.Txn(ctx).If(unclaimed...).Then(
+		v3.OpPut(itemKey, string(val)),
+		v3.OpPut(claimKey, "", v3.WithLease(lresp.ID)),
+	).Commit()
+	if err != nil || !resp.Succeeded {
+		q.client.Revoke(q.client.Ctx(), lresp.ID)
+		return nil, err
+	}
+	return &WorkItem{
+		q:        q,
+		itemKey:  itemKey,
+		claimKey: claimKey,
+		claimRev: resp.Header.Revision,
+		lease:    lresp.ID,
+		Value:    v.Value,
+		Attempts: v.Attempts,
+	}, nil
+}
+
+// waitAvailable waits until an item is enqueued or a claim is released after rev.
+func (q *WorkQueue) waitAvailable(ctx context.Context, rev int64) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wch := q.client.Watch(cctx, q.keyPrefix+"/", v3.WithPrefix(), v3.WithRev(rev+1))
+	var wr v3.WatchResponse
+	for wr = range wch {
+		for _, ev := range wr.Events {
+			key := string(ev.Kv.Key)
+			if (ev.Type == mvccpb.PUT && ev.IsCreate() && strings.HasPrefix(key, q.itemsPrefix())) ||
+				(ev.Type == mvccpb.DELETE && strings.HasPrefix(key, q.claimsPrefix())) {
+				return nil
+			}
+		}
+	}
+	if err := wr.Err(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return ErrNoWatcher
+}
+
+// WorkItem is an item claimed by WorkQueue.Dequeue.
+type WorkItem struct {
+	q        *WorkQueue
+	itemKey  string
+	claimKey string
+	claimRev int64
+	lease    v3.LeaseID
+
+	// Value is the enqueued value of the item.
+	Value string
+	// Attempts is the number of deliveries of the item, including this one.
+	Attempts int
+}
+
+// Ack acknowledges the item, deleting it from the queue. It returns
+// ErrClaimLost if the visibility timeout of the item expired.
+func (wi *WorkItem) Ack(ctx context.Context) error {
+	return wi.release(ctx, v3.OpDelete(wi.itemKey), v3.OpDelete(wi.claimKey))
+}
+
+// Nack negatively acknowledges the item, releasing its claim so it is
+// redelivered. It returns ErrClaimLost if the visibility timeout of the item
+// expired.
+func (wi *WorkItem) Nack(ctx context.Context) error {
+	return wi.release(ctx, v3.OpDelete(wi.claimKey))
+}
+
+func (wi *WorkItem) release(ctx context.Context, ops ...v3.Op) error {
+	cli := wi.q.client
+	resp, err := cli.Txn(ctx).If(v3.Compare(v3.ModRevision(wi.claimKey), "=", wi.claimRev)).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	// the lease only holds the claim, so failing to revoke it only delays its expiry.
+	cli.Revoke(ctx, wi.lease)
+	if !resp.Succeeded {
+		return ErrClaimLost
+	}
+	return nil
+}
+
+// Extend renews the visibility timeout of the item. It returns ErrClaimLost if
+// the visibility timeout of the item expired.
+func (wi *WorkItem) Extend(ctx context.Context) error {
+	_, err := wi.q.client.KeepAliveOnce(ctx, wi.lease)
+	if errors.Is(err, rpctypes.ErrLeaseNotFound) {
+		return ErrClaimLost
+	}
+	return err
+}
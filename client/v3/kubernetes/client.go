@@ -84,7 +84,7 @@ func (k Client) OptimisticPut(ctx context.Context, key string, value []byte, exp
 	txn := k.KV.Txn(ctx).If(
 		clientv3.Compare(clientv3.ModRevision(key), "=", expectedRevision),
 	).Then(
-		clientv3.OpPut(key, string(value), clientv3.WithLease(opts.LeaseID)),
+		clientv3.OpPut(key, string(value), clientv3.WithLease(opts.LeaseID), clientv3.WithTTL(opts.TTL)),
 	)
 
 	if opts.GetOnFailure {
@@ -91,6 +91,10 @@ type PutOptions struct {
 	// LeaseID is the ID of a lease to associate with the key allowing for automatic deletion after lease expires after it's TTL (time to live).
 	// Deprecated: Should be replaced with TTL when Interface starts using one lease per object.
 	LeaseID clientv3.LeaseID
+
+	// TTL is the time-to-live in seconds of the key. The key is deleted once the TTL elapses
+	// unless it is put again. It cannot be combined with LeaseID.
+	TTL int64
 }
 
 type DeleteOptions struct {
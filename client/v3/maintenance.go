@@ -35,6 +35,11 @@ type (
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
 
+	PrefixQuota         pb.PrefixQuota
+	PrefixQuotaResponse pb.PrefixQuotaResponse
+
+	ReloadConfigResponse pb.ReloadConfigResponse
+
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
 
@@ -79,6 +84,14 @@ type Maintenance interface {
 	// Deprecated: use SnapshotWithVersion instead.
 	Snapshot(ctx context.Context) (io.ReadCloser, error)
 
+	// SnapshotDelta returns a reader for every change of the key-value store in the
+	// revision range (sinceRev, rev] of the member. If rev is zero, the delta ends at
+	// the current revision of the member. It fails if sinceRev has been compacted.
+	// If the context "ctx" is canceled or timed out, reading from returned
+	// "io.ReadCloser" would error out (e.g. context.Canceled, context.DeadlineExceeded).
+	// Supported since etcd 3.7.
+	SnapshotDelta(ctx context.Context, sinceRev, rev int64) (*SnapshotResponse, error)
+
 	// MoveLeader requests current leader to transfer its leadership to the transferee.
 	// Request must be made to the leader.
 	MoveLeader(ctx context.Context, transfereeID uint64) (*MoveLeaderResponse, error)
@@ -87,6 +100,25 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// PrefixQuotaList gets all prefix quotas together with the current usage of their prefixes.
+	// Supported since etcd 3.7.
+	PrefixQuotaList(ctx context.Context) (*PrefixQuotaResponse, error)
+
+	// PrefixQuotaPut limits the combined size of keys and values and the number of keys
+	// under the given prefix. A zero limit leaves that dimension unlimited.
+	// Supported since etcd 3.7.
+	PrefixQuotaPut(ctx context.Context, prefix string, quotaBytes, quotaKeys int64) (*PrefixQuotaResponse, error)
+
+	// PrefixQuotaDelete removes the quota of the given prefix.
+	// Supported since etcd 3.7.
+	PrefixQuotaDelete(ctx context.Context, prefix string) (*PrefixQuotaResponse, error)
+
+	// ReloadConfig reloads the configuration of a given etcd member, usually from its
+	// configuration file, and applies the changed settings which can be changed without
+	// a restart. The response lists the changed settings which need a restart.
+	// Supported since etcd 3.7.
+	ReloadConfig(ctx context.Context, endpoint string) (*ReloadConfigResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -270,6 +302,42 @@ func (m *maintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotRespons
 	}, nil
 }
 
+func (m *maintenance) SnapshotDelta(ctx context.Context, sinceRev, rev int64) (*SnapshotResponse, error) {
+	req := &pb.SnapshotDeltaRequest{SinceRevision: sinceRev, Revision: rev}
+	ss, err := m.remote.SnapshotDelta(ctx, req, append(m.callOpts, withMax(defaultStreamMaxRetries))...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+
+	m.lg.Info("opened snapshot delta stream; downloading")
+	pr, pw := io.Pipe()
+
+	resp, err := ss.Recv()
+	if err != nil {
+		m.logAndCloseWithError(err, pw)
+		return nil, ContextError(ctx, err)
+	}
+	go func() {
+		sresp := resp
+		for {
+			if _, err := pw.Write(sresp.Blob); err != nil {
+				m.logAndCloseWithError(err, pw)
+				return
+			}
+			if sresp, err = ss.Recv(); err != nil {
+				m.logAndCloseWithError(err, pw)
+				return
+			}
+		}
+	}()
+
+	return &SnapshotResponse{
+		Header:   resp.GetHeader(),
+		Snapshot: &snapshotReadCloser{ctx: ctx, ReadCloser: pr},
+		Version:  resp.GetVersion(),
+	}, nil
+}
+
 func (m *maintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 	ss, err := m.remote.Snapshot(ctx, &pb.SnapshotRequest{}, append(m.callOpts, withMax(defaultStreamMaxRetries))...)
 	if err != nil {
@@ -348,3 +416,53 @@ func (m *maintenance) Downgrade(ctx context.Context, action DowngradeAction, ver
 	resp, err := m.remote.Downgrade(ctx, &pb.DowngradeRequest{Action: actionType, Version: version}, m.callOpts...)
 	return (*DowngradeResponse)(resp), ContextError(ctx, err)
 }
+
+func (m *maintenance) PrefixQuotaList(ctx context.Context) (*PrefixQuotaResponse, error) {
+	req := &pb.PrefixQuotaRequest{Action: pb.PrefixQuotaRequest_GET}
+	resp, err := m.remote.PrefixQuota(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*PrefixQuotaResponse)(resp), nil
+}
+
+func (m *maintenance) PrefixQuotaPut(ctx context.Context, prefix string, quotaBytes, quotaKeys int64) (*PrefixQuotaResponse, error) {
+	req := &pb.PrefixQuotaRequest{
+		Action: pb.PrefixQuotaRequest_PUT,
+		Quota: &pb.PrefixQuota{
+			Prefix:     []byte(prefix),
+			QuotaBytes: quotaBytes,
+			QuotaKeys:  quotaKeys,
+		},
+	}
+	resp, err := m.remote.PrefixQuota(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*PrefixQuotaResponse)(resp), nil
+}
+
+func (m *maintenance) PrefixQuotaDelete(ctx context.Context, prefix string) (*PrefixQuotaResponse, error) {
+	req := &pb.PrefixQuotaRequest{
+		Action: pb.PrefixQuotaRequest_DELETE,
+		Quota:  &pb.PrefixQuota{Prefix: []byte(prefix)},
+	}
+	resp, err := m.remote.PrefixQuota(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*PrefixQuotaResponse)(resp), nil
+}
+
+func (m *maintenance) ReloadConfig(ctx context.Context, endpoint string) (*ReloadConfigResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.ReloadConfig(ctx, &pb.ReloadConfigRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*ReloadConfigResponse)(resp), nil
+}
@@ -0,0 +1,512 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const defaultMaxTxnOps = 128
+
+// ConflictPolicy decides which update wins when a key is updated in both
+// clusters before the mirror mirrored either update.
+type ConflictPolicy string
+
+const (
+	// LastWriterWins keeps the update the mirror receives last. The update
+	// being mirrored is dropped, since the concurrent update of the other
+	// cluster is received after it and mirrored over it, so both clusters
+	// converge on the same value.
+	LastWriterWins ConflictPolicy = "last-writer-wins"
+	// SkipAndReport mirrors neither update, leaving each cluster with its own
+	// value of the key until it is updated again.
+	SkipAndReport ConflictPolicy = "skip-and-report"
+)
+
+// Conflict is an update dropped as the key was updated concurrently in the
+// other cluster.
+type Conflict struct {
+	// Key is the key in the cluster the update was received from.
+	Key string
+	// Revision is the revision of the update.
+	Revision int64
+	// FromDestination is true if the update was received from the destination
+	// cluster, and false if it was received from the source cluster.
+	FromDestination bool
+}
+
+// BidirectionalConfig configures a Bidirectional mirror.
+type BidirectionalConfig struct {
+	// Prefix is the mirrored prefix of the source cluster, and DestPrefix the
+	// prefix of the destination cluster it is mirrored with.
+	Prefix     string
+	DestPrefix string
+	// CheckpointKey stores, in each cluster, the last revision of the other
+	// cluster mirrored to it. Its updates are never mirrored. Mirrors of
+	// different prefixes between the same clusters need different keys.
+	CheckpointKey string
+	// ConflictPolicy resolves the keys updated concurrently in both clusters.
+	ConflictPolicy ConflictPolicy
+	// OnConflict, if set, is called with every update dropped because of a
+	// conflict.
+	OnConflict func(Conflict)
+	// MaxTxnOps is the maximum number of operations of the transactions
+	// mirroring the updates, including the checkpoint update.
+	MaxTxnOps int
+}
+
+// Bidirectional mirrors a prefix between two clusters in both directions.
+//
+// Every transaction of the mirror also puts the checkpoint key, which tags
+// the revisions written by the mirror so they are not mirrored back. An
+// update conflicts with the other cluster if the key was updated there after
+// the last revision of that cluster the mirror handled, by a write other than
+// the mirror's own. The conflicting updates are resolved by ConflictPolicy.
+//
+// On its first run, the mirror copies the prefix of the source cluster to the
+// destination cluster. The keys only present in the destination cluster are
+// left as is.
+type Bidirectional struct {
+	cfg      BidirectionalConfig
+	src, dst *side
+	mirrored atomic.Int64
+}
+
+// side is the state of one of the clusters of a bidirectional mirror.
+type side struct {
+	c      *clientv3.Client
+	prefix string
+	isDest bool
+	peer   *side
+
+	// rev is the last revision of the cluster whose updates were handled.
+	rev int64
+	// head is the revision of the cluster when the mirror started. The writes
+	// of the mirror up to head are found from the history of the checkpoint
+	// key, and the later ones are tracked in written.
+	head int64
+	// written holds the revisions after rev written by the mirror.
+	written map[int64]struct{}
+	// overwritten holds the keys written by the mirror after rev.
+	overwritten map[string]overwrite
+	// skipped holds the keys whose updates, up to the revision, conflicted
+	// with an update of the peer and must not be mirrored.
+	skipped map[string]int64
+}
+
+type overwrite struct {
+	rev     int64
+	deleted bool
+}
+
+// NewBidirectional creates a bidirectional mirror of the prefix cfg.Prefix of
+// the source cluster c with the prefix cfg.DestPrefix of the destination
+// cluster dc.
+func NewBidirectional(c, dc *clientv3.Client, cfg BidirectionalConfig) *Bidirectional {
+	if cfg.ConflictPolicy == "" {
+		cfg.ConflictPolicy = LastWriterWins
+	}
+	if cfg.MaxTxnOps <= 0 {
+		cfg.MaxTxnOps = defaultMaxTxnOps
+	}
+	src := newSide(c, cfg.Prefix, false)
+	dst := newSide(dc, cfg.DestPrefix, true)
+	src.peer, dst.peer = dst, src
+	return &Bidirectional{cfg: cfg, src: src, dst: dst}
+}
+
+func newSide(c *clientv3.Client, prefix string, isDest bool) *side {
+	return &side{
+		c:           c,
+		prefix:      prefix,
+		isDest:      isDest,
+		written:     make(map[int64]struct{}),
+		overwritten: make(map[string]overwrite),
+		skipped:     make(map[string]int64),
+	}
+}
+
+// Mirrored returns the number of updates mirrored so far.
+func (b *Bidirectional) Mirrored() int64 { return b.mirrored.Load() }
+
+// Run mirrors the updates of both clusters until ctx is canceled or an error
+// occurs. It returns rpctypes.ErrCompacted if the updates since the last
+// checkpoint of a cluster were compacted.
+func (b *Bidirectional) Run(ctx context.Context) error {
+	switch b.cfg.ConflictPolicy {
+	case LastWriterWins, SkipAndReport:
+	default:
+		return fmt.Errorf("mirror: unknown conflict policy %q", b.cfg.ConflictPolicy)
+	}
+	if b.cfg.CheckpointKey == "" {
+		return errors.New("mirror: empty checkpoint key")
+	}
+	if err := b.start(ctx); err != nil {
+		return err
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	srcc := b.src.c.Watch(wctx, b.src.prefix, clientv3.WithPrefix(), clientv3.WithRev(b.src.rev+1))
+	dstc := b.dst.c.Watch(wctx, b.dst.prefix, clientv3.WithPrefix(), clientv3.WithRev(b.dst.rev+1))
+	for {
+		var (
+			s  *side
+			wr clientv3.WatchResponse
+			ok bool
+		)
+		select {
+		case wr, ok = <-srcc:
+			s = b.src
+		case wr, ok = <-dstc:
+			s = b.dst
+		}
+		if !ok {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return errors.New("mirror: watch closed")
+		}
+		if err := wr.Err(); err != nil {
+			return err
+		}
+		if err := b.mirrorEvents(ctx, s, wr.Events); err != nil {
+			return err
+		}
+	}
+}
+
+// start loads the checkpoints of both clusters, copying the source prefix to
+// the destination cluster if it was never mirrored.
+func (b *Bidirectional) start(ctx context.Context) error {
+	// the checkpoint of a cluster is stored in its peer.
+	srcCheckpoint, err := b.dst.loadCheckpoint(ctx, b.cfg.CheckpointKey)
+	if err != nil {
+		return err
+	}
+	if srcCheckpoint == 0 {
+		if err = b.copySource(ctx); err != nil {
+			return err
+		}
+	}
+	if _, err = b.src.loadCheckpoint(ctx, b.cfg.CheckpointKey); err != nil {
+		return err
+	}
+	if b.dst.rev == 0 {
+		// start from the current revision of the destination cluster.
+		b.dst.rev = b.dst.head
+		if _, err = b.src.c.Put(ctx, b.cfg.CheckpointKey, strconv.FormatInt(b.dst.rev, 10)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadCheckpoint loads the checkpoint of the peer of s stored in s, and the
+// current revision of s. It returns 0 if there is no checkpoint.
+func (s *side) loadCheckpoint(ctx context.Context, key string) (int64, error) {
+	resp, err := s.c.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	s.head = resp.Header.Revision
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	rev, err := strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("mirror: invalid checkpoint %q of key %q", resp.Kvs[0].Value, key)
+	}
+	s.peer.rev = rev
+	return rev, nil
+}
+
+// copySource copies the source prefix, at its current revision, to the
+// destination cluster.
+func (b *Bidirectional) copySource(ctx context.Context) error {
+	resp, err := b.src.c.Get(ctx, b.cfg.CheckpointKey)
+	if err != nil {
+		return err
+	}
+	rev := resp.Header.Revision
+
+	rc, errc := NewSyncer(b.src.c, b.src.prefix, rev).SyncBase(ctx)
+	var ops []clientv3.Op
+	for r := range rc {
+		for _, kv := range r.Kvs {
+			if string(kv.Key) == b.cfg.CheckpointKey {
+				continue
+			}
+			ops = append(ops, clientv3.OpPut(b.src.peerKey(string(kv.Key)), string(kv.Value)))
+			b.mirrored.Add(1)
+			// leave room for the checkpoint in the last transaction.
+			if len(ops) == max(b.cfg.MaxTxnOps-1, 1) {
+				if _, err = b.dst.c.Txn(ctx).Then(ops...).Commit(); err != nil {
+					return err
+				}
+				ops = ops[:0]
+			}
+		}
+	}
+	if err = <-errc; err != nil {
+		return err
+	}
+	ops = append(ops, clientv3.OpPut(b.cfg.CheckpointKey, strconv.FormatInt(rev, 10)))
+	tresp, err := b.dst.c.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	b.src.rev = rev
+	// the copy is not watched, as the destination cluster is watched from
+	// its revision after the copy.
+	b.dst.head = tresp.Header.Revision
+	return nil
+}
+
+// mirrorEvents mirrors the events of s to its peer, one revision at a time.
+func (b *Bidirectional) mirrorEvents(ctx context.Context, s *side, evs []*clientv3.Event) error {
+	for len(evs) > 0 {
+		n := 1
+		for n < len(evs) && evs[n].Kv.ModRevision == evs[0].Kv.ModRevision {
+			n++
+		}
+		if err := b.mirrorRevision(ctx, s, evs[:n]); err != nil {
+			return err
+		}
+		s.advance(evs[0].Kv.ModRevision)
+		evs = evs[n:]
+	}
+	return nil
+}
+
+// mirrorRevision mirrors the events of a revision of s to its peer, unless
+// the revision was written by the mirror.
+func (b *Bidirectional) mirrorRevision(ctx context.Context, s *side, evs []*clientv3.Event) error {
+	rev := evs[0].Kv.ModRevision
+	written, err := s.isWritten(ctx, b.cfg.CheckpointKey, rev)
+	if err != nil || written {
+		return err
+	}
+
+	var (
+		updates int
+		pending []*clientv3.Event
+	)
+	for _, ev := range evs {
+		key := string(ev.Kv.Key)
+		if key == b.cfg.CheckpointKey {
+			continue
+		}
+		updates++
+		if skipped, ok := s.skipped[key]; ok && rev <= skipped {
+			// the conflict was reported with the update of the peer.
+			continue
+		}
+		ow, err := s.overwrittenAfter(ctx, b.cfg.CheckpointKey, key, rev)
+		if err != nil {
+			return err
+		}
+		if ow != nil {
+			// the mirror already replaced the update by an update of the
+			// peer, which is only a conflict if the outcomes differ.
+			if ev.Type != mvccpb.DELETE || !ow.deleted {
+				b.conflict(s, key, rev)
+			}
+			continue
+		}
+		pending = append(pending, ev)
+	}
+	if updates == 0 {
+		return nil
+	}
+
+	// the checkpoint is updated even if every update is dropped, so the
+	// conflicts are not evaluated again after a restart. Split transactions
+	// only checkpoint the revision once it is fully mirrored.
+	n := max(b.cfg.MaxTxnOps-1, 1)
+	for {
+		batch := pending[:min(n, len(pending))]
+		pending = pending[len(batch):]
+		checkpoint := rev
+		if len(pending) > 0 {
+			checkpoint = rev - 1
+		}
+		if err := b.mirrorTxn(ctx, s, batch, checkpoint); err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+	}
+}
+
+// mirrorTxn mirrors the events to the peer of s in a single transaction,
+// dropping the events conflicting with an update of the peer.
+func (b *Bidirectional) mirrorTxn(ctx context.Context, s *side, evs []*clientv3.Event, checkpoint int64) error {
+	p := s.peer
+	cmps := make([]clientv3.Cmp, len(evs))
+	for i, ev := range evs {
+		// fast path: the key was not updated since the last handled revision.
+		cmps[i] = clientv3.Compare(clientv3.ModRevision(s.peerKey(string(ev.Kv.Key))), "<", p.rev+1)
+	}
+	for {
+		ops := make([]clientv3.Op, 0, len(evs)+1)
+		for _, ev := range evs {
+			pk := s.peerKey(string(ev.Kv.Key))
+			if ev.Type == mvccpb.DELETE {
+				ops = append(ops, clientv3.OpDelete(pk))
+			} else {
+				ops = append(ops, clientv3.OpPut(pk, string(ev.Kv.Value)))
+			}
+		}
+		ops = append(ops, clientv3.OpPut(b.cfg.CheckpointKey, strconv.FormatInt(checkpoint, 10)))
+		resp, err := p.c.Txn(ctx).If(cmps...).Then(ops...).Commit()
+		if err != nil {
+			return err
+		}
+		if resp.Succeeded {
+			wrev := resp.Header.Revision
+			p.written[wrev] = struct{}{}
+			for _, ev := range evs {
+				p.overwritten[s.peerKey(string(ev.Kv.Key))] = overwrite{rev: wrev, deleted: ev.Type == mvccpb.DELETE}
+			}
+			b.mirrored.Add(int64(len(evs)))
+			return nil
+		}
+		if evs, cmps, err = b.resolve(ctx, s, evs); err != nil {
+			return err
+		}
+	}
+}
+
+// resolve drops the events of s whose key was updated in the peer by a write
+// other than the mirror's since the last handled revision of the peer. It
+// returns the remaining events, with comparisons guarding the revisions of
+// their keys.
+func (b *Bidirectional) resolve(ctx context.Context, s *side, evs []*clientv3.Event) ([]*clientv3.Event, []clientv3.Cmp, error) {
+	p := s.peer
+	gets := make([]clientv3.Op, len(evs))
+	for i, ev := range evs {
+		gets[i] = clientv3.OpGet(s.peerKey(string(ev.Kv.Key)), clientv3.WithKeysOnly())
+	}
+	resp, err := p.c.Txn(ctx).Then(gets...).Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		kept []*clientv3.Event
+		cmps []clientv3.Cmp
+	)
+	for i, ev := range evs {
+		pk := s.peerKey(string(ev.Kv.Key))
+		var modRev int64
+		if kvs := resp.Responses[i].GetResponseRange().Kvs; len(kvs) > 0 {
+			modRev = kvs[0].ModRevision
+		}
+		if modRev > p.rev {
+			written, err := p.isWritten(ctx, b.cfg.CheckpointKey, modRev)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !written {
+				b.conflict(s, string(ev.Kv.Key), ev.Kv.ModRevision)
+				if b.cfg.ConflictPolicy == SkipAndReport {
+					p.skipped[pk] = max(p.skipped[pk], modRev)
+				}
+				continue
+			}
+		}
+		kept = append(kept, ev)
+		cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(pk), "=", modRev))
+	}
+	return kept, cmps, nil
+}
+
+func (b *Bidirectional) conflict(s *side, key string, rev int64) {
+	if b.cfg.OnConflict != nil {
+		b.cfg.OnConflict(Conflict{Key: key, Revision: rev, FromDestination: s.isDest})
+	}
+}
+
+// peerKey returns the key of the peer mirrored with the key of s.
+func (s *side) peerKey(key string) string {
+	return s.peer.prefix + strings.TrimPrefix(key, s.prefix)
+}
+
+// isWritten returns true if the revision of s was written by the mirror.
+func (s *side) isWritten(ctx context.Context, checkpointKey string, rev int64) (bool, error) {
+	if rev > s.head {
+		_, ok := s.written[rev]
+		return ok, nil
+	}
+	// every write of the mirror updates the checkpoint key.
+	resp, err := s.c.Get(ctx, checkpointKey, clientv3.WithRev(rev))
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Kvs) == 1 && resp.Kvs[0].ModRevision == rev, nil
+}
+
+// overwrittenAfter returns the write of the mirror replacing the update of
+// the key of s at rev, or nil if there is none.
+func (s *side) overwrittenAfter(ctx context.Context, checkpointKey, key string, rev int64) (*overwrite, error) {
+	if ow, ok := s.overwritten[key]; ok && ow.rev > rev {
+		return &ow, nil
+	}
+	if rev > s.head {
+		return nil, nil
+	}
+	// the writes before the mirror started are not tracked; only the puts of
+	// the mirror can supersede an update, as its deletes would have conflicted.
+	resp, err := s.c.Get(ctx, key, clientv3.WithKeysOnly())
+	if err != nil || len(resp.Kvs) == 0 || resp.Kvs[0].ModRevision <= rev {
+		return nil, err
+	}
+	written, err := s.isWritten(ctx, checkpointKey, resp.Kvs[0].ModRevision)
+	if err != nil || !written {
+		return nil, err
+	}
+	return &overwrite{rev: resp.Kvs[0].ModRevision}, nil
+}
+
+// advance marks rev as handled, forgetting the state only needed for the
+// earlier revisions.
+func (s *side) advance(rev int64) {
+	s.rev = rev
+	for r := range s.written {
+		if r <= rev {
+			delete(s.written, r)
+		}
+	}
+	for k, ow := range s.overwritten {
+		if ow.rev <= rev {
+			delete(s.overwritten, k)
+		}
+	}
+	for k, r := range s.skipped {
+		if r <= rev {
+			delete(s.skipped, k)
+		}
+	}
+}
@@ -14,7 +14,11 @@
 
 package clientv3
 
-import pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+import (
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
 
 type opType int
 
@@ -44,6 +48,8 @@ type Op struct {
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	// continueToken resumes a paginated range
+	continueToken []byte
 
 	// for range, watch
 	rev int64
@@ -56,10 +62,15 @@ type Op struct {
 	// if true, split watch events when total exceeds
 	// "--max-request-bytes" flag value + 512-byte
 	fragment bool
+	// coalesceWindow and coalesceRevisions bound how long the server holds
+	// watch events to coalesce them
+	coalesceWindow    time.Duration
+	coalesceRevisions int64
 
 	// for put
 	ignoreValue bool
 	ignoreLease bool
+	ttl         int64
 
 	// progressNotify is for progress updates.
 	progressNotify bool
@@ -68,6 +79,8 @@ type Op struct {
 	// filters for watchers
 	filterPut    bool
 	filterDelete bool
+	// valueFilters filter PUT events by their value
+	valueFilters []*pb.WatchValueFilter
 
 	// for put
 	val     []byte
@@ -143,6 +156,9 @@ func (op Op) MinCreateRev() int64 { return op.minCreateRev }
 // MaxCreateRev returns the operation's maximum create revision.
 func (op Op) MaxCreateRev() int64 { return op.maxCreateRev }
 
+// ContinueToken returns the operation's continue token.
+func (op Op) ContinueToken() []byte { return op.continueToken }
+
 // WithRangeBytes sets the byte slice for the Op's range end.
 func (op *Op) WithRangeBytes(end []byte) { op.end = end }
 
@@ -168,6 +184,7 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		ContinueToken:     op.continueToken,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -197,7 +214,7 @@ func (op Op) toRequestOp() *pb.RequestOp {
 	case tRange:
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestRange{RequestRange: op.toRangeRequest()}}
 	case tPut:
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
+		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, TTL: op.ttl}
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: r}}
 	case tDeleteRange:
 		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
@@ -252,6 +269,8 @@ func OpDelete(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in delete")
+	case ret.ttl != 0:
+		panic("unexpected ttl in delete")
 	case ret.limit != 0:
 		panic("unexpected limit in delete")
 	case ret.rev != 0:
@@ -262,11 +281,13 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected serializable in delete")
 	case ret.countOnly:
 		panic("unexpected countOnly in delete")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in delete")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.filterDelete, ret.filterPut, len(ret.valueFilters) != 0:
 		panic("unexpected filter in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
@@ -291,11 +312,13 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected serializable in put")
 	case ret.countOnly:
 		panic("unexpected countOnly in put")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in put")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.filterDelete, ret.filterPut, len(ret.valueFilters) != 0:
 		panic("unexpected filter in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
@@ -314,6 +337,8 @@ func opWatch(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in watch")
+	case ret.ttl != 0:
+		panic("unexpected ttl in watch")
 	case ret.limit != 0:
 		panic("unexpected limit in watch")
 	case ret.sort != nil:
@@ -322,6 +347,8 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected serializable in watch")
 	case ret.countOnly:
 		panic("unexpected countOnly in watch")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in watch")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
@@ -344,6 +371,13 @@ func WithLease(leaseID LeaseID) OpOption {
 	return func(op *Op) { op.leaseID = leaseID }
 }
 
+// WithTTL sets the time-to-live in seconds of the key for a 'Put' request.
+// The key is deleted once the ttl elapses unless it is put again. A put without
+// WithTTL removes the ttl of the key. It cannot be combined with WithLease.
+func WithTTL(ttl int64) OpOption {
+	return func(op *Op) { op.ttl = ttl }
+}
+
 // WithLimit limits the number of results to return from 'Get' request.
 // If WithLimit is given a 0 limit, it is treated as no limit.
 func WithLimit(n int64) OpOption { return func(op *Op) { op.limit = n } }
@@ -461,6 +495,13 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithContinueToken resumes a paginated 'Get' request from the continue token of
+// a previous response. The key, range end and limit options should be the same
+// as in the first request; the revision is carried by the token.
+func WithContinueToken(token []byte) OpOption {
+	return func(op *Op) { op.continueToken = token }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -510,6 +551,36 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithValuePrefix discards PUT events whose value does not start with the given prefix.
+// Multiple value filters must all match for a PUT event to be sent to the watcher.
+func WithValuePrefix(prefix []byte) OpOption {
+	return withValueFilter(&pb.WatchValueFilter{Type: pb.WatchValueFilter_PREFIX, Value: prefix})
+}
+
+// WithValueRange discards PUT events whose value is not in the byte range [start, end).
+// An empty end matches all values greater than or equal to start.
+func WithValueRange(start, end []byte) OpOption {
+	return withValueFilter(&pb.WatchValueFilter{Type: pb.WatchValueFilter_RANGE, Value: start, RangeEnd: end})
+}
+
+// WithValueJSONField discards PUT events whose JSON value does not hold a field at the
+// dot separated path that is equal to the JSON encoded value, e.g.
+// WithValueJSONField("spec.nodeName", []byte(`"node-1"`)).
+func WithValueJSONField(path string, value []byte) OpOption {
+	return withValueFilter(&pb.WatchValueFilter{Type: pb.WatchValueFilter_JSON_FIELD, Path: path, Value: value})
+}
+
+// WithValueProtoField discards PUT events whose protobuf encoded value does not hold the
+// field at fieldPath with the given payload in wire format. Scalar fields are compared with
+// their wire encoding, e.g. protowire.AppendVarint(nil, 7) for a varint field holding 7.
+func WithValueProtoField(value []byte, fieldPath ...int32) OpOption {
+	return withValueFilter(&pb.WatchValueFilter{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: fieldPath, Value: value})
+}
+
+func withValueFilter(vf *pb.WatchValueFilter) OpOption {
+	return func(op *Op) { op.valueFilters = append(op.valueFilters, vf) }
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
@@ -529,6 +600,21 @@ func WithFragment() OpOption {
 	return func(op *Op) { op.fragment = true }
 }
 
+// WithCoalesce makes the server hold the watch events for up to the given
+// window, and send only the latest event of each key. The previous key-value
+// of a coalesced event, if requested with WithPrevKV, is the one before the
+// held events. Responses with dropped events have Coalesced set. The
+// grpc-proxy cancels coalescing watches with ErrWatchCoalesceNotSupported.
+func WithCoalesce(window time.Duration) OpOption {
+	return func(op *Op) { op.coalesceWindow = window }
+}
+
+// WithCoalesceRevisions makes the server send the events held by WithCoalesce
+// as soon as they span the given number of revisions.
+func WithCoalesceRevisions(revisions int64) OpOption {
+	return func(op *Op) { op.coalesceRevisions = revisions }
+}
+
 // WithIgnoreValue updates the key using its current value.
 // This option can not be combined with non-empty values.
 // Returns an error if the key does not exist.
@@ -217,6 +217,10 @@ func (rmc *retryMaintenanceClient) Snapshot(ctx context.Context, in *pb.Snapshot
 	return rmc.mc.Snapshot(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rmc *retryMaintenanceClient) SnapshotDelta(ctx context.Context, in *pb.SnapshotDeltaRequest, opts ...grpc.CallOption) (stream pb.Maintenance_SnapshotDeltaClient, err error) {
+	return rmc.mc.SnapshotDelta(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rmc *retryMaintenanceClient) MoveLeader(ctx context.Context, in *pb.MoveLeaderRequest, opts ...grpc.CallOption) (resp *pb.MoveLeaderResponse, err error) {
 	return rmc.mc.MoveLeader(ctx, in, append(opts, withRepeatablePolicy())...)
 }
@@ -229,6 +233,14 @@ func (rmc *retryMaintenanceClient) Downgrade(ctx context.Context, in *pb.Downgra
 	return rmc.mc.Downgrade(ctx, in, opts...)
 }
 
+func (rmc *retryMaintenanceClient) PrefixQuota(ctx context.Context, in *pb.PrefixQuotaRequest, opts ...grpc.CallOption) (resp *pb.PrefixQuotaResponse, err error) {
+	return rmc.mc.PrefixQuota(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) ReloadConfig(ctx context.Context, in *pb.ReloadConfigRequest, opts ...grpc.CallOption) (resp *pb.ReloadConfigResponse, err error) {
+	return rmc.mc.ReloadConfig(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 type retryAuthClient struct {
 	ac pb.AuthClient
 }
@@ -47,9 +47,38 @@ func hasChecksum(n int64) bool {
 // the selected node.
 // Etcd <v3.6 will return "" as version.
 func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string) (string, error) {
+	return save(ctx, lg, cfg, dbPath, "snapshot", func(cli *clientv3.Client) (*clientv3.SnapshotResponse, error) {
+		return cli.SnapshotWithVersion(ctx)
+	}, func(size int64) error {
+		if !hasChecksum(size) {
+			return fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
+		}
+		return nil
+	})
+}
+
+// SaveDelta fetches every change between the revision sinceRev and the current
+// revision from remote etcd server, saves it to target path and returns server
+// version. The delta can be restored on top of a snapshot, or a previous delta,
+// taken at sinceRev. Make sure to specify only one endpoint in client configuration.
+// Supported since etcd 3.7.
+func SaveDelta(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, deltaPath string, sinceRev int64) (string, error) {
+	return save(ctx, lg, cfg, deltaPath, "snapshot delta", func(cli *clientv3.Client) (*clientv3.SnapshotResponse, error) {
+		return cli.SnapshotDelta(ctx, sinceRev, 0)
+	}, func(size int64) error {
+		if size < sha256.Size {
+			return fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
+		}
+		return nil
+	})
+}
+
+func save(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string, kind string,
+	fetch func(*clientv3.Client) (*clientv3.SnapshotResponse, error), verify func(size int64) error,
+) (string, error) {
 	cfg.Logger = lg.Named("client")
 	if len(cfg.Endpoints) != 1 {
-		return "", fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
+		return "", fmt.Errorf("%s must be requested to one selected node, not multiple %v", kind, cfg.Endpoints)
 	}
 	cli, err := clientv3.New(cfg)
 	if err != nil {
@@ -83,7 +112,7 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 	lg.Info("created temporary db file", zap.String("path", partpath))
 
 	start := time.Now()
-	resp, err := cli.SnapshotWithVersion(ctx)
+	resp, err := fetch(cli)
 	if err != nil {
 		return "", err
 	}
@@ -93,22 +122,22 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 			lg.Error("Could not close snapshot stream", zap.Error(err))
 		}
 	}()
-	lg.Info("fetching snapshot", zap.String("endpoint", cfg.Endpoints[0]))
+	lg.Info("fetching "+kind, zap.String("endpoint", cfg.Endpoints[0]))
 	var size int64
 	size, err = io.Copy(f, resp.Snapshot)
 	if err != nil {
-		return resp.Version, fmt.Errorf("could not write snapshot: %w", err)
+		return resp.Version, fmt.Errorf("could not write %s: %w", kind, err)
 	}
-	if !hasChecksum(size) {
-		return resp.Version, fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
+	if err = verify(size); err != nil {
+		return resp.Version, err
 	}
 	if err = fileutil.Fsync(f); err != nil {
-		return resp.Version, fmt.Errorf("could not fsync snapshot: %w", err)
+		return resp.Version, fmt.Errorf("could not fsync %s: %w", kind, err)
 	}
 	if err = f.Close(); err != nil {
 		return resp.Version, fmt.Errorf("could not close file descriptor: %w", err)
 	}
-	lg.Info("fetched snapshot",
+	lg.Info("fetched "+kind,
 		zap.String("endpoint", cfg.Endpoints[0]),
 		zap.String("size", humanize.Bytes(uint64(size))),
 		zap.Duration("took", time.Since(start)),
@@ -103,6 +103,11 @@ type WatchResponse struct {
 	// Created is used to indicate the creation of the watcher.
 	Created bool
 
+	// Coalesced is used to indicate that events were dropped because a later
+	// event of the same key is in the response, for watchers created with
+	// WithCoalesce.
+	Coalesced bool
+
 	closeErr error
 
 	// cancelReason is a reason of canceling watch
@@ -214,6 +219,12 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// valueFilters filter out PUT events by their value
+	valueFilters []*pb.WatchValueFilter
+	// coalesceWindow and coalesceRevisions bound how long the server holds
+	// events to coalesce them
+	coalesceWindow    time.Duration
+	coalesceRevisions int64
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -307,16 +318,19 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	}
 
 	wr := &watchRequest{
-		ctx:            ctx,
-		createdNotify:  ow.createdNotify,
-		key:            string(ow.key),
-		end:            string(ow.end),
-		rev:            ow.rev,
-		progressNotify: ow.progressNotify,
-		fragment:       ow.fragment,
-		filters:        filters,
-		prevKV:         ow.prevKV,
-		retc:           make(chan chan WatchResponse, 1),
+		ctx:               ctx,
+		createdNotify:     ow.createdNotify,
+		key:               string(ow.key),
+		end:               string(ow.end),
+		rev:               ow.rev,
+		progressNotify:    ow.progressNotify,
+		fragment:          ow.fragment,
+		filters:           filters,
+		valueFilters:      ow.valueFilters,
+		coalesceWindow:    ow.coalesceWindow,
+		coalesceRevisions: ow.coalesceRevisions,
+		prevKV:            ow.prevKV,
+		retc:              make(chan chan WatchResponse, 1),
 	}
 
 	ok := false
@@ -585,6 +599,7 @@ func (w *watchGRPCStream) run() {
 				cur.Events = append(cur.Events, pbresp.Events...)
 				// update "Fragment" field; last response with "Fragment" == false
 				cur.Fragment = pbresp.Fragment
+				cur.Coalesced = cur.Coalesced || pbresp.Coalesced
 			}
 
 			switch {
@@ -723,6 +738,7 @@ func (w *watchGRPCStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 		CompactRevision: pbresp.CompactRevision,
 		Created:         pbresp.Created,
 		Canceled:        pbresp.Canceled,
+		Coalesced:       pbresp.Coalesced,
 		cancelReason:    pbresp.CancelReason,
 	}
 
@@ -1016,13 +1032,16 @@ func (w *watchGRPCStream) openWatchClient() (ws pb.Watch_WatchClient, err error)
 // toPB converts an internal watch request structure to its protobuf WatchRequest structure.
 func (wr *watchRequest) toPB() *pb.WatchRequest {
 	req := &pb.WatchCreateRequest{
-		StartRevision:  wr.rev,
-		Key:            []byte(wr.key),
-		RangeEnd:       []byte(wr.end),
-		ProgressNotify: wr.progressNotify,
-		Filters:        wr.filters,
-		PrevKv:         wr.prevKV,
-		Fragment:       wr.fragment,
+		StartRevision:     wr.rev,
+		Key:               []byte(wr.key),
+		RangeEnd:          []byte(wr.end),
+		ProgressNotify:    wr.progressNotify,
+		Filters:           wr.filters,
+		ValueFilters:      wr.valueFilters,
+		PrevKv:            wr.prevKV,
+		Fragment:          wr.fragment,
+		CoalesceWindowMs:  wr.coalesceWindow.Milliseconds(),
+		CoalesceRevisions: wr.coalesceRevisions,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
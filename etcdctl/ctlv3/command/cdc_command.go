@@ -0,0 +1,407 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+const (
+	cdcFormatJSON     = "json"
+	cdcFormatProtobuf = "protobuf"
+
+	cdcCheckpointFile = "checkpoint.json"
+	cdcPartialSuffix  = ".partial"
+
+	cdcOpGap = "GAP"
+)
+
+var (
+	cdcPrefix           string
+	cdcFormat           string
+	cdcRev              int64
+	cdcRolloverSize     int64
+	cdcRolloverInterval time.Duration
+)
+
+// NewCDCCommand returns the cobra command for "cdc".
+func NewCDCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cdc [options] <directory>",
+		Short: "Streams the changes of the key space to files",
+		Long: `Streams the changes of the key space to segment files of the directory.
+
+Every segment is named after the first revision it holds, and keeps the
+".partial" suffix until it is rolled over. The last written revision is
+checkpointed in the directory along with the segment files, so a restarted
+stream resumes without gap nor duplicate. The revisions compacted before the
+stream could read them are recorded by a gap marker.
+
+The json format writes an object per line for every event, with the "op",
+"revision", "key", "value" and "prev_kv" fields; the keys and values are
+base64 encoded. A gap marker has the "GAP" op, and misses the revisions from
+"gap_start_revision" to "revision".
+
+The protobuf format writes length-delimited etcdserverpb.WatchResponse
+messages, each prefixed by its size as an unsigned varint. A message holds
+events, or is a gap marker missing the revisions before its compact_revision.
+`,
+		Run: cdcCommandFunc,
+	}
+
+	cmd.Flags().StringVar(&cdcPrefix, "prefix", "", "Key prefix to stream, all the keys if empty")
+	cmd.Flags().StringVar(&cdcFormat, "format", cdcFormatJSON, "Format of the segment files ('json' or 'protobuf')")
+	cmd.Flags().Int64Var(&cdcRev, "rev", 0, "Revision to start streaming from on the first run, the next revision if zero")
+	cmd.Flags().Int64Var(&cdcRolloverSize, "rollover-size", 64*1024*1024, "Size in bytes after which a segment file is rolled over")
+	cmd.Flags().DurationVar(&cdcRolloverInterval, "rollover-interval", time.Hour, "Age after which a segment file is rolled over, never if zero")
+
+	return cmd
+}
+
+// cdcCommandFunc executes the "cdc" command.
+func cdcCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("cdc takes one directory argument"))
+	}
+	if cdcFormat != cdcFormatJSON && cdcFormat != cdcFormatProtobuf {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("unknown format %q", cdcFormat))
+	}
+	if cdcRolloverSize <= 0 || cdcRolloverInterval < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--rollover-size` must be positive, and `--rollover-interval` not negative"))
+	}
+
+	w, err := openCDCWriter(args[0], cdcFormat, cdcRolloverSize, cdcRolloverInterval)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	c := mustClientFromCmd(cmd)
+	err = streamCDC(context.TODO(), c, w)
+	cobrautl.ExitWithError(cobrautl.ExitError, err)
+}
+
+// streamCDC writes the changes of the key space after the checkpoint of w,
+// until ctx is canceled or an error occurs.
+func streamCDC(ctx context.Context, c *clientv3.Client, w *cdcWriter) error {
+	if w.cp.Revision == 0 {
+		rev := cdcRev - 1
+		if cdcRev <= 0 {
+			resp, err := c.Get(ctx, "foo")
+			if err != nil {
+				return err
+			}
+			rev = resp.Header.Revision
+		}
+		// checkpoint the start revision, so the first restart does not skip
+		// the changes made meanwhile.
+		if err := w.checkpoint(rev); err != nil {
+			return err
+		}
+	}
+
+	var tick <-chan time.Time
+	if w.rolloverInterval > 0 {
+		ticker := time.NewTicker(min(w.rolloverInterval, time.Second))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		wctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+		wch := c.Watch(wctx, cdcPrefix, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(w.cp.Revision+1))
+		err := w.consume(ctx, wch, tick)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// consume writes the watch responses of wch, until the watch is compacted.
+func (w *cdcWriter) consume(ctx context.Context, wch clientv3.WatchChan, tick <-chan time.Time) error {
+	for {
+		select {
+		case wr, ok := <-wch:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return errors.New("cdc: watch closed")
+			}
+			if wr.CompactRevision != 0 {
+				// watch again after the gap.
+				return w.writeGap(wr.CompactRevision)
+			}
+			if err := wr.Err(); err != nil {
+				return err
+			}
+			if len(wr.Events) > 0 {
+				if err := w.writeEvents(wr.Events); err != nil {
+					return err
+				}
+			}
+		case <-tick:
+		}
+		if err := w.maybeRollover(time.Now()); err != nil {
+			return err
+		}
+	}
+}
+
+// cdcEvent is a record of the json format.
+type cdcEvent struct {
+	Op       string           `json:"op"`
+	Revision int64            `json:"revision"`
+	Key      []byte           `json:"key,omitempty"`
+	Value    []byte           `json:"value,omitempty"`
+	PrevKv   *mvccpb.KeyValue `json:"prev_kv,omitempty"`
+	// GapStartRevision is the first revision a gap marker misses.
+	GapStartRevision int64 `json:"gap_start_revision,omitempty"`
+}
+
+// cdcCheckpoint is the progress of the stream.
+type cdcCheckpoint struct {
+	// Revision is the last written revision.
+	Revision int64 `json:"revision"`
+	// Segment is the partial segment file written, whose content after
+	// Offset is not checkpointed yet.
+	Segment string `json:"segment,omitempty"`
+	Offset  int64  `json:"offset,omitempty"`
+}
+
+// cdcWriter writes the records to the segment files of a directory, and
+// checkpoints them once synced.
+type cdcWriter struct {
+	dir              string
+	format           string
+	rolloverSize     int64
+	rolloverInterval time.Duration
+
+	cp cdcCheckpoint
+	// f is the partial segment, or nil if none is open.
+	f      *os.File
+	opened time.Time
+}
+
+// openCDCWriter opens the writer of dir, truncating its partial segment to
+// the checkpointed content.
+func openCDCWriter(dir, format string, rolloverSize int64, rolloverInterval time.Duration) (*cdcWriter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	w := &cdcWriter{dir: dir, format: format, rolloverSize: rolloverSize, rolloverInterval: rolloverInterval}
+	b, err := os.ReadFile(filepath.Join(dir, cdcCheckpointFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err = json.Unmarshal(b, &w.cp); err != nil {
+			return nil, fmt.Errorf("cdc: invalid checkpoint: %w", err)
+		}
+	}
+
+	if w.cp.Segment != "" {
+		f, err := os.OpenFile(filepath.Join(dir, w.cp.Segment), os.O_RDWR, 0o644)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			// the segment was rolled over, but not checkpointed.
+			w.cp.Segment, w.cp.Offset = "", 0
+		case err != nil:
+			return nil, err
+		default:
+			if err = f.Truncate(w.cp.Offset); err == nil {
+				_, err = f.Seek(w.cp.Offset, 0)
+			}
+			if err != nil {
+				f.Close()
+				return nil, err
+			}
+			w.f, w.opened = f, time.Now()
+		}
+	}
+
+	// the other partial segments were never checkpointed.
+	partials, err := filepath.Glob(filepath.Join(dir, "*"+cdcPartialSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range partials {
+		if filepath.Base(p) != w.cp.Segment {
+			if err = os.Remove(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return w, nil
+}
+
+func (w *cdcWriter) writeEvents(evs []*clientv3.Event) error {
+	var b []byte
+	if w.format == cdcFormatProtobuf {
+		resp := &pb.WatchResponse{Events: make([]*mvccpb.Event, len(evs))}
+		for i, ev := range evs {
+			resp.Events[i] = (*mvccpb.Event)(ev)
+		}
+		var err error
+		if b, err = appendDelimited(b, resp); err != nil {
+			return err
+		}
+	} else {
+		for _, ev := range evs {
+			line, err := json.Marshal(cdcEvent{
+				Op:       ev.Type.String(),
+				Revision: ev.Kv.ModRevision,
+				Key:      ev.Kv.Key,
+				Value:    ev.Kv.Value,
+				PrevKv:   ev.PrevKv,
+			})
+			if err != nil {
+				return err
+			}
+			b = append(append(b, line...), '\n')
+		}
+	}
+	return w.write(b, evs[0].Kv.ModRevision, evs[len(evs)-1].Kv.ModRevision)
+}
+
+// writeGap writes a gap marker for the revisions compacted after the
+// checkpoint, up to compactRev.
+func (w *cdcWriter) writeGap(compactRev int64) error {
+	start, end := w.cp.Revision+1, compactRev-1
+	if end < start {
+		return nil
+	}
+	var (
+		b   []byte
+		err error
+	)
+	if w.format == cdcFormatProtobuf {
+		b, err = appendDelimited(b, &pb.WatchResponse{CompactRevision: compactRev})
+	} else {
+		b, err = json.Marshal(cdcEvent{Op: cdcOpGap, Revision: end, GapStartRevision: start})
+		b = append(b, '\n')
+	}
+	if err != nil {
+		return err
+	}
+	return w.write(b, start, end)
+}
+
+func appendDelimited(b []byte, m *pb.WatchResponse) ([]byte, error) {
+	data, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.AppendUvarint(b, uint64(len(data))), data...), nil
+}
+
+// write appends the records of the revisions from first to last to the
+// partial segment, opening a new one if needed, and checkpoints them once
+// synced.
+func (w *cdcWriter) write(b []byte, first, last int64) error {
+	if w.f == nil {
+		ext := "ndjson"
+		if w.format == cdcFormatProtobuf {
+			ext = "pb"
+		}
+		name := fmt.Sprintf("%016d.%s%s", first, ext, cdcPartialSuffix)
+		f, err := os.OpenFile(filepath.Join(w.dir, name), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		w.f, w.opened = f, time.Now()
+		w.cp.Segment, w.cp.Offset = name, 0
+	}
+	if _, err := w.f.Write(b); err != nil {
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		return err
+	}
+	w.cp.Offset += int64(len(b))
+	return w.checkpoint(last)
+}
+
+// maybeRollover rolls the partial segment over once it reached the rollover
+// size or interval.
+func (w *cdcWriter) maybeRollover(now time.Time) error {
+	if w.f == nil || (w.cp.Offset < w.rolloverSize && (w.rolloverInterval == 0 || now.Sub(w.opened) < w.rolloverInterval)) {
+		return nil
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	w.f = nil
+	partial := filepath.Join(w.dir, w.cp.Segment)
+	if err := os.Rename(partial, strings.TrimSuffix(partial, cdcPartialSuffix)); err != nil {
+		return err
+	}
+	w.cp.Segment, w.cp.Offset = "", 0
+	return w.checkpoint(w.cp.Revision)
+}
+
+// checkpoint atomically replaces the checkpoint file.
+func (w *cdcWriter) checkpoint(rev int64) error {
+	w.cp.Revision = rev
+	b, err := json.Marshal(w.cp)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(w.dir, cdcCheckpointFile+".tmp")
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(b); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(w.dir, cdcCheckpointFile)); err != nil {
+		return err
+	}
+	d, err := os.Open(w.dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (w *cdcWriter) close() error {
+	if w.f == nil {
+		return nil
+	}
+	return w.f.Close()
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func cdcPut(key string, rev int64) *clientv3.Event {
+	return &clientv3.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: []byte("v"), ModRevision: rev}}
+}
+
+func readCDCEvents(t *testing.T, path string) []cdcEvent {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var evs []cdcEvent
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var ev cdcEvent
+		require.NoError(t, json.Unmarshal(s.Bytes(), &ev))
+		evs = append(evs, ev)
+	}
+	require.NoError(t, s.Err())
+	return evs
+}
+
+// TestCDCWriterResume ensures the records written after the checkpoint are
+// discarded on restart, so they are not duplicated.
+func TestCDCWriterResume(t *testing.T) {
+	dir := t.TempDir()
+	w, err := openCDCWriter(dir, cdcFormatJSON, 1<<20, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.checkpoint(1))
+	require.NoError(t, w.writeEvents([]*clientv3.Event{cdcPut("a", 2), cdcPut("b", 2)}))
+	segment := filepath.Join(dir, w.cp.Segment)
+	// the stream stops after writing the next revision, before checkpointing it.
+	_, err = w.f.WriteString(`{"op":"PUT","revision":3}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, w.close())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0000000000000009.ndjson.partial"), []byte("stray"), 0o644))
+
+	w, err = openCDCWriter(dir, cdcFormatJSON, 1<<20, 0)
+	require.NoError(t, err)
+	defer w.close()
+	require.Equal(t, int64(2), w.cp.Revision)
+	require.NoError(t, w.writeEvents([]*clientv3.Event{cdcPut("c", 3)}))
+
+	evs := readCDCEvents(t, segment)
+	require.Len(t, evs, 3)
+	require.Equal(t, []byte("c"), evs[2].Key)
+	require.Equal(t, int64(3), evs[2].Revision)
+	partials, err := filepath.Glob(filepath.Join(dir, "*"+cdcPartialSuffix))
+	require.NoError(t, err)
+	require.Equal(t, []string{segment}, partials)
+}
+
+func TestCDCWriterRollover(t *testing.T) {
+	dir := t.TempDir()
+	w, err := openCDCWriter(dir, cdcFormatJSON, 1, time.Hour)
+	require.NoError(t, err)
+	defer w.close()
+	require.NoError(t, w.checkpoint(1))
+
+	require.NoError(t, w.writeEvents([]*clientv3.Event{cdcPut("a", 2)}))
+	require.NoError(t, w.maybeRollover(time.Now()))
+	require.Nil(t, w.f)
+	require.NoError(t, w.writeGap(6))
+	w.rolloverSize = 1 << 20
+	require.NoError(t, w.maybeRollover(time.Now()))
+	require.NotNil(t, w.f)
+	require.NoError(t, w.maybeRollover(time.Now().Add(time.Hour)))
+	require.Equal(t, int64(5), w.cp.Revision)
+
+	evs := readCDCEvents(t, filepath.Join(dir, "0000000000000002.ndjson"))
+	require.Len(t, evs, 1)
+	evs = readCDCEvents(t, filepath.Join(dir, "0000000000000003.ndjson"))
+	require.Equal(t, []cdcEvent{{Op: cdcOpGap, Revision: 5, GapStartRevision: 3}}, evs)
+}
+
+func TestCDCWriterProtobuf(t *testing.T) {
+	dir := t.TempDir()
+	w, err := openCDCWriter(dir, cdcFormatProtobuf, 1<<20, 0)
+	require.NoError(t, err)
+	defer w.close()
+	require.NoError(t, w.checkpoint(1))
+	require.NoError(t, w.writeEvents([]*clientv3.Event{cdcPut("a", 2), cdcPut("b", 3)}))
+	require.NoError(t, w.writeGap(10))
+
+	b, err := os.ReadFile(filepath.Join(dir, w.cp.Segment))
+	require.NoError(t, err)
+	r := bytes.NewReader(b)
+	var resps []*pb.WatchResponse
+	for r.Len() > 0 {
+		n, err := binary.ReadUvarint(r)
+		require.NoError(t, err)
+		data := make([]byte, n)
+		_, err = r.Read(data)
+		require.NoError(t, err)
+		var resp pb.WatchResponse
+		require.NoError(t, resp.Unmarshal(data))
+		resps = append(resps, &resp)
+	}
+	require.Len(t, resps, 2)
+	require.Len(t, resps[0].Events, 2)
+	require.Equal(t, []byte("b"), resps[0].Events[1].Kv.Key)
+	require.Equal(t, int64(10), resps[1].CompactRevision)
+}
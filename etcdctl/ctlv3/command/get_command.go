@@ -39,6 +39,8 @@ var (
 	getMaxCreateRev int64
 	getMinModRev    int64
 	getMaxModRev    int64
+	getPaginate     bool
+	getPageSize     int64
 )
 
 // NewGetCommand returns the cobra command for "get".
@@ -63,6 +65,8 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&getMaxCreateRev, "max-create-rev", 0, "Maximum create revision")
 	cmd.Flags().Int64Var(&getMinModRev, "min-mod-rev", 0, "Minimum modification revision")
 	cmd.Flags().Int64Var(&getMaxModRev, "max-mod-rev", 0, "Maximum modification revision")
+	cmd.Flags().BoolVar(&getPaginate, "paginate", false, "Get the keys in pages of '--page-size' keys at the revision of the first page")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 1000, "Maximum number of keys per page when '--paginate' is set")
 
 	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
@@ -80,12 +84,6 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
-	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
-	cancel()
-	if err != nil {
-		cobrautl.ExitWithError(cobrautl.ExitError, err)
-	}
 
 	if getCountOnly {
 		if _, fields := display.(*fieldsPrinter); !fields {
@@ -100,9 +98,44 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 		}
 		dp.valueOnly = true
 	}
+
+	c := mustClientFromCmd(cmd)
+	if getPaginate {
+		getPaginated(cmd, c, key, opts)
+		return
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := c.Get(ctx, key, opts...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
 	display.Get(*resp)
 }
 
+// getPaginated gets the keys page by page, each page is displayed as soon as
+// it is received so the whole range is never held in memory.
+func getPaginated(cmd *cobra.Command, c *clientv3.Client, key string, opts []clientv3.OpOption) {
+	opts = append(opts, clientv3.WithLimit(getPageSize))
+	var token []byte
+	for {
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.Get(ctx, key, append(opts, clientv3.WithContinueToken(token))...)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.Get(*resp)
+		if !resp.More {
+			return
+		}
+		if len(resp.ContinueToken) == 0 {
+			cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("server did not return a continue token, it may not support pagination"))
+		}
+		token = resp.ContinueToken
+	}
+}
+
 func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("get command needs one argument as key and an optional argument as range_end"))
@@ -116,6 +149,18 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--keys-only` and `--count-only` cannot be set at the same time, choose one"))
 	}
 
+	if getPaginate {
+		if getPageSize <= 0 {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` must be greater than 0"))
+		}
+		if getLimit != 0 || getCountOnly {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--paginate` cannot be set with `--limit` or `--count-only`"))
+		}
+		if (getSortTarget != "" && strings.ToUpper(getSortTarget) != "KEY") || strings.ToUpper(getSortOrder) == "DESCEND" {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--paginate` only supports sorting by key in ascending order"))
+		}
+	}
+
 	var opts []clientv3.OpOption
 	if IsSerializable(getConsistency) {
 		opts = append(opts, clientv3.WithSerializable())
@@ -18,7 +18,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -33,7 +37,11 @@ import (
 )
 
 const (
-	defaultMaxTxnOps = uint(128)
+	defaultMaxTxnOps        = uint(128)
+	defaultMirrorCheckpoint = "__etcdctl_make_mirror_checkpoint"
+
+	mirrorLeasesDrop = "drop"
+	mirrorLeasesKeep = "keep"
 )
 
 var (
@@ -48,6 +56,16 @@ var (
 	mmnodestprefix bool
 	mmrev          int64
 	mmmaxTxnOps    uint
+
+	mmbidirectional  bool
+	mmconflictPolicy string
+	mmcheckpointKey  string
+
+	mmresume  bool
+	mminclude string
+	mmexclude string
+	mmrenames []string
+	mmleases  string
 )
 
 // NewMakeMirrorCommand returns the cobra command for "makeMirror".
@@ -70,6 +88,14 @@ func NewMakeMirrorCommand() *cobra.Command {
 	c.Flags().BoolVar(&mminsecureTr, "dest-insecure-transport", true, "Disable transport security for client connections")
 	c.Flags().StringVar(&mmuser, "dest-user", "", "Destination username[:password] for authentication (prompt if password is not supplied)")
 	c.Flags().StringVar(&mmpassword, "dest-password", "", "Destination password for authentication (if this option is used, --user option shouldn't include password)")
+	c.Flags().BoolVar(&mmbidirectional, "bidirectional", false, "Mirror the updates of the destination cluster back to the source cluster as well")
+	c.Flags().StringVar(&mmconflictPolicy, "conflict-policy", string(mirror.LastWriterWins), "Resolution of the keys updated concurrently in both clusters with --bidirectional ('last-writer-wins' or 'skip-and-report')")
+	c.Flags().StringVar(&mmcheckpointKey, "checkpoint-key", defaultMirrorCheckpoint, "Key storing the last revision mirrored, in the destination cluster with --resume and in each cluster with --bidirectional, unique for every mirror between the same clusters")
+	c.Flags().BoolVar(&mmresume, "resume", false, "Store the last mirrored revision in the destination cluster, and resume from it after a restart")
+	c.Flags().StringVar(&mminclude, "include-regex", "", "Only mirror the keys matching this regular expression")
+	c.Flags().StringVar(&mmexclude, "exclude-regex", "", "Do not mirror the keys matching this regular expression")
+	c.Flags().StringArrayVar(&mmrenames, "rename-prefix", nil, "Rename a key prefix in the destination cluster, as <prefix>=<new prefix>. Can be repeated, the first matching rule applies instead of --dest-prefix")
+	c.Flags().StringVar(&mmleases, "leases", mirrorLeasesDrop, "Lease attachments of the mirrored keys: 'drop' to mirror the keys without lease, 'keep' to attach them to destination leases with the remaining TTL of their source leases, kept alive again after a restart with --resume")
 
 	return c
 }
@@ -134,10 +160,58 @@ func makeMirrorCommandFunc(cmd *cobra.Command, args []string) {
 	dc := mustClient(cc)
 	c := mustClientFromCmd(cmd)
 
-	err := makeMirror(context.TODO(), c, dc)
+	var err error
+	if mmbidirectional {
+		err = makeBidirectionalMirror(context.TODO(), c, dc)
+	} else {
+		err = makeMirror(context.TODO(), c, dc)
+	}
 	cobrautl.ExitWithError(cobrautl.ExitError, err)
 }
 
+func makeBidirectionalMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) error {
+	if mmnodestprefix && len(mmdestprefix) > 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--dest-prefix` and `--no-dest-prefix` cannot be set at the same time, choose one"))
+	}
+	if mmrev != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--rev` cannot be set with `--bidirectional`, the mirror resumes from its checkpoints"))
+	}
+	if mmresume || mminclude != "" || mmexclude != "" || len(mmrenames) > 0 || mmleases != mirrorLeasesDrop {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--bidirectional` always resumes, and does not support key rules nor lease attachments"))
+	}
+	policy := mirror.ConflictPolicy(mmconflictPolicy)
+	if policy != mirror.LastWriterWins && policy != mirror.SkipAndReport {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("unknown conflict policy %q", mmconflictPolicy))
+	}
+	if !mmnodestprefix && len(mmdestprefix) == 0 {
+		mmdestprefix = mmprefix
+	}
+
+	m := mirror.NewBidirectional(c, dc, mirror.BidirectionalConfig{
+		Prefix:         mmprefix,
+		DestPrefix:     mmdestprefix,
+		CheckpointKey:  mmcheckpointKey,
+		ConflictPolicy: policy,
+		OnConflict: func(cf mirror.Conflict) {
+			cluster := "source"
+			if cf.FromDestination {
+				cluster = "destination"
+			}
+			fmt.Fprintf(os.Stderr, "conflict: update of %q at revision %d of the %s cluster not mirrored (%s)\n", cf.Key, cf.Revision, cluster, policy)
+		},
+		MaxTxnOps: int(mmmaxTxnOps),
+	})
+
+	go func() {
+		for {
+			time.Sleep(30 * time.Second)
+			fmt.Println(m.Mirrored())
+		}
+	}()
+
+	return m.Run(ctx)
+}
+
 func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) error {
 	total := int64(0)
 
@@ -145,6 +219,14 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 	if mmnodestprefix && len(mmdestprefix) > 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--dest-prefix` and `--no-dest-prefix` cannot be set at the same time, choose one"))
 	}
+	rules, err := newMirrorRules()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	// if remove destination prefix is false and destination prefix is empty set the value of destination prefix same as prefix
+	if !mmnodestprefix && len(mmdestprefix) == 0 {
+		mmdestprefix = mmprefix
+	}
 
 	go func() {
 		for {
@@ -157,36 +239,155 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 	if startRev < 0 {
 		startRev = 0
 	}
+	if mmresume {
+		resp, err := dc.Get(ctx, mmcheckpointKey)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) > 0 {
+			// the checkpoint takes precedence over --rev, which only applies to the first run.
+			if startRev, err = strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64); err != nil {
+				return fmt.Errorf("invalid checkpoint %q of key %q", resp.Kvs[0].Value, mmcheckpointKey)
+			}
+		}
+	}
 
-	s := mirror.NewSyncer(c, mmprefix, startRev)
+	m := &oneWayMirror{c: c, dc: dc, rules: rules, total: &total}
+	if mmleases == mirrorLeasesKeep {
+		m.leases = newLeaseMirror(c, dc)
+		if mmresume {
+			if err = m.leases.resume(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	resync := false
+	for {
+		// If a rev is provided, then do not sync the whole key space.
+		// Instead, just start watching the key space starting from the rev
+		if startRev == 0 {
+			if startRev, err = m.syncBase(ctx, resync); err != nil {
+				return err
+			}
+		}
+		err = m.syncUpdates(ctx, startRev)
+		if !mmresume || !errors.Is(err, rpctypes.ErrCompacted) {
+			return err
+		}
+		// the updates since the checkpoint are compacted, so resync the whole prefix.
+		startRev, resync = 0, true
+	}
+}
 
-	// If a rev is provided, then do not sync the whole key space.
-	// Instead, just start watching the key space starting from the rev
-	if startRev == 0 {
-		rc, errc := s.SyncBase(ctx)
+// oneWayMirror mirrors the key prefix of the source cluster to the destination cluster.
+type oneWayMirror struct {
+	c, dc  *clientv3.Client
+	rules  *mirrorRules
+	leases *leaseMirror
+	total  *int64
+}
 
-		// if remove destination prefix is false and destination prefix is empty set the value of destination prefix same as prefix
-		if !mmnodestprefix && len(mmdestprefix) == 0 {
-			mmdestprefix = mmprefix
+// syncBase copies the key prefix at the current revision of the source
+// cluster, and returns that revision. A resync also deletes the mirrored keys
+// no longer in the source cluster.
+func (m *oneWayMirror) syncBase(ctx context.Context, resync bool) (int64, error) {
+	checkPath := "foo"
+	if len(mmprefix) != 0 {
+		checkPath = mmprefix
+	}
+	resp, err := m.c.Get(ctx, checkPath)
+	if err != nil {
+		return 0, err
+	}
+	rev := resp.Header.Revision
+
+	mirrored := make(map[string]struct{})
+	rc, errc := mirror.NewSyncer(m.c, mmprefix, rev).SyncBase(ctx)
+	for r := range rc {
+		for _, kv := range r.Kvs {
+			key, ok := m.rules.destKey(string(kv.Key))
+			if !ok {
+				continue
+			}
+			opts, err := m.putOptions(ctx, kv)
+			if err != nil {
+				return 0, err
+			}
+			if _, err = m.dc.Put(ctx, key, string(kv.Value), opts...); err != nil {
+				return 0, err
+			}
+			if resync {
+				mirrored[key] = struct{}{}
+			}
+			atomic.AddInt64(m.total, 1)
 		}
+	}
+	if err = <-errc; err != nil {
+		return 0, err
+	}
 
-		for r := range rc {
-			for _, kv := range r.Kvs {
-				_, err := dc.Put(ctx, modifyPrefix(string(kv.Key)), string(kv.Value))
-				if err != nil {
-					return err
-				}
-				atomic.AddInt64(&total, 1)
-			}
+	if resync {
+		if err = m.deleteStale(ctx, mirrored); err != nil {
+			return 0, err
+		}
+	}
+	if mmresume {
+		if _, err = m.dc.Put(ctx, mmcheckpointKey, strconv.FormatInt(rev, 10)); err != nil {
+			return 0, err
 		}
+	}
+	return rev, nil
+}
 
-		err := <-errc
+// deleteStale deletes the destination keys mirrored from a source key that
+// is not in mirrored anymore.
+func (m *oneWayMirror) deleteStale(ctx context.Context, mirrored map[string]struct{}) error {
+	for _, pfx := range m.rules.destPrefixes() {
+		opts := []clientv3.OpOption{clientv3.WithKeysOnly(), clientv3.WithPrefix()}
+		if pfx == "" {
+			opts = []clientv3.OpOption{clientv3.WithKeysOnly(), clientv3.WithFromKey()}
+			pfx = "\x00"
+		}
+		resp, err := m.dc.Get(ctx, pfx, opts...)
 		if err != nil {
 			return err
 		}
+		for _, kv := range resp.Kvs {
+			key := string(kv.Key)
+			if _, ok := mirrored[key]; ok || key == mmcheckpointKey || strings.HasPrefix(key, leaseMappingPrefix()) {
+				continue
+			}
+			if _, ok := m.rules.sourceKey(key); !ok {
+				continue
+			}
+			if _, err = m.dc.Delete(ctx, key); err != nil {
+				return err
+			}
+			atomic.AddInt64(m.total, 1)
+		}
 	}
+	return nil
+}
 
-	wc := s.SyncUpdates(ctx)
+// syncUpdates mirrors the updates of the source cluster after rev.
+func (m *oneWayMirror) syncUpdates(ctx context.Context, rev int64) error {
+	maxTxnOps := int(mmmaxTxnOps)
+	if mmresume && maxTxnOps > 1 {
+		// leave room for the checkpoint.
+		maxTxnOps--
+	}
+	commit := func(ops []clientv3.Op, checkpoint int64) error {
+		if len(ops) == 0 {
+			return nil
+		}
+		if mmresume {
+			ops = append(ops, clientv3.OpPut(mmcheckpointKey, strconv.FormatInt(checkpoint, 10)))
+		}
+		_, err := m.dc.Txn(ctx).Then(ops...).Commit()
+		return err
+	}
+
+	wc := mirror.NewSyncer(m.c, mmprefix, rev).SyncUpdates(ctx)
 
 	for wr := range wc {
 		if wr.CompactRevision != 0 {
@@ -199,45 +400,261 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 		for _, ev := range wr.Events {
 			nextRev := ev.Kv.ModRevision
 			if lastRev != 0 && nextRev > lastRev {
-				_, err := dc.Txn(ctx).Then(ops...).Commit()
-				if err != nil {
+				if err := commit(ops, lastRev); err != nil {
 					return err
 				}
 				ops = []clientv3.Op{}
 			}
 			lastRev = nextRev
 
-			if len(ops) == int(mmmaxTxnOps) {
-				_, err := dc.Txn(ctx).Then(ops...).Commit()
-				if err != nil {
+			if len(ops) == maxTxnOps {
+				// the revision is only checkpointed once fully mirrored.
+				if err := commit(ops, lastRev-1); err != nil {
 					return err
 				}
 				ops = []clientv3.Op{}
 			}
 
+			key, ok := m.rules.destKey(string(ev.Kv.Key))
+			if !ok {
+				continue
+			}
 			switch ev.Type {
 			case mvccpb.PUT:
-				ops = append(ops, clientv3.OpPut(modifyPrefix(string(ev.Kv.Key)), string(ev.Kv.Value)))
-				atomic.AddInt64(&total, 1)
+				opts, err := m.putOptions(ctx, ev.Kv)
+				if err != nil {
+					return err
+				}
+				ops = append(ops, clientv3.OpPut(key, string(ev.Kv.Value), opts...))
+				atomic.AddInt64(m.total, 1)
 			case mvccpb.DELETE:
-				ops = append(ops, clientv3.OpDelete(modifyPrefix(string(ev.Kv.Key))))
-				atomic.AddInt64(&total, 1)
+				ops = append(ops, clientv3.OpDelete(key))
+				atomic.AddInt64(m.total, 1)
 			default:
 				panic("unexpected event type")
 			}
 		}
 
-		if len(ops) != 0 {
-			_, err := dc.Txn(ctx).Then(ops...).Commit()
-			if err != nil {
-				return err
-			}
+		if err := commit(ops, lastRev); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// putOptions returns the options mirroring the lease attachment of kv.
+func (m *oneWayMirror) putOptions(ctx context.Context, kv *mvccpb.KeyValue) ([]clientv3.OpOption, error) {
+	if m.leases == nil || kv.Lease == 0 {
+		return nil, nil
+	}
+	id, err := m.leases.destLease(ctx, clientv3.LeaseID(kv.Lease))
+	if err != nil || id == clientv3.NoLease {
+		return nil, err
+	}
+	return []clientv3.OpOption{clientv3.WithLease(id)}, nil
+}
+
 func modifyPrefix(key string) string {
 	return strings.Replace(key, mmprefix, mmdestprefix, 1)
 }
+
+// mirrorRules filter the mirrored keys, and rename their prefixes.
+type mirrorRules struct {
+	include, exclude *regexp.Regexp
+	renames          []prefixRename
+}
+
+type prefixRename struct {
+	from, to string
+}
+
+func newMirrorRules() (*mirrorRules, error) {
+	var (
+		r   mirrorRules
+		err error
+	)
+	if mminclude != "" {
+		if r.include, err = regexp.Compile(mminclude); err != nil {
+			return nil, fmt.Errorf("invalid `--include-regex`: %w", err)
+		}
+	}
+	if mmexclude != "" {
+		if r.exclude, err = regexp.Compile(mmexclude); err != nil {
+			return nil, fmt.Errorf("invalid `--exclude-regex`: %w", err)
+		}
+	}
+	for _, rename := range mmrenames {
+		from, to, ok := strings.Cut(rename, "=")
+		if !ok || from == "" {
+			return nil, fmt.Errorf("invalid `--rename-prefix` %q, expected <prefix>=<new prefix>", rename)
+		}
+		r.renames = append(r.renames, prefixRename{from: from, to: to})
+	}
+	if mmleases != mirrorLeasesDrop && mmleases != mirrorLeasesKeep {
+		return nil, fmt.Errorf("invalid `--leases` %q, expected %q or %q", mmleases, mirrorLeasesDrop, mirrorLeasesKeep)
+	}
+	return &r, nil
+}
+
+// destKey returns the destination key of a source key, or false if the key
+// is not mirrored. The first matching rename rule applies, or the destination
+// prefix if none matches.
+func (r *mirrorRules) destKey(key string) (string, bool) {
+	if (r.include != nil && !r.include.MatchString(key)) || (r.exclude != nil && r.exclude.MatchString(key)) {
+		return "", false
+	}
+	for _, rename := range r.renames {
+		if strings.HasPrefix(key, rename.from) {
+			return rename.to + strings.TrimPrefix(key, rename.from), true
+		}
+	}
+	return modifyPrefix(key), true
+}
+
+// sourceKey returns the source key mirrored to a destination key, or false if
+// no mirrored key is mirrored to it.
+func (r *mirrorRules) sourceKey(key string) (string, bool) {
+	var candidates []string
+	for _, rename := range r.renames {
+		if strings.HasPrefix(key, rename.to) {
+			candidates = append(candidates, rename.from+strings.TrimPrefix(key, rename.to))
+		}
+	}
+	if strings.HasPrefix(key, mmdestprefix) {
+		candidates = append(candidates, mmprefix+strings.TrimPrefix(key, mmdestprefix))
+	}
+	for _, src := range candidates {
+		if !strings.HasPrefix(src, mmprefix) {
+			continue
+		}
+		if dst, ok := r.destKey(src); ok && dst == key {
+			return src, true
+		}
+	}
+	return "", false
+}
+
+// destPrefixes returns the destination prefixes keys are mirrored to.
+func (r *mirrorRules) destPrefixes() []string {
+	pfxs := []string{mmdestprefix}
+	for _, rename := range r.renames {
+		pfxs = append(pfxs, rename.to)
+	}
+	return pfxs
+}
+
+// leaseMirror attaches the mirrored keys to destination leases granted with
+// the remaining TTL of their source leases. The destination leases are kept
+// alive while their source leases exist, and revoked once they expire.
+//
+// With --resume, the destination lease of each source lease is stored under
+// the lease mapping prefix of the checkpoint key, attached to the destination
+// lease, so a restarted mirror keeps alive the leases granted before.
+type leaseMirror struct {
+	c, dc *clientv3.Client
+
+	mu     sync.Mutex
+	leases map[clientv3.LeaseID]clientv3.LeaseID
+}
+
+func newLeaseMirror(c, dc *clientv3.Client) *leaseMirror {
+	return &leaseMirror{c: c, dc: dc, leases: make(map[clientv3.LeaseID]clientv3.LeaseID)}
+}
+
+// leaseMappingPrefix returns the prefix of the keys storing the destination
+// lease of each source lease.
+func leaseMappingPrefix() string {
+	return mmcheckpointKey + "/leases/"
+}
+
+func leaseMappingKey(id clientv3.LeaseID) string {
+	return fmt.Sprintf("%s%016x", leaseMappingPrefix(), int64(id))
+}
+
+// resume keeps alive the destination leases granted by a previous run.
+func (lm *leaseMirror) resume(ctx context.Context) error {
+	resp, err := lm.dc.Get(ctx, leaseMappingPrefix(), clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	for _, kv := range resp.Kvs {
+		id, err := strconv.ParseInt(strings.TrimPrefix(string(kv.Key), leaseMappingPrefix()), 16, 64)
+		if err != nil {
+			return fmt.Errorf("invalid lease mapping key %q", kv.Key)
+		}
+		// the key is attached to the destination lease it maps to.
+		did := clientv3.LeaseID(kv.Lease)
+		ttl, err := lm.dc.TimeToLive(ctx, did)
+		if err != nil {
+			return err
+		}
+		if ttl.TTL == -1 {
+			continue
+		}
+		lm.leases[clientv3.LeaseID(id)] = did
+		go lm.keepAlive(ctx, clientv3.LeaseID(id), did, time.Duration(ttl.GrantedTTL)*time.Second/3)
+	}
+	return nil
+}
+
+// destLease returns the destination lease of a source lease, or NoLease if
+// the source lease already expired.
+func (lm *leaseMirror) destLease(ctx context.Context, id clientv3.LeaseID) (clientv3.LeaseID, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	if did, ok := lm.leases[id]; ok {
+		return did, nil
+	}
+	ttl, err := lm.c.TimeToLive(ctx, id)
+	if err != nil {
+		return clientv3.NoLease, err
+	}
+	if ttl.TTL == -1 {
+		// the key is deleted along with its lease, which is mirrored next.
+		return clientv3.NoLease, nil
+	}
+	// the source lease may be about to expire, so the destination lease
+	// must not outlive it by its full granted TTL.
+	destTTL := max(ttl.TTL, 1)
+	resp, err := lm.dc.Grant(ctx, destTTL)
+	if err != nil {
+		return clientv3.NoLease, err
+	}
+	if mmresume {
+		if _, err = lm.dc.Put(ctx, leaseMappingKey(id), "", clientv3.WithLease(resp.ID)); err != nil {
+			return clientv3.NoLease, err
+		}
+	}
+	lm.leases[id] = resp.ID
+	go lm.keepAlive(ctx, id, resp.ID, time.Duration(destTTL)*time.Second/3)
+	return resp.ID, nil
+}
+
+// keepAlive renews the destination lease did until the source lease id expires.
+func (lm *leaseMirror) keepAlive(ctx context.Context, id, did clientv3.LeaseID, interval time.Duration) {
+	defer func() {
+		lm.mu.Lock()
+		delete(lm.leases, id)
+		lm.mu.Unlock()
+	}()
+	ticker := time.NewTicker(max(interval, 500*time.Millisecond))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ttl, err := lm.c.TimeToLive(ctx, id)
+		if err == nil && ttl.TTL == -1 {
+			lm.dc.Revoke(ctx, did)
+			return
+		}
+		if _, err = lm.dc.KeepAliveOnce(ctx, did); errors.Is(err, rpctypes.ErrLeaseNotFound) {
+			return
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorRules(t *testing.T) {
+	mmprefix, mmdestprefix = "/app/", "/mirror/"
+	mminclude, mmexclude = `^/app/(cfg|svc)/`, `\.tmp$`
+	mmrenames = []string{"/app/svc/=/services/"}
+	mmleases = mirrorLeasesDrop
+	defer func() {
+		mmprefix, mmdestprefix, mminclude, mmexclude, mmrenames = "", "", "", "", nil
+	}()
+	rules, err := newMirrorRules()
+	require.NoError(t, err)
+
+	tests := []struct {
+		key     string
+		destKey string
+		ok      bool
+	}{
+		{key: "/app/cfg/a", destKey: "/mirror/cfg/a", ok: true},
+		{key: "/app/svc/b", destKey: "/services/b", ok: true},
+		{key: "/app/cfg/a.tmp"},
+		{key: "/app/other/c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			destKey, ok := rules.destKey(tt.key)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.destKey, destKey)
+			if ok {
+				key, ok := rules.sourceKey(destKey)
+				require.True(t, ok)
+				require.Equal(t, tt.key, key)
+			}
+		})
+	}
+
+	// no mirrored key is mirrored to the keys renamed elsewhere or filtered out.
+	for _, key := range []string{"/mirror/svc/b", "/mirror/other/c", "/services/b.tmp"} {
+		_, ok := rules.sourceKey(key)
+		require.False(t, ok, key)
+	}
+	require.ElementsMatch(t, []string{"/mirror/", "/services/"}, rules.destPrefixes())
+}
+
+func TestMirrorRulesInvalid(t *testing.T) {
+	defer func() { mminclude, mmrenames, mmleases = "", nil, "" }()
+	for _, set := range []func(){
+		func() { mminclude = "(" },
+		func() { mmrenames = []string{"noequal"} },
+		func() { mmrenames = []string{"=/to/"} },
+		func() { mmleases = "expire" },
+	} {
+		mminclude, mmrenames, mmleases = "", nil, mirrorLeasesDrop
+		set()
+		_, err := newMirrorRules()
+		require.Error(t, err)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
@@ -57,6 +58,8 @@ type printer interface {
 
 	Alarm(v3.AlarmResponse)
 
+	PrefixQuota(v3.PrefixQuotaResponse)
+
 	RoleAdd(role string, r v3.AuthRoleAddResponse)
 	RoleGet(role string, r v3.AuthRoleGetResponse)
 	RoleDelete(role string, r v3.AuthRoleDeleteResponse)
@@ -122,6 +125,9 @@ func (p *printerRPC) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 }
 func (p *printerRPC) MemberList(r v3.MemberListResponse) { p.p((*pb.MemberListResponse)(&r)) }
 func (p *printerRPC) Alarm(r v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(&r)) }
+func (p *printerRPC) PrefixQuota(r v3.PrefixQuotaResponse) {
+	p.p((*pb.PrefixQuotaResponse)(&r))
+}
 func (p *printerRPC) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
 	p.p((*pb.MoveLeaderResponse)(&r))
 }
@@ -182,8 +188,38 @@ func (p *printerUnsupported) DowngradeValidate(r v3.DowngradeResponse)
 func (p *printerUnsupported) DowngradeEnable(r v3.DowngradeResponse)                    { p.p(nil) }
 func (p *printerUnsupported) DowngradeCancel(r v3.DowngradeResponse)                    { p.p(nil) }
 
+func makePrefixQuotaTable(r v3.PrefixQuotaResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"Prefix", "Used Bytes", "Quota Bytes", "Used Keys", "Quota Keys"}
+	for _, q := range r.Quotas {
+		quotaBytes, quotaKeys := "unlimited", "unlimited"
+		if q.QuotaBytes > 0 {
+			quotaBytes = humanize.Bytes(uint64(q.QuotaBytes))
+		}
+		if q.QuotaKeys > 0 {
+			quotaKeys = fmt.Sprint(q.QuotaKeys)
+		}
+		rows = append(rows, []string{
+			string(q.Prefix),
+			humanize.Bytes(uint64(q.UsedBytes)),
+			quotaBytes,
+			fmt.Sprint(q.UsedKeys),
+			quotaKeys,
+		})
+	}
+	return hdr, rows
+}
+
 func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string) {
 	hdr = []string{"ID", "Status", "Name", "Peer Addrs", "Client Addrs", "Is Learner"}
+	// the auto-promotion state of learners is only reported by the leader
+	withPromotion := false
+	for _, m := range r.Members {
+		if m.LearnerPromotion != nil {
+			withPromotion = true
+			hdr = append(hdr, "Auto Promotion")
+			break
+		}
+	}
 	for _, m := range r.Members {
 		status := "started"
 		if len(m.Name) == 0 {
@@ -193,18 +229,33 @@ func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string
 		if m.IsLearner {
 			isLearner = "true"
 		}
-		rows = append(rows, []string{
+		row := []string{
 			fmt.Sprintf("%x", m.ID),
 			status,
 			m.Name,
 			strings.Join(m.PeerURLs, ","),
 			strings.Join(m.ClientURLs, ","),
 			isLearner,
-		})
+		}
+		if withPromotion {
+			row = append(row, learnerPromotionString(m.LearnerPromotion))
+		}
+		rows = append(rows, row)
 	}
 	return hdr, rows
 }
 
+func learnerPromotionString(lp *pb.LearnerPromotion) string {
+	switch {
+	case lp == nil:
+		return ""
+	case lp.CaughtUpSince != 0:
+		return fmt.Sprintf("caught up for %v", time.Since(time.Unix(lp.CaughtUpSince, 0)).Round(time.Second))
+	default:
+		return fmt.Sprintf("lagging %d entries", lp.Lag)
+	}
+}
+
 func makeEndpointHealthTable(healthList []epHealth) (hdr []string, rows [][]string) {
 	hdr = []string{"endpoint", "health", "took", "error"}
 	for _, h := range healthList {
@@ -175,6 +175,10 @@ func (p *fieldsPrinter) MemberList(r v3.MemberListResponse) {
 			fmt.Printf("\"ClientURL\" : %q\n", u)
 		}
 		fmt.Println(`"IsLearner" :`, m.IsLearner)
+		if m.LearnerPromotion != nil {
+			fmt.Println(`"LearnerLag" :`, m.LearnerPromotion.Lag)
+			fmt.Println(`"LearnerCaughtUpSince" :`, m.LearnerPromotion.CaughtUpSince)
+		}
 		fmt.Println()
 	}
 }
@@ -233,6 +237,18 @@ func (p *fieldsPrinter) Alarm(r v3.AlarmResponse) {
 	}
 }
 
+func (p *fieldsPrinter) PrefixQuota(r v3.PrefixQuotaResponse) {
+	p.hdr(r.Header)
+	for _, q := range r.Quotas {
+		fmt.Printf("\"Prefix\" : %q\n", string(q.Prefix))
+		fmt.Println(`"QuotaBytes" :`, q.QuotaBytes)
+		fmt.Println(`"QuotaKeys" :`, q.QuotaKeys)
+		fmt.Println(`"UsedBytes" :`, q.UsedBytes)
+		fmt.Println(`"UsedKeys" :`, q.UsedKeys)
+		fmt.Println()
+	}
+}
+
 func (p *fieldsPrinter) RoleAdd(role string, r v3.AuthRoleAddResponse) { p.hdr(r.Header) }
 func (p *fieldsPrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 	p.hdr(r.Header)
@@ -240,6 +256,9 @@ func (p *fieldsPrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		fmt.Println(`"PermType" : `, p.PermType.String())
 		fmt.Printf("\"Key\" : %q\n", string(p.Key))
 		fmt.Printf("\"RangeEnd\" : %q\n", string(p.RangeEnd))
+		if p.Deny {
+			fmt.Println(`"Deny" : `, p.Deny)
+		}
 	}
 }
 func (p *fieldsPrinter) RoleDelete(role string, r v3.AuthRoleDeleteResponse) { p.hdr(r.Header) }
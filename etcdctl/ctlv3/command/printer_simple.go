@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	v3 "go.etcd.io/etcd/client/v3"
@@ -125,6 +126,13 @@ func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 	}
 }
 
+func (s *simplePrinter) PrefixQuota(resp v3.PrefixQuotaResponse) {
+	_, rows := makePrefixQuotaTable(resp)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
 func (s *simplePrinter) MemberAdd(r v3.MemberAddResponse) {
 	asLearner := " "
 	if r.Member.IsLearner {
@@ -206,8 +214,6 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		return
 	}
 
-	fmt.Println("KV Read:")
-
 	printRange := func(perm *v3.Permission) {
 		sKey := string(perm.Key)
 		sRangeEnd := string(perm.RangeEnd)
@@ -222,18 +228,22 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		fmt.Print("\n")
 	}
 
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermRead || perm.PermType == v3.PermReadWrite {
-			if len(perm.RangeEnd) == 0 {
-				fmt.Printf("\t%s\n", perm.Key)
-			} else {
-				printRange((*v3.Permission)(perm))
-			}
+	// printPerms prints the permissions matching the filter under the header,
+	// which is omitted without matching permissions unless always is set.
+	printPerms := func(header string, always bool, filter func(perm *authpb.Permission) bool) {
+		printed := false
+		if always {
+			fmt.Println(header)
+			printed = true
 		}
-	}
-	fmt.Println("KV Write:")
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermWrite || perm.PermType == v3.PermReadWrite {
+		for _, perm := range r.Perm {
+			if !filter(perm) {
+				continue
+			}
+			if !printed {
+				fmt.Println(header)
+				printed = true
+			}
 			if len(perm.RangeEnd) == 0 {
 				fmt.Printf("\t%s\n", perm.Key)
 			} else {
@@ -241,6 +251,18 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 			}
 		}
 	}
+	readable := func(perm *authpb.Permission) bool {
+		return perm.PermType == v3.PermRead || perm.PermType == v3.PermReadWrite || perm.PermType == v3.PermAdmin
+	}
+	writable := func(perm *authpb.Permission) bool {
+		return perm.PermType == v3.PermWrite || perm.PermType == v3.PermReadWrite || perm.PermType == v3.PermAdmin
+	}
+
+	printPerms("KV Read:", true, func(perm *authpb.Permission) bool { return !perm.Deny && readable(perm) })
+	printPerms("KV Write:", true, func(perm *authpb.Permission) bool { return !perm.Deny && writable(perm) })
+	printPerms("KV Admin:", false, func(perm *authpb.Permission) bool { return !perm.Deny && perm.PermType == v3.PermAdmin })
+	printPerms("KV Read Denied:", false, func(perm *authpb.Permission) bool { return perm.Deny && readable(perm) })
+	printPerms("KV Write Denied:", false, func(perm *authpb.Permission) bool { return perm.Deny && writable(perm) })
 }
 
 func (s *simplePrinter) RoleList(r v3.AuthRoleListResponse) {
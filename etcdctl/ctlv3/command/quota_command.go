@@ -0,0 +1,126 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+var (
+	quotaBytes string
+	quotaKeys  int64
+)
+
+// NewQuotaCommand returns the cobra command for "quota".
+func NewQuotaCommand() *cobra.Command {
+	qc := &cobra.Command{
+		Use:   "quota <subcommand>",
+		Short: "Prefix quota related commands",
+	}
+
+	qc.AddCommand(NewQuotaSetCommand())
+	qc.AddCommand(NewQuotaDeleteCommand())
+	qc.AddCommand(NewQuotaListCommand())
+
+	return qc
+}
+
+func NewQuotaSetCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "set <prefix>",
+		Short: "Limits the size and number of keys under a prefix",
+		Run:   quotaSetCommandFunc,
+	}
+	cmd.Flags().StringVar(&quotaBytes, "bytes", "", "Maximum combined size of keys and values under the prefix (e.g. 512MB)")
+	cmd.Flags().Int64Var(&quotaKeys, "keys", 0, "Maximum number of keys under the prefix")
+	return &cmd
+}
+
+// quotaSetCommandFunc executes the "quota set" command.
+func quotaSetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota set command needs 1 argument"))
+	}
+	var qb int64
+	if quotaBytes != "" {
+		b, err := humanize.ParseBytes(quotaBytes)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad --bytes value: %w", err))
+		}
+		qb = int64(b)
+	}
+	if qb == 0 && quotaKeys == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota set command needs --bytes or --keys"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).PrefixQuotaPut(ctx, args[0], qb, quotaKeys)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.PrefixQuota(*resp)
+}
+
+func NewQuotaDeleteCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "delete <prefix>",
+		Short: "Removes the quota of a prefix",
+		Run:   quotaDeleteCommandFunc,
+	}
+	return &cmd
+}
+
+// quotaDeleteCommandFunc executes the "quota delete" command.
+func quotaDeleteCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota delete command needs 1 argument"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).PrefixQuotaDelete(ctx, args[0])
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.PrefixQuota(*resp)
+}
+
+func NewQuotaListCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "list",
+		Short: "Lists all prefix quotas and their usage",
+		Run:   quotaListCommandFunc,
+	}
+	return &cmd
+}
+
+// quotaListCommandFunc executes the "quota list" command.
+func quotaListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota list command accepts no arguments"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).PrefixQuotaList(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.PrefixQuota(*resp)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+// NewReloadConfigCommand returns the cobra command for "reload-config".
+func NewReloadConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reload-config",
+		Short: "Reloads the configuration files of the etcd members with given endpoints",
+		Run:   reloadConfigCommandFunc,
+	}
+	cmd.PersistentFlags().BoolVar(&epClusterEndpoints, "cluster", false, "use all endpoints from the cluster member list")
+	return cmd
+}
+
+func reloadConfigCommandFunc(cmd *cobra.Command, args []string) {
+	failures := 0
+	cfg := clientConfigFromCmd(cmd)
+	for _, ep := range endpointsFromCluster(cmd) {
+		cfg.Endpoints = []string{ep}
+		c := mustClient(cfg)
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.ReloadConfig(ctx, ep)
+		cancel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to reload the configuration of etcd member[%s]. (%v)\n", ep, err)
+			failures++
+		} else {
+			fmt.Printf("Reloaded the configuration of etcd member[%s]. applied: [%s], restart required: [%s]\n",
+				ep, strings.Join(resp.AppliedFields, ", "), strings.Join(resp.RestartRequiredFields, ", "))
+		}
+		c.Close()
+	}
+
+	if failures != 0 {
+		os.Exit(cobrautl.ExitError)
+	}
+}
@@ -27,6 +27,7 @@ import (
 var (
 	rolePermPrefix  bool
 	rolePermFromKey bool
+	rolePermDeny    bool
 )
 
 // NewRoleCommand returns the cobra command for "role".
@@ -87,6 +88,7 @@ func newRoleGrantPermissionCommand() *cobra.Command {
 
 	cmd.Flags().BoolVar(&rolePermPrefix, "prefix", false, "grant a prefix permission")
 	cmd.Flags().BoolVar(&rolePermFromKey, "from-key", false, "grant a permission of keys that are greater than or equal to the given key using byte compare")
+	cmd.Flags().BoolVar(&rolePermDeny, "deny", false, "deny the permission instead of granting it; deny permissions take precedence over granted permissions")
 
 	return cmd
 }
@@ -173,7 +175,13 @@ func roleGrantPermissionCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	key, rangeEnd := permRange(args[2:])
-	resp, err := mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	client := mustClientFromCmd(cmd)
+	var resp *clientv3.AuthRoleGrantPermissionResponse
+	if rolePermDeny {
+		resp, err = client.Auth.RoleDenyPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	} else {
+		resp, err = client.Auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
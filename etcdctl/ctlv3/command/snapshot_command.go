@@ -44,7 +44,12 @@ var snapshotExample = util.Normalize(`
 	etcdctl --endpoints=https://127.0.0.1:2379 --dial-timeout=20s snapshot save /backup/etcd-snapshot.db
 
 	# Save snapshot with desirable time format
-	etcdctl snapshot save /mnt/backup/etcd/backup_$(date +%Y%m%d_%H%M%S).db`)
+	etcdctl snapshot save /mnt/backup/etcd/backup_$(date +%Y%m%d_%H%M%S).db
+
+	# Save the changes since revision 1000 as a delta to restore on top of a snapshot
+	etcdctl snapshot save --since-revision=1000 /backup/etcd-delta.db`)
+
+var snapshotSinceRev int64
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
 func NewSnapshotCommand() *cobra.Command {
@@ -58,12 +63,14 @@ func NewSnapshotCommand() *cobra.Command {
 }
 
 func NewSnapshotSaveCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "save <filename>",
 		Short:   "Stores an etcd node backend snapshot to a given file",
 		Run:     snapshotSaveCommandFunc,
 		Example: snapshotExample,
 	}
+	cmd.Flags().Int64Var(&snapshotSinceRev, "since-revision", 0, "Store only the changes after the given revision as a delta to restore on top of a snapshot")
+	return cmd
 }
 
 func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
@@ -86,6 +93,17 @@ func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
 	defer cancel()
 
 	path := args[0]
+	if cmd.Flags().Changed("since-revision") {
+		version, err := snapshot.SaveDelta(ctx, lg, *cfg, path, snapshotSinceRev)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
+		}
+		fmt.Printf("Snapshot delta since revision %d saved at %s\n", snapshotSinceRev, path)
+		if version != "" {
+			fmt.Printf("Server version %s\n", version)
+		}
+		return
+	}
 	version, err := snapshot.SaveWithVersion(ctx, lg, *cfg, path)
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
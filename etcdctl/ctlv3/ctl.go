@@ -81,7 +81,9 @@ func init() {
 		command.NewTxnCommand(),
 		command.NewCompactionCommand(),
 		command.NewAlarmCommand(),
+		command.NewQuotaCommand(),
 		command.NewDefragCommand(),
+		command.NewReloadConfigCommand(),
 		command.NewEndpointCommand(),
 		command.NewMoveLeaderCommand(),
 		command.NewWatchCommand(),
@@ -90,6 +92,7 @@ func init() {
 		command.NewMemberCommand(),
 		command.NewSnapshotCommand(),
 		command.NewMakeMirrorCommand(),
+		command.NewCDCCommand(),
 		command.NewLockCommand(),
 		command.NewElectCommand(),
 		command.NewAuthCommand(),
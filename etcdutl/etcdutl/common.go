@@ -24,6 +24,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
 	"go.etcd.io/raft/v3/raftpb"
@@ -40,6 +41,19 @@ func GetLogger() *zap.Logger {
 	return lg
 }
 
+// newEncryptionKMS returns the KMS of the key encryption keys of keyFile, or
+// nil if keyFile is empty.
+func newEncryptionKMS(keyFile string) encryption.KMS {
+	if keyFile == "" {
+		return nil
+	}
+	kms, err := encryption.NewLocalKMS(keyFile)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	return kms
+}
+
 func getLatestWALSnap(lg *zap.Logger, dataDir string) (walpb.Snapshot, error) {
 	snapshot, err := getLatestV2Snapshot(lg, dataDir)
 	if err != nil {
@@ -20,10 +20,15 @@ import (
 
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
-var hashKVRevision int64
+var (
+	hashKVRevision          int64
+	hashKVEncryptionKeyFile string
+)
 
 // NewHashKVCommand returns the cobra command for "hashkv".
 func NewHashKVCommand() *cobra.Command {
@@ -34,13 +39,14 @@ func NewHashKVCommand() *cobra.Command {
 		Run:   hashKVCommandFunc,
 	}
 	cmd.Flags().Int64Var(&hashKVRevision, "rev", 0, "maximum revision to hash (default: latest revision)")
+	cmd.Flags().StringVar(&hashKVEncryptionKeyFile, "encryption-key-file", "", "Path to the key encryption keys of a file of encrypted values")
 	return cmd
 }
 
 func hashKVCommandFunc(cmd *cobra.Command, args []string) {
 	printer := initPrinterFromCmd(cmd)
 
-	ds, err := calculateHashKV(args[0], hashKVRevision)
+	ds, err := calculateHashKV(args[0], hashKVRevision, newEncryptionKMS(hashKVEncryptionKeyFile))
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
@@ -53,11 +59,26 @@ type HashKV struct {
 	CompactRevision int64  `json:"compactRevision"`
 }
 
-func calculateHashKV(dbPath string, rev int64) (HashKV, error) {
+func calculateHashKV(dbPath string, rev int64, kms encryption.KMS) (HashKV, error) {
 	cfg := backend.DefaultBackendConfig(zap.NewNop())
 	cfg.Path = dbPath
 	b := backend.New(cfg)
-	st := mvcc.NewStore(zap.NewNop(), b, nil, mvcc.StoreConfig{})
+
+	tx := b.ReadTx()
+	tx.RLock()
+	keys, _, err := schema.UnsafeReadDataKeys(tx)
+	if err == nil {
+		err = encryption.UnsafeCheck(tx, kms)
+	}
+	tx.RUnlock()
+	if err != nil {
+		return HashKV{}, err
+	}
+	// the values of a file never encrypted are left in plaintext.
+	if len(keys) == 0 {
+		kms = nil
+	}
+	st := mvcc.NewStore(zap.NewNop(), b, nil, mvcc.StoreConfig{Cipher: encryption.NewCipher(kms)})
 	hst := mvcc.NewHashStorage(zap.NewNop(), st)
 
 	h, _, err := hst.HashByRev(rev)
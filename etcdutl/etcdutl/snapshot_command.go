@@ -17,6 +17,7 @@ package etcdutl
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -42,6 +43,12 @@ var (
 	initialMmapSize     = backend.InitialMmapSize
 	markCompacted       bool
 	revisionBump        uint64
+	restoreDeltas       []string
+	restoreWALArchive   string
+	restoreToRevision   int64
+	restoreToTime       string
+
+	encryptionKeyFile string
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -56,7 +63,7 @@ func NewSnapshotCommand() *cobra.Command {
 }
 
 func newSnapshotStatusCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "status <filename>",
 		Short: "Gets backend snapshot status of a given file",
 		Long: `When --write-out is set to simple, this command prints out comma-separated status lists for each endpoint.
@@ -64,6 +71,8 @@ The items in the lists are hash, revision, total keys, total size.
 `,
 		Run: SnapshotStatusCommandFunc,
 	}
+	cmd.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "Path to the key encryption keys of a snapshot of encrypted values")
+	return cmd
 }
 
 func NewSnapshotRestoreCommand() *cobra.Command {
@@ -82,9 +91,15 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().Uint64Var(&initialMmapSize, "initial-memory-map-size", initialMmapSize, "Initial memory map size of the database in bytes. It uses the default value if not defined or defined to 0")
 	cmd.Flags().Uint64Var(&revisionBump, "bump-revision", 0, "How much to increase the latest revision after restore")
 	cmd.Flags().BoolVar(&markCompacted, "mark-compacted", false, "Mark the latest revision after restore as the point of scheduled compaction (required if --bump-revision > 0, disallowed otherwise)")
+	cmd.Flags().StringArrayVar(&restoreDeltas, "delta", nil, "Path to a snapshot delta to apply on top of the snapshot, can be repeated to apply a chain of deltas in order")
+	cmd.Flags().StringVar(&restoreWALArchive, "wal-archive-dir", "", "Path to archived WAL segments to replay on top of the snapshot")
+	cmd.Flags().Int64Var(&restoreToRevision, "to-revision", 0, "Replay the archived WAL up to the revision (requires --wal-archive-dir)")
+	cmd.Flags().StringVar(&restoreToTime, "to-time", "", "Replay the archived WAL up to the time in RFC3339 format (requires --wal-archive-dir)")
+	cmd.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "Path to the key encryption keys of a snapshot of encrypted values, to encrypt the replayed WAL entries and the deltas")
 
 	cmd.MarkFlagDirname("data-dir")
 	cmd.MarkFlagDirname("wal-dir")
+	cmd.MarkFlagDirname("wal-archive-dir")
 
 	return cmd
 }
@@ -97,7 +112,7 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 	printer := initPrinterFromCmd(cmd)
 
 	lg := GetLogger()
-	sp := snapshot.NewV3(lg)
+	sp := snapshot.NewV3WithKMS(lg, newEncryptionKMS(encryptionKeyFile))
 	ds, err := sp.Status(args[0])
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
@@ -107,7 +122,8 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 
 func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
 	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWALDir,
-		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, args)
+		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, restoreDeltas,
+		restoreWALArchive, restoreToRevision, restoreToTime, encryptionKeyFile, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -120,6 +136,11 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	initialMmapSize uint64,
 	revisionBump uint64,
 	markCompacted bool,
+	deltaPaths []string,
+	walArchiveDir string,
+	toRevision int64,
+	toTime string,
+	encryptionKeyFile string,
 	args []string,
 ) {
 	if len(args) != 1 {
@@ -132,6 +153,14 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
+	var to time.Time
+	if toTime != "" {
+		var err error
+		if to, err = time.Parse(time.RFC3339, toTime); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --to-time: %w", err))
+		}
+	}
+
 	dataDir := restoreDataDir
 	if dataDir == "" {
 		dataDir = restoreName + ".etcd"
@@ -143,7 +172,7 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	}
 
 	lg := GetLogger()
-	sp := snapshot.NewV3(lg)
+	sp := snapshot.NewV3WithKMS(lg, newEncryptionKMS(encryptionKeyFile))
 
 	if err := sp.Restore(snapshot.RestoreConfig{
 		SnapshotPath:        args[0],
@@ -157,6 +186,10 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		InitialMmapSize:     initialMmapSize,
 		RevisionBump:        revisionBump,
 		MarkCompacted:       markCompacted,
+		DeltaPaths:          deltaPaths,
+		WALArchiveDir:       walArchiveDir,
+		ToRevision:          toRevision,
+		ToTime:              to,
 	}); err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
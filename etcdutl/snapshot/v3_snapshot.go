@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -26,6 +27,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -41,7 +43,10 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
@@ -76,8 +81,15 @@ func NewV3(lg *zap.Logger) Manager {
 	return &v3Manager{lg: lg}
 }
 
+// NewV3WithKMS returns a new snapshot Manager for v3.x snapshot, whose values
+// are encrypted with data encryption keys wrapped by kms.
+func NewV3WithKMS(lg *zap.Logger, kms encryption.KMS) Manager {
+	return &v3Manager{lg: lg, kms: kms}
+}
+
 type v3Manager struct {
-	lg *zap.Logger
+	lg  *zap.Logger
+	kms encryption.KMS
 
 	name      string
 	srcDbPath string
@@ -89,6 +101,9 @@ type v3Manager struct {
 	initialMmapSize uint64
 }
 
+// deltaApplyBatchSize is the number of delta entries written to the backend at once.
+const deltaApplyBatchSize = 10000
+
 // hasChecksum returns "true" if the file size "n"
 // has appended sha256 hash digest.
 func hasChecksum(n int64) bool {
@@ -142,6 +157,16 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 		if v != nil {
 			ds.Version = v.String()
 		}
+		keys, active, err := schema.ReadDataKeysFromSnapshot(tx)
+		if err != nil {
+			return err
+		}
+		cipher := encryption.NewCipher(s.kms)
+		if cipher != nil {
+			if err = cipher.Load(keys, active); err != nil {
+				return err
+			}
+		}
 		c := tx.Cursor()
 		for next, _ := c.First(); next != nil; next, _ = c.Next() {
 			b := tx.Bucket(next)
@@ -171,6 +196,10 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 					}
 					ds.Revision = rev.Main
 
+					v, err = cipher.Decrypt(k, v)
+					if err != nil {
+						return fmt.Errorf("cannot decrypt value, key: %q err: %w", k, err)
+					}
 					var kv mvccpb.KeyValue
 					err = kv.Unmarshal(v)
 					if err != nil {
@@ -250,10 +279,31 @@ type RestoreConfig struct {
 	// MarkCompacted is "true" to mark the latest revision as compacted.
 	// (required if RevisionBump > 0)
 	MarkCompacted bool
+
+	// DeltaPaths are the paths of snapshot deltas to apply in order on top of
+	// the snapshot. Each delta must start at or before the revision reached by
+	// the snapshot and the deltas applied before it.
+	DeltaPaths []string
+
+	// WALArchiveDir is the directory of archived WAL segments to replay on top
+	// of the snapshot, or a local sink of the WAL archiver whose segments are
+	// verified against its manifest. The segments must cover the entries
+	// following the consistent index of the snapshot. Cannot be combined with
+	// DeltaPaths.
+	WALArchiveDir string
+	// ToRevision stops the WAL replay once the revision is reached.
+	// If 0, the WAL is replayed up to its last committed entry.
+	ToRevision int64
+	// ToTime stops the WAL replay before the first request proposed after it.
+	// Cannot be combined with ToRevision.
+	ToTime time.Time
 }
 
 // Restore restores a new etcd data directory from given snapshot file.
 func (s *v3Manager) Restore(cfg RestoreConfig) error {
+	if err := cfg.validateReplay(); err != nil {
+		return err
+	}
 	pURLs, err := types.NewURLs(cfg.PeerURLs)
 	if err != nil {
 		return err
@@ -315,6 +365,21 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 		return err
 	}
 
+	if len(cfg.DeltaPaths) > 0 {
+		if err = s.applyDeltas(cfg.DeltaPaths); err != nil {
+			return err
+		}
+		if err = s.recomputePrefixQuotaUsage(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.WALArchiveDir != "" {
+		if err = s.replayWAL(cfg); err != nil {
+			return err
+		}
+	}
+
 	if cfg.MarkCompacted && cfg.RevisionBump > 0 {
 		if err = s.modifyLatestRevision(cfg.RevisionBump); err != nil {
 			return err
@@ -346,6 +411,20 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	})
 }
 
+func (cfg RestoreConfig) validateReplay() error {
+	switch {
+	case cfg.WALArchiveDir == "" && (cfg.ToRevision != 0 || !cfg.ToTime.IsZero()):
+		return errors.New("a WAL archive is required to restore to a revision or time")
+	case cfg.WALArchiveDir != "" && len(cfg.DeltaPaths) > 0:
+		return errors.New("snapshot deltas cannot be combined with a WAL archive")
+	case cfg.ToRevision < 0:
+		return fmt.Errorf("invalid revision %d to restore to", cfg.ToRevision)
+	case cfg.ToRevision != 0 && !cfg.ToTime.IsZero():
+		return errors.New("cannot restore to both a revision and a time")
+	}
+	return nil
+}
+
 func (s *v3Manager) outDbPath() string {
 	return filepath.Join(s.snapDir, "db")
 }
@@ -368,6 +447,248 @@ func (s *v3Manager) saveDB() error {
 	return nil
 }
 
+// applyDeltas writes the changes of the deltas to the key bucket of the
+// database snapshot. The key index is rebuilt from the key bucket on start.
+func (s *v3Manager) applyDeltas(paths []string) error {
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	defer func() {
+		be.ForceCommit()
+		be.Close()
+	}()
+
+	// the values of the deltas are re-encrypted with the data encryption
+	// key of the snapshot.
+	cipher := encryption.NewCipher(s.kms)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	latest, err := s.unsafeGetLatestRevision(tx)
+	compacted, _ := mvcc.UnsafeReadFinishedCompact(tx)
+	if err == nil {
+		err = cipher.UnsafeInit(tx)
+	}
+	tx.Unlock()
+	if err != nil {
+		return err
+	}
+	rev := max(latest.Main, compacted)
+
+	for _, path := range paths {
+		if rev, err = s.applyDelta(tx, cipher, path, rev); err != nil {
+			return fmt.Errorf("failed to apply delta %q: %w", path, err)
+		}
+	}
+
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	return s.unsafeClearMissingLeases(tx, cipher)
+}
+
+// applyDelta applies the changes of the delta after the revision rev and
+// returns the revision reached after applying it.
+func (s *v3Manager) applyDelta(tx backend.BatchTx, cipher *encryption.Cipher, path string, rev int64) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return rev, err
+	}
+	defer f.Close()
+
+	size, err := s.verifyDelta(f)
+	if err != nil {
+		return rev, err
+	}
+	dr, err := mvcc.NewDeltaReader(io.LimitReader(f, size))
+	if err != nil {
+		return rev, err
+	}
+	if dr.SinceRev > rev {
+		return rev, fmt.Errorf("delta starts after revision %d, but the revisions applied so far end at %d", dr.SinceRev, rev)
+	}
+	// the delta may come from another member, or from before a rotation of
+	// the data encryption keys, so its values are decrypted with its own keys.
+	deltaCipher := encryption.NewCipher(s.kms)
+	if deltaCipher != nil && len(dr.DataKeys) > 0 {
+		if err = deltaCipher.Load(dr.DataKeys, dr.DataKeys[0].ID); err != nil {
+			return rev, err
+		}
+	}
+
+	s.lg.Info(
+		"applying snapshot delta",
+		zap.String("path", path),
+		zap.Int64("since-revision", dr.SinceRev),
+		zap.Int64("revision", dr.Rev),
+	)
+	applied := 0
+	for done := false; !done; {
+		// unlock after every batch of entries to let the backend commit them
+		tx.LockOutsideApply()
+		for i := 0; i < deltaApplyBatchSize; i++ {
+			k, v, nerr := dr.Next()
+			if errors.Is(nerr, io.EOF) {
+				done = true
+				break
+			}
+			if nerr != nil {
+				tx.Unlock()
+				return rev, nerr
+			}
+			// revisions already in the snapshot or an earlier delta
+			if mvcc.BytesToRev(k).Main <= rev {
+				continue
+			}
+			// the markers of revision bumps are empty.
+			if len(v) > 0 {
+				if v, nerr = deltaCipher.Decrypt(k, v); nerr != nil {
+					tx.Unlock()
+					return rev, nerr
+				}
+				v = cipher.Encrypt(k, v)
+			}
+			tx.UnsafePut(schema.Key, k, v)
+			applied++
+		}
+		tx.Unlock()
+	}
+	leases, err := dr.Leases()
+	if err != nil {
+		return rev, err
+	}
+	// the leases of a delta are as of its revision, so they replace the
+	// leases of the snapshot and of the earlier deltas unless it is older.
+	if dr.Rev > rev {
+		tx.LockOutsideApply()
+		for _, l := range schema.MustUnsafeGetAllLeases(tx) {
+			schema.UnsafeDeleteLease(tx, l)
+		}
+		for _, l := range leases {
+			schema.MustUnsafePutLease(tx, l)
+		}
+		tx.Unlock()
+	}
+	s.lg.Info(
+		"applied snapshot delta",
+		zap.String("path", path),
+		zap.Int("entries", applied),
+		zap.Int("leases", len(leases)),
+	)
+	return max(rev, dr.Rev), nil
+}
+
+// unsafeClearMissingLeases detaches the keys from the leases missing from the
+// lease bucket, as they would never expire otherwise. A lease is missing if it
+// was revoked while the last delta was written.
+func (s *v3Manager) unsafeClearMissingLeases(tx backend.UnsafeReadWriter, cipher *encryption.Cipher) error {
+	leases := make(map[int64]struct{})
+	for _, l := range schema.MustUnsafeGetAllLeases(tx) {
+		leases[l.ID] = struct{}{}
+	}
+
+	// the latest revision of every key attached to a missing lease
+	type keyRev struct {
+		rev []byte
+		kv  mvccpb.KeyValue
+	}
+	missing := make(map[string]keyRev)
+	err := tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		// the markers of revision bumps are empty.
+		if len(v) == 0 {
+			return nil
+		}
+		v, err := cipher.Decrypt(k, v)
+		if err != nil {
+			return err
+		}
+		var kv mvccpb.KeyValue
+		if err = kv.Unmarshal(v); err != nil {
+			return err
+		}
+		if _, ok := leases[kv.Lease]; mvcc.IsTombstone(k) || kv.Lease == 0 || ok {
+			delete(missing, string(kv.Key))
+			return nil
+		}
+		missing[string(kv.Key)] = keyRev{rev: bytes.Clone(k), kv: kv}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[int64]struct{})
+	for _, kr := range missing {
+		ids[kr.kv.Lease] = struct{}{}
+		kr.kv.Lease = 0
+		v, err := kr.kv.Marshal()
+		if err != nil {
+			return err
+		}
+		tx.UnsafePut(schema.Key, kr.rev, cipher.Encrypt(kr.rev, v))
+	}
+	if len(missing) > 0 {
+		s.lg.Warn(
+			"cleared the leases of keys attached to leases missing from the snapshot deltas",
+			zap.Int("keys", len(missing)),
+			zap.Int("leases", len(ids)),
+		)
+	}
+	return nil
+}
+
+// recomputePrefixQuotaUsage recomputes the usage of the prefix quotas, as the
+// deltas are written to the key bucket without tracking it.
+func (s *v3Manager) recomputePrefixQuotaUsage() error {
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	defer be.Close()
+
+	pqs, err := serverstorage.NewPrefixQuotaStore(s.lg, schema.NewQuotaBackend(s.lg, be))
+	if err != nil || len(pqs.List()) == 0 {
+		return err
+	}
+	tx := be.ReadTx()
+	tx.RLock()
+	err = encryption.UnsafeCheck(tx, s.kms)
+	tx.RUnlock()
+	if err != nil {
+		return err
+	}
+	kv := mvcc.NewStore(s.lg, be, &lease.FakeLessor{}, mvcc.StoreConfig{Cipher: encryption.NewCipher(s.kms)})
+	defer kv.Close()
+	if err = pqs.RecomputeUsage(kv); err != nil {
+		return err
+	}
+	be.ForceCommit()
+	return nil
+}
+
+// verifyDelta checks the sha256 checksum appended to the delta and returns
+// the size of the delta without the checksum.
+func (s *v3Manager) verifyDelta(f *os.File) (int64, error) {
+	st, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := st.Size() - sha256.Size
+	if size < 0 {
+		return 0, fmt.Errorf("delta is missing the sha256 checksum")
+	}
+	if !s.skipHashCheck {
+		h := sha256.New()
+		if _, err = io.CopyN(h, f, size); err != nil {
+			return 0, err
+		}
+		sha := make([]byte, sha256.Size)
+		if _, err = io.ReadFull(f, sha); err != nil {
+			return 0, err
+		}
+		if dsha := h.Sum(nil); !bytes.Equal(sha, dsha) {
+			return 0, fmt.Errorf("expected sha256 %v, got %v", sha, dsha)
+		}
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
 // modifyLatestRevision can increase the latest revision by the given amount and sets the scheduled compaction
 // to that revision so that the server will consider this revision compacted.
 func (s *v3Manager) modifyLatestRevision(bumpAmount uint64) error {
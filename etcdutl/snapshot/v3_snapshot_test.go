@@ -15,8 +15,13 @@
 package snapshot
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -30,6 +35,11 @@ import (
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
@@ -218,6 +228,164 @@ func TestSnapshotStatusTotalKey(t *testing.T) {
 	}
 }
 
+// TestSnapshotStatusEncrypted tests the status of a snapshot of encrypted values.
+func TestSnapshotStatusEncrypted(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("k1:"+base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	kms, err := encryption.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+
+	dbpath := createDBWithConfig(t, func(cfg *embed.Config) { cfg.EncryptionKeyFile = keyFile }, insertKeys(t, 10, 100))
+
+	db, err := bbolt.Open(dbpath, 0o400, &bbolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	err = db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(schema.Key.Name()).ForEach(func(k, v []byte) error {
+			assert.Truef(t, encryption.IsEncrypted(v), "value of revision %x is not encrypted", k)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = NewV3(zap.NewNop()).Status(dbpath)
+	require.ErrorIs(t, err, encryption.ErrKeyRequired)
+
+	status, err := NewV3WithKMS(zap.NewNop(), kms).Status(dbpath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), status.Revision)
+	assert.Equal(t, 10, status.TotalKey)
+}
+
+// TestRestoreEncryptedDelta ensures that the values of a delta of another member,
+// encrypted with its own data encryption key, are re-encrypted with the key of
+// the snapshot on restore.
+func TestRestoreEncryptedDelta(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("k1:"+base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	kms, err := encryption.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+
+	dbpath := createDBWithConfig(t, func(cfg *embed.Config) { cfg.EncryptionKeyFile = keyFile }, insertKeys(t, 10, 100))
+
+	// the other member has a data encryption key of the same ID.
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	kv := mvcc.NewStore(zap.NewNop(), b, &lease.FakeLessor{}, mvcc.StoreConfig{Cipher: encryption.NewCipher(kms)})
+	for i := 0; i < 13; i++ {
+		kv.Put([]byte(strconv.Itoa(i)), []byte("delta"), lease.NoLease)
+	}
+	var delta bytes.Buffer
+	require.NoError(t, mvcc.WriteDelta(&delta, kv, b, 11, 14))
+	require.NoError(t, kv.Close())
+	betesting.Close(t, b)
+	sha := sha256.Sum256(delta.Bytes())
+	deltaPath := filepath.Join(t.TempDir(), "delta")
+	require.NoError(t, os.WriteFile(deltaPath, append(delta.Bytes(), sha[:]...), 0o600))
+
+	restore := func(sp Manager, dataDir string) error {
+		return sp.Restore(RestoreConfig{
+			SnapshotPath:        dbpath,
+			DeltaPaths:          []string{deltaPath},
+			Name:                "default",
+			OutputDataDir:       dataDir,
+			PeerURLs:            []string{"http://localhost:2380"},
+			InitialCluster:      "default=http://localhost:2380",
+			InitialClusterToken: "etcd-cluster",
+			SkipHashCheck:       true,
+		})
+	}
+	require.ErrorIs(t, restore(NewV3(zap.NewNop()), filepath.Join(t.TempDir(), "restored")), encryption.ErrKeyRequired)
+	dataDir := filepath.Join(t.TempDir(), "restored")
+	require.NoError(t, restore(NewV3WithKMS(zap.NewNop(), kms), dataDir))
+
+	rb := backend.NewDefaultBackend(zap.NewNop(), filepath.Join(dataDir, "member", "snap", "db"))
+	rkv := mvcc.NewStore(zap.NewNop(), rb, &lease.FakeLessor{}, mvcc.StoreConfig{Cipher: encryption.NewCipher(kms)})
+	defer func() {
+		rkv.Close()
+		rb.Close()
+	}()
+	r, err := rkv.Range(context.TODO(), []byte("0"), []byte{0xff}, mvcc.RangeOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(14), r.Rev)
+	values := make(map[string]string)
+	for _, kv := range r.KVs {
+		values[string(kv.Key)] = string(kv.Value)
+	}
+	assert.Len(t, values, 13)
+	for _, k := range []string{"10", "11", "12"} {
+		assert.Equal(t, "delta", values[k])
+	}
+}
+
+// TestRestoreDeltaLeases ensures that the leases granted after the snapshot are
+// restored from the delta, and that the keys attached to leases missing from it
+// are detached.
+func TestRestoreDeltaLeases(t *testing.T) {
+	dbpath := createDB(t, insertKeys(t, 10, 100))
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	kv := mvcc.NewStore(zap.NewNop(), b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	for i := 0; i < 11; i++ {
+		kv.Put([]byte(strconv.Itoa(i)), []byte("delta"), lease.NoLease)
+	}
+	kv.Put([]byte("granted"), []byte("delta"), 1)
+	kv.Put([]byte("revoked"), []byte("delta"), 2)
+	tx := b.BatchTx()
+	tx.LockOutsideApply()
+	schema.UnsafeCreateLeaseBucket(tx)
+	schema.MustUnsafePutLease(tx, &leasepb.Lease{ID: 1, TTL: 60})
+	tx.Unlock()
+	var delta bytes.Buffer
+	require.NoError(t, mvcc.WriteDelta(&delta, kv, b, 11, 14))
+	require.NoError(t, kv.Close())
+	betesting.Close(t, b)
+	sha := sha256.Sum256(delta.Bytes())
+	deltaPath := filepath.Join(t.TempDir(), "delta")
+	require.NoError(t, os.WriteFile(deltaPath, append(delta.Bytes(), sha[:]...), 0o600))
+
+	dataDir := filepath.Join(t.TempDir(), "restored")
+	require.NoError(t, NewV3(zap.NewNop()).Restore(RestoreConfig{
+		SnapshotPath:        dbpath,
+		DeltaPaths:          []string{deltaPath},
+		Name:                "default",
+		OutputDataDir:       dataDir,
+		PeerURLs:            []string{"http://localhost:2380"},
+		InitialCluster:      "default=http://localhost:2380",
+		InitialClusterToken: "etcd-cluster",
+		SkipHashCheck:       true,
+	}))
+
+	rb := backend.NewDefaultBackend(zap.NewNop(), filepath.Join(dataDir, "member", "snap", "db"))
+	rtx := rb.ReadTx()
+	rtx.RLock()
+	leases := schema.MustUnsafeGetAllLeases(rtx)
+	rtx.RUnlock()
+	rkv := mvcc.NewStore(zap.NewNop(), rb, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer func() {
+		rkv.Close()
+		rb.Close()
+	}()
+	require.Len(t, leases, 1)
+	assert.Equal(t, int64(1), leases[0].ID)
+	assert.Equal(t, int64(60), leases[0].TTL)
+
+	r, err := rkv.Range(context.TODO(), []byte("granted"), nil, mvcc.RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, r.KVs, 1)
+	assert.Equal(t, int64(1), r.KVs[0].Lease)
+	r, err = rkv.Range(context.TODO(), []byte("revoked"), nil, mvcc.RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, r.KVs, 1)
+	assert.Equal(t, int64(0), r.KVs[0].Lease)
+	assert.Equal(t, "delta", string(r.KVs[0].Value))
+}
+
 // insertKeys insert `numKeys` number of keys of `valueSize` size into a running etcd server.
 func insertKeys(t *testing.T, numKeys, valueSize int) func(*etcdserver.EtcdServer) {
 	t.Helper()
@@ -239,11 +407,19 @@ func insertKeys(t *testing.T, numKeys, valueSize int) func(*etcdserver.EtcdServe
 // It returns the path of bbolt database.
 func createDB(t *testing.T, generateContent func(*etcdserver.EtcdServer)) string {
 	t.Helper()
+	return createDBWithConfig(t, func(*embed.Config) {}, generateContent)
+}
+
+// createDBWithConfig is createDB with the server configuration changed by
+// configure.
+func createDBWithConfig(t *testing.T, configure func(*embed.Config), generateContent func(*etcdserver.EtcdServer)) string {
+	t.Helper()
 
 	cfg := embed.NewConfig()
 	cfg.BackendBatchLimit = 1
 	cfg.LogLevel = "fatal"
 	cfg.Dir = t.TempDir()
+	configure(cfg)
 
 	etcd, err := embed.StartEtcd(cfg)
 	require.NoError(t, err)
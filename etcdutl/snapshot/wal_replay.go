@@ -0,0 +1,264 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/server/v3/storage/walarchive"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// replayWarningApplyDuration is the apply duration above which replayed
+// requests are logged as expensive, the default of the etcd server.
+const replayWarningApplyDuration = 100 * time.Millisecond
+
+// replayWAL applies the committed entries of the archived WAL that follow the
+// consistent index of the database snapshot, using the same appliers as the
+// etcd server. It stops before the first entry past the revision or time
+// target of the restore, if any.
+func (s *v3Manager) replayWAL(cfg RestoreConfig) error {
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
+	defer func() {
+		be.ForceCommit()
+		be.Close()
+	}()
+
+	index, _ := schema.ReadConsistentIndex(be.ReadTx())
+	walDir, err := archivedWALDir(cfg.WALArchiveDir)
+	if err != nil {
+		return fmt.Errorf("failed to verify WAL archive %q: %w", cfg.WALArchiveDir, err)
+	}
+	ents, err := readCommittedEntries(s.lg, walDir, index)
+	if err != nil {
+		return fmt.Errorf("failed to read WAL archive %q: %w", cfg.WALArchiveDir, err)
+	}
+
+	r, err := newWALReplayer(s.lg, be, s.kms)
+	if err != nil {
+		return err
+	}
+	defer r.close()
+
+	if cfg.ToRevision > 0 && cfg.ToRevision < r.kv.Rev() {
+		return fmt.Errorf("revision %d is older than the revision %d of the snapshot", cfg.ToRevision, r.kv.Rev())
+	}
+
+	var (
+		reached  bool
+		lastTime time.Time
+		applied  int
+	)
+	for i := range ents {
+		e := &ents[i]
+		if cfg.ToRevision > 0 && r.kv.Rev() >= cfg.ToRevision {
+			reached = true
+			break
+		}
+		if e.Type != raftpb.EntryNormal || len(e.Data) == 0 {
+			continue
+		}
+		var req pb.InternalRaftRequest
+		if !pbutil.MaybeUnmarshal(&req, e.Data) {
+			// v2 requests do not change the v3 store
+			continue
+		}
+		// Requests proposed without a timestamp, by older members or during a
+		// downgrade, are taken as proposed along with the previous request.
+		if req.Header != nil && req.Header.Timestamp != 0 {
+			proposed := time.Unix(0, req.Header.Timestamp)
+			if !cfg.ToTime.IsZero() && proposed.After(cfg.ToTime) {
+				reached = true
+				break
+			}
+			lastTime = proposed
+		}
+		if !isReplayable(&req) {
+			continue
+		}
+		r.apply(e, &req)
+		applied++
+	}
+
+	switch {
+	case cfg.ToRevision > 0 && r.kv.Rev() < cfg.ToRevision:
+		return fmt.Errorf("the WAL archive ends at revision %d before revision %d", r.kv.Rev(), cfg.ToRevision)
+	case !cfg.ToTime.IsZero() && !reached:
+		if lastTime.IsZero() {
+			return fmt.Errorf("the WAL archive ends before %s", cfg.ToTime.Format(time.RFC3339Nano))
+		}
+		return fmt.Errorf("the WAL archive ends before %s, its last request was proposed at %s",
+			cfg.ToTime.Format(time.RFC3339Nano), lastTime.Format(time.RFC3339Nano))
+	}
+
+	s.lg.Info(
+		"replayed WAL archive",
+		zap.String("wal-archive-dir", cfg.WALArchiveDir),
+		zap.Uint64("snapshot-index", index),
+		zap.Int("applied-entries", applied),
+		zap.Int64("revision", r.kv.Rev()),
+	)
+	return nil
+}
+
+// archivedWALDir returns the directory of the WAL segments of the archive in
+// dir. If dir is a sink of the etcd WAL archiver, the checksums of its segments
+// are verified against its manifest. Otherwise dir holds the segments itself.
+func archivedWALDir(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, walarchive.ManifestDir)); errors.Is(err, fs.ErrNotExist) {
+		return dir, nil
+	}
+	if err := walarchive.Verify(context.Background(), walarchive.NewLocalSink(dir), walarchive.WALDir+"/"); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, walarchive.WALDir), nil
+}
+
+// readCommittedEntries reads the committed entries after index from the WAL in dir.
+func readCommittedEntries(lg *zap.Logger, dir string, index uint64) ([]raftpb.Entry, error) {
+	w, err := wal.OpenForRead(lg, dir, walpb.Snapshot{Index: index})
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	// the WAL is read from an entry, not from one of its snapshots.
+	_, st, ents, err := w.ReadAll()
+	if err != nil && !errors.Is(err, wal.ErrSnapshotNotFound) {
+		return nil, err
+	}
+	if st.Commit <= index {
+		return nil, nil
+	}
+	// entries after the commit index might have been overwritten by a later leader.
+	if n := st.Commit - index; uint64(len(ents)) > n {
+		ents = ents[:n]
+	}
+	return ents, nil
+}
+
+// isReplayable returns true if applying the request changes the state restored
+// from the snapshot. The membership is not restored from the snapshot and
+// authentication tokens are not persisted.
+func isReplayable(r *pb.InternalRaftRequest) bool {
+	if r.V2 != nil || r.ClusterVersionSet != nil || r.ClusterMemberAttrSet != nil ||
+		r.DowngradeInfoSet != nil || r.DowngradeVersionTest != nil || r.Authenticate != nil {
+		return false
+	}
+	return r.Range == nil && r.AuthUserGet == nil && r.AuthRoleGet == nil && r.AuthStatus == nil
+}
+
+// walReplayer applies raft entries to a backend outside of an etcd server.
+type walReplayer struct {
+	lg        *zap.Logger
+	ci        cindex.ConsistentIndexer
+	lessor    lease.Lessor
+	kv        mvcc.KV
+	authStore auth.AuthStore
+	applier   apply.UberApplier
+
+	// index and term of the entry being applied
+	index, term uint64
+}
+
+func newWALReplayer(lg *zap.Logger, be backend.Backend, kms encryption.KMS) (*walReplayer, error) {
+	tx := be.ReadTx()
+	tx.RLock()
+	err := encryption.UnsafeCheck(tx, kms)
+	tx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &walReplayer{lg: lg, ci: cindex.NewConsistentIndex(be)}
+	cl := membership.NewCluster(lg)
+
+	// the lessor is never promoted, leases only expire through the replayed entries.
+	prefixQuotas, err := serverstorage.NewPrefixQuotaStore(lg, schema.NewQuotaBackend(lg, be))
+	if err != nil {
+		return nil, err
+	}
+	r.lessor = lease.NewLessor(lg, be, cl, lease.LessorConfig{})
+	r.kv = mvcc.NewStore(lg, be, r.lessor, mvcc.StoreConfig{Cipher: encryption.NewCipher(kms), UsageTracker: prefixQuotas})
+	tp, err := auth.NewTokenProvider(lg, "", nil, 0)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	r.authStore = auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), tp, bcrypt.DefaultCost)
+
+	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	// the backend quota is disabled, requests that exceeded it were rejected
+	// by the alarm raised at that time.
+	r.applier = apply.NewUberApplier(lg, be, r.kv, alarmStore, prefixQuotas, r.authStore, r.lessor, cl, r, r, r.ci,
+		replayWarningApplyDuration, false, -1)
+	return r, nil
+}
+
+func (r *walReplayer) apply(e *raftpb.Entry, req *pb.InternalRaftRequest) {
+	r.index, r.term = e.Index, e.Term
+	r.ci.SetConsistentIndex(e.Index, e.Term)
+	ar := r.applier.Apply(req)
+	if ar != nil && ar.Physc != nil {
+		// wait for compactions, so they are finished before the backend is closed.
+		<-ar.Physc
+	}
+}
+
+func (r *walReplayer) close() {
+	if r.authStore != nil {
+		r.authStore.Close()
+	}
+	if r.kv != nil {
+		r.kv.Close()
+	}
+	r.lessor.Stop()
+}
+
+func (r *walReplayer) MemberID() types.ID     { return 0 }
+func (r *walReplayer) Leader() types.ID       { return 0 }
+func (r *walReplayer) CommittedIndex() uint64 { return r.index }
+func (r *walReplayer) AppliedIndex() uint64   { return r.index }
+func (r *walReplayer) Term() uint64           { return r.term }
+func (r *walReplayer) ForceSnapshot()         {}
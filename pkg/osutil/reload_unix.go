@@ -0,0 +1,66 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows && !plan9
+
+package osutil
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/verify"
+)
+
+// ReloadHandler is a function that is called on receiving a SIGHUP signal.
+type ReloadHandler func()
+
+var (
+	reloadRegisterMu sync.Mutex
+	// reloadHandlers holds all registered ReloadHandlers in order they will
+	// be executed.
+	reloadHandlers []ReloadHandler
+)
+
+// RegisterReloadHandler registers a new ReloadHandler.
+func RegisterReloadHandler(h ReloadHandler) {
+	reloadRegisterMu.Lock()
+	defer reloadRegisterMu.Unlock()
+	reloadHandlers = append(reloadHandlers, h)
+}
+
+// HandleReloads calls the handler functions on each SIGHUP signal.
+func HandleReloads(lg *zap.Logger) {
+	verify.Assert(lg != nil, "the logger should not be nil")
+	notifier := make(chan os.Signal, 1)
+	signal.Notify(notifier, syscall.SIGHUP)
+
+	go func() {
+		for sig := range notifier {
+			reloadRegisterMu.Lock()
+			rhs := make([]ReloadHandler, len(reloadHandlers))
+			copy(rhs, reloadHandlers)
+			reloadRegisterMu.Unlock()
+
+			lg.Info("received signal; reloading", zap.String("signal", sig.String()))
+			for _, h := range rhs {
+				h()
+			}
+		}
+	}()
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records who changed what in etcd: the mutating, auth,
+// cluster and maintenance requests of the clients, with their outcome.
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Level is the amount of detail recorded for a request.
+type Level string
+
+const (
+	// LevelNone records nothing.
+	LevelNone Level = "none"
+	// LevelMetadata records the client, the request and its keys, the outcome
+	// and the revision, but no values.
+	LevelMetadata Level = "metadata"
+	// LevelRequest records the values of the requests in addition to the
+	// metadata. Passwords are never recorded.
+	LevelRequest Level = "request"
+)
+
+// ParseLevel returns the level named s.
+func ParseLevel(s string) (Level, error) {
+	switch l := Level(s); l {
+	case LevelNone, LevelMetadata, LevelRequest:
+		return l, nil
+	}
+	return "", fmt.Errorf("unknown audit level %q (expected %q, %q or %q)", s, LevelNone, LevelMetadata, LevelRequest)
+}
+
+func (l Level) rank() int {
+	switch l {
+	case LevelMetadata:
+		return 1
+	case LevelRequest:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Max returns the more detailed of l and o.
+func (l Level) Max(o Level) Level {
+	if o.rank() > l.rank() {
+		return o
+	}
+	return l
+}
+
+// Outcomes of the requests.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Event is the audit record of a request.
+type Event struct {
+	// Time is the time the request was received.
+	Time  time.Time `json:"time"`
+	Level Level     `json:"level"`
+
+	// User is the authenticated user of the request, if any.
+	User string `json:"user,omitempty"`
+	// CommonName is the common name of the client certificate, if any.
+	CommonName string `json:"common-name,omitempty"`
+	RemoteAddr string `json:"remote-addr,omitempty"`
+
+	// Method is the full gRPC method of the request, e.g. "/etcdserverpb.KV/Put".
+	Method   string `json:"method"`
+	Key      string `json:"key,omitempty"`
+	RangeEnd string `json:"range-end,omitempty"`
+	// Value is the value of a put, recorded at LevelRequest.
+	Value []byte      `json:"value,omitempty"`
+	Txn   *TxnSummary `json:"txn,omitempty"`
+
+	// TargetUser, TargetRole, MemberID and LeaseID are the subjects of the
+	// auth, cluster and lease requests.
+	TargetUser string `json:"target-user,omitempty"`
+	TargetRole string `json:"target-role,omitempty"`
+	MemberID   string `json:"member-id,omitempty"`
+	LeaseID    int64  `json:"lease-id,omitempty"`
+	// Permission is the type of a granted permission, e.g. "READ" or "deny WRITE".
+	Permission string `json:"permission,omitempty"`
+
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+	// Revision is the revision of the store after the request.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// TxnSummary is the audit record of the comparisons and operations of a
+// transaction.
+type TxnSummary struct {
+	Compares []Op `json:"compares,omitempty"`
+	Success  []Op `json:"success,omitempty"`
+	Failure  []Op `json:"failure,omitempty"`
+	// Succeeded is true if the comparisons succeeded, which is only known if
+	// the transaction was executed.
+	Succeeded *bool `json:"succeeded,omitempty"`
+}
+
+// Op is the audit record of an operation or a comparison of a transaction.
+type Op struct {
+	// Type is "range", "put", "delete-range" or "txn" for the operations, and
+	// the compared target, e.g. "value" or "mod", for the comparisons.
+	Type     string `json:"type"`
+	Key      string `json:"key,omitempty"`
+	RangeEnd string `json:"range-end,omitempty"`
+	// Value is the value of a put or of a value comparison, recorded at
+	// LevelRequest.
+	Value []byte      `json:"value,omitempty"`
+	Txn   *TxnSummary `json:"txn,omitempty"`
+}
+
+// Writer writes the audit events, e.g. to a file or to a log collector. Write
+// is called concurrently, before the response is sent to the client.
+type Writer interface {
+	Write(ev *Event) error
+}
+
+// Policy selects the level of the requests by the longest prefix of their keys.
+// A request on a key range takes the most detailed level of the keys in it.
+type Policy struct {
+	// Default is the level of the requests without keys, and of the keys
+	// matching no prefix.
+	Default Level
+	rules   []rule
+}
+
+type rule struct {
+	prefix string
+	level  Level
+}
+
+// ParsePolicy returns the policy of the default level and the comma separated
+// list of per-prefix levels, e.g. "/registry/secrets/=none,/app/=request".
+func ParsePolicy(defaultLevel, prefixes string) (*Policy, error) {
+	l, err := ParseLevel(defaultLevel)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{Default: l}
+	if prefixes == "" {
+		return p, nil
+	}
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(prefixes, ",") {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid audit policy entry %q (expected <prefix>=<level>)", entry)
+		}
+		prefix := entry[:i]
+		if seen[prefix] {
+			return nil, fmt.Errorf("duplicate audit policy prefix %q", prefix)
+		}
+		seen[prefix] = true
+		if l, err = ParseLevel(entry[i+1:]); err != nil {
+			return nil, err
+		}
+		p.rules = append(p.rules, rule{prefix: prefix, level: l})
+	}
+	// the longest prefixes first, so the first match is the longest.
+	sort.Slice(p.rules, func(i, j int) bool { return len(p.rules[i].prefix) > len(p.rules[j].prefix) })
+	return p, nil
+}
+
+// Level returns the level of the requests on key.
+func (p *Policy) Level(key []byte) Level {
+	for _, r := range p.rules {
+		if strings.HasPrefix(string(key), r.prefix) {
+			return r.level
+		}
+	}
+	return p.Default
+}
+
+// RangeLevel returns the most detailed level of the requests on the keys in
+// [key, end), or on key if end is empty. An end of "\x00" is the end of the
+// keyspace.
+func (p *Policy) RangeLevel(key, end []byte) Level {
+	if len(end) == 0 {
+		return p.Level(key)
+	}
+	if bytes.Equal(end, []byte{0}) {
+		end = nil
+	}
+	l := p.Level(key)
+	covered := false
+	for _, r := range p.rules {
+		pstart, pend := []byte(r.prefix), prefixEnd(r.prefix)
+		if (end != nil && bytes.Compare(pstart, end) >= 0) || (pend != nil && bytes.Compare(key, pend) >= 0) {
+			continue
+		}
+		l = l.Max(r.level)
+		if bytes.Compare(pstart, key) <= 0 && (pend == nil || (end != nil && bytes.Compare(end, pend) <= 0)) {
+			covered = true
+		}
+	}
+	// the keys matching no prefix take the default level.
+	if !covered {
+		l = l.Max(p.Default)
+	}
+	return l
+}
+
+// prefixEnd returns the end of the range of the keys with the given prefix,
+// or nil if it is the end of the keyspace.
+func prefixEnd(prefix string) []byte {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// Auditor writes the events of the requests selected by its policy.
+type Auditor struct {
+	lg     *zap.Logger
+	policy *Policy
+	w      Writer
+}
+
+// New returns an auditor writing the events selected by policy to w.
+func New(lg *zap.Logger, policy *Policy, w Writer) *Auditor {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return &Auditor{lg: lg, policy: policy, w: w}
+}
+
+// Policy returns the policy of the auditor.
+func (a *Auditor) Policy() *Policy { return a.policy }
+
+// Log writes the event. A failure is logged, as the request was already
+// served.
+func (a *Auditor) Log(ev *Event) {
+	if ev.Level == LevelNone {
+		return
+	}
+	if err := a.w.Write(ev); err != nil {
+		writeFailures.Inc()
+		a.lg.Warn("failed to write audit event", zap.String("method", ev.Method), zap.String("user", ev.User), zap.Error(err))
+		return
+	}
+	writtenEvents.Inc()
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("metadata", "/app/=request,/app/secrets/=none,/a=b=metadata")
+	require.NoError(t, err)
+
+	tests := map[string]Level{
+		"/app/config":     LevelRequest,
+		"/app/secrets/pw": LevelNone,
+		"/a=b/c":          LevelMetadata,
+		"/other":          LevelMetadata,
+		"":                LevelMetadata,
+	}
+	for key, want := range tests {
+		assert.Equalf(t, want, p.Level([]byte(key)), "level of %q", key)
+	}
+}
+
+func TestPolicyRangeLevel(t *testing.T) {
+	p, err := ParsePolicy("none", "/app/=metadata,/app/secrets/=request,/other/=none")
+	require.NoError(t, err)
+
+	tests := []struct {
+		key, end string
+		want     Level
+	}{
+		{key: "/app/a", want: LevelMetadata},
+		{key: "/app/a", end: "/app/b", want: LevelMetadata},
+		// the range starts outside of the secrets, but covers them.
+		{key: "/app/", end: "/app0", want: LevelRequest},
+		{key: "/", end: "\x00", want: LevelRequest},
+		{key: "/app/secrets/pw", end: "/app/secrets/px", want: LevelRequest},
+		// the range covers keys matching no prefix.
+		{key: "/other/a", end: "/p", want: LevelNone},
+		{key: "/b", end: "/c", want: LevelNone},
+	}
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, p.RangeLevel([]byte(tt.key), []byte(tt.end)), "level of [%q, %q)", tt.key, tt.end)
+	}
+
+	p, err = ParsePolicy("metadata", "/app/secrets/=none")
+	require.NoError(t, err)
+	assert.Equal(t, LevelNone, p.RangeLevel([]byte("/app/secrets/a"), []byte("/app/secrets/b")))
+	assert.Equal(t, LevelMetadata, p.RangeLevel([]byte("/app/secrets/a"), []byte("/app/t")))
+}
+
+func TestParsePolicyInvalid(t *testing.T) {
+	tests := []struct {
+		level    string
+		prefixes string
+	}{
+		{level: "verbose"},
+		{level: "metadata", prefixes: "/app/"},
+		{level: "metadata", prefixes: "=request"},
+		{level: "metadata", prefixes: "/app/=all"},
+		{level: "metadata", prefixes: "/app/=none,/app/=request"},
+	}
+	for _, tt := range tests {
+		_, err := ParsePolicy(tt.level, tt.prefixes)
+		assert.Errorf(t, err, "ParsePolicy(%q, %q)", tt.level, tt.prefixes)
+	}
+}
+
+func TestLevelMax(t *testing.T) {
+	assert.Equal(t, LevelMetadata, LevelNone.Max(LevelMetadata))
+	assert.Equal(t, LevelRequest, LevelRequest.Max(LevelMetadata))
+	assert.Equal(t, LevelNone, LevelNone.Max(LevelNone))
+}
+
+type failingWriter struct{ writes int }
+
+func (w *failingWriter) Write(*Event) error {
+	w.writes++
+	return errors.New("disk full")
+}
+
+func TestAuditorLog(t *testing.T) {
+	w := &failingWriter{}
+	a := New(zaptest.NewLogger(t), &Policy{Default: LevelMetadata}, w)
+
+	a.Log(&Event{Level: LevelNone, Method: "/etcdserverpb.KV/Put"})
+	assert.Equal(t, 0, w.writes)
+
+	// a failure to write is not returned, as the request was already served.
+	a.Log(&Event{Level: LevelMetadata, Method: "/etcdserverpb.KV/Put"})
+	assert.Equal(t, 1, w.writes)
+}
+
+func TestFileWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	w := NewFileWriter(FileConfig{Path: path, MaxSize: 1})
+
+	succeeded := true
+	events := []*Event{
+		{Time: time.Unix(1, 0).UTC(), Level: LevelRequest, User: "alice", Method: "/etcdserverpb.KV/Put", Key: "foo", Value: []byte("bar"), Outcome: OutcomeSuccess, Revision: 2},
+		{Time: time.Unix(2, 0).UTC(), Level: LevelMetadata, Method: "/etcdserverpb.KV/Txn", Txn: &TxnSummary{Success: []Op{{Type: "delete-range", Key: "a", RangeEnd: "b"}}, Succeeded: &succeeded}, Outcome: OutcomeSuccess, Revision: 3},
+		{Time: time.Unix(3, 0).UTC(), Level: LevelMetadata, Method: "/etcdserverpb.Auth/UserDelete", TargetUser: "bob", Outcome: OutcomeFailure, Error: "etcdserver: permission denied"},
+	}
+	for _, ev := range events {
+		require.NoError(t, w.Write(ev))
+	}
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var got []*Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &ev))
+		got = append(got, &ev)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, events, got)
+}
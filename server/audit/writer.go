@@ -0,0 +1,64 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// FileConfig is the configuration of a FileWriter.
+type FileConfig struct {
+	Path string
+	// MaxSize is the size in megabytes at which the file is rotated.
+	MaxSize int
+	// MaxBackups is the number of rotated files to keep. Zero keeps all.
+	MaxBackups int
+	// MaxAge is the number of days to keep the rotated files. Zero keeps them
+	// regardless of their age.
+	MaxAge int
+}
+
+// FileWriter writes the events as JSON lines to a file rotated by size.
+type FileWriter struct {
+	l *lumberjack.Logger
+}
+
+// NewFileWriter returns a writer to the file of cfg. The file is created on
+// the first write.
+func NewFileWriter(cfg FileConfig) *FileWriter {
+	return &FileWriter{l: &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAge,
+	}}
+}
+
+func (w *FileWriter) Write(ev *Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	// a single write keeps the lines of concurrent events whole.
+	_, err = w.l.Write(append(data, '\n'))
+	return err
+}
+
+// Close closes the file.
+func (w *FileWriter) Close() error {
+	return w.l.Close()
+}
@@ -0,0 +1,537 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	optJWKSFile            = "jwks-file"
+	optJWKSURL             = "jwks-url"
+	optJWKSCAFile          = "jwks-ca-file"
+	optJWKSRefreshInterval = "jwks-refresh-interval"
+	optIssuer              = "issuer"
+	optAudience            = "audience"
+	optUsernameClaim       = "username-claim"
+	optUsernamePrefix      = "username-prefix"
+	optRolesClaim          = "roles-claim"
+)
+
+var knownOIDCOptions = map[string]bool{
+	optJWKSFile:            true,
+	optJWKSURL:             true,
+	optJWKSCAFile:          true,
+	optJWKSRefreshInterval: true,
+	optIssuer:              true,
+	optAudience:            true,
+	optUsernameClaim:       true,
+	optUsernamePrefix:      true,
+	optRolesClaim:          true,
+}
+
+var (
+	// DefaultJWKSRefreshInterval will be used when a 'jwks-refresh-interval' is not specified
+	DefaultJWKSRefreshInterval = time.Hour
+
+	// minJWKSRefreshInterval limits how often the JWKS document is reloaded for
+	// tokens signed with unknown keys, or after a failed reload.
+	minJWKSRefreshInterval = 10 * time.Second
+
+	jwksFetchTimeout = 10 * time.Second
+
+	// oidcSigningMethods are the algorithms of the externally issued tokens.
+	// Symmetric algorithms are excluded, since JWKS documents are public.
+	oidcSigningMethods = []string{
+		"RS256", "RS384", "RS512",
+		"PS256", "PS384", "PS512",
+		"ES256", "ES384", "ES512",
+		"EdDSA",
+	}
+)
+
+type oidcOptions struct {
+	JWKSFile        string
+	JWKSURL         string
+	JWKSCAFile      string
+	RefreshInterval time.Duration
+	Issuer          string
+	Audience        string
+	UsernameClaim   string
+	UsernamePrefix  string
+	RolesClaim      string
+}
+
+// Parse will load options from the specified map or set defaults where appropriate
+func (opts *oidcOptions) Parse(optMap map[string]string) error {
+	opts.JWKSFile = optMap[optJWKSFile]
+	opts.JWKSURL = optMap[optJWKSURL]
+	opts.JWKSCAFile = optMap[optJWKSCAFile]
+	if (opts.JWKSFile == "") == (opts.JWKSURL == "") {
+		return fmt.Errorf("exactly one of %q and %q must be given", optJWKSFile, optJWKSURL)
+	}
+	if opts.JWKSCAFile != "" && opts.JWKSURL == "" {
+		return fmt.Errorf("%q requires %q", optJWKSCAFile, optJWKSURL)
+	}
+
+	opts.RefreshInterval = DefaultJWKSRefreshInterval
+	if interval := optMap[optJWKSRefreshInterval]; interval != "" {
+		var err error
+		opts.RefreshInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			return err
+		}
+		if opts.RefreshInterval < minJWKSRefreshInterval {
+			return fmt.Errorf("%q must be at least %v", optJWKSRefreshInterval, minJWKSRefreshInterval)
+		}
+	}
+
+	opts.Issuer = optMap[optIssuer]
+	// the keys of an issuer sign the tokens of all its audiences, which must
+	// not authenticate to etcd.
+	opts.Audience = optMap[optAudience]
+	if opts.Audience == "" {
+		return fmt.Errorf("%q must be given", optAudience)
+	}
+	opts.UsernameClaim = optMap[optUsernameClaim]
+	if opts.UsernameClaim == "" {
+		opts.UsernameClaim = "sub"
+	}
+	opts.UsernamePrefix = optMap[optUsernamePrefix]
+	opts.RolesClaim = optMap[optRolesClaim]
+	return nil
+}
+
+// tokenOIDC verifies externally issued JWTs, such as OpenID Connect ID tokens or
+// workload identity tokens, with the keys of a JWKS document. The claims of a
+// token name the etcd user and, optionally, additional etcd roles. Password
+// authentication keeps issuing simple tokens.
+type tokenOIDC struct {
+	*tokenSimple
+
+	lg     *zap.Logger
+	opts   oidcOptions
+	keys   *jwks
+	parser *jwt.Parser
+}
+
+func (t *tokenOIDC) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
+	// simple tokens never have the three parts of a JWT.
+	if strings.Count(token, ".") != 2 {
+		return t.tokenSimple.info(ctx, token, rev)
+	}
+
+	parsed, err := t.parser.Parse(token, t.keys.keyFunc)
+	if err != nil {
+		t.lg.Warn("failed to verify an externally issued token", zap.Error(err))
+		return nil, false
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !parsed.Valid || !ok {
+		t.lg.Warn("failed to obtain claims from an externally issued token")
+		return nil, false
+	}
+
+	username, ok := claims[t.opts.UsernameClaim].(string)
+	if !ok || username == "" {
+		t.lg.Warn("failed to obtain the user claim from an externally issued token", zap.String("claim", t.opts.UsernameClaim))
+		return nil, false
+	}
+	ai := &AuthInfo{Username: t.opts.UsernamePrefix + username, Revision: rev}
+	if t.opts.RolesClaim != "" {
+		ai.Roles = t.roles(claims[t.opts.RolesClaim])
+	}
+	return ai, true
+}
+
+// roles returns the sorted etcd roles of the roles claim, which is either a
+// string or a list of strings. The root role can't be granted by a token.
+func (t *tokenOIDC) roles(claim any) []string {
+	var roles []string
+	switch c := claim.(type) {
+	case string:
+		roles = []string{c}
+	case []any:
+		for _, r := range c {
+			if role, ok := r.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	filtered := roles[:0]
+	for _, role := range roles {
+		if role == rootRole {
+			t.lg.Warn("ignoring the root role granted by an externally issued token")
+			continue
+		}
+		if role != "" {
+			filtered = append(filtered, role)
+		}
+	}
+	sort.Strings(filtered)
+	return compactStrings(filtered)
+}
+
+func compactStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	out := s[:1]
+	for _, v := range s[1:] {
+		if v != out[len(out)-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func newTokenProviderOIDC(
+	lg *zap.Logger,
+	optMap map[string]string,
+	indexWaiter func(uint64) <-chan struct{},
+	TokenTTL time.Duration,
+) (*tokenOIDC, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	var opts oidcOptions
+	if err := opts.Parse(optMap); err != nil {
+		lg.Error("problem loading OIDC options", zap.Error(err))
+		return nil, ErrInvalidAuthOpts
+	}
+
+	keys := make([]string, 0, len(optMap))
+	for k := range optMap {
+		if !knownOIDCOptions[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		lg.Warn("unknown OIDC options", zap.Strings("keys", keys))
+	}
+
+	ks, err := newJWKS(lg, opts)
+	if err != nil {
+		lg.Error("problem loading the JWKS document", zap.Error(err))
+		return nil, ErrInvalidAuthOpts
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(opts.Audience),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+
+	return &tokenOIDC{
+		tokenSimple: newTokenProviderSimple(lg, indexWaiter, TokenTTL),
+		lg:          lg,
+		opts:        opts,
+		keys:        ks,
+		parser:      jwt.NewParser(parserOpts...),
+	}, nil
+}
+
+// jwks holds the public keys of a JWKS document, reloading them periodically and
+// when a token is signed with an unknown key.
+type jwks struct {
+	lg              *zap.Logger
+	file            string
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+
+	mu          sync.Mutex
+	keys        map[string]any // kid -> public key
+	loaded      time.Time
+	lastAttempt time.Time
+	// reloading is closed once the reload in progress completes, nil if none.
+	// Concurrent verifications share a single reload.
+	reloading chan struct{}
+}
+
+func newJWKS(lg *zap.Logger, opts oidcOptions) (*jwks, error) {
+	ks := &jwks{
+		lg:              lg,
+		file:            opts.JWKSFile,
+		url:             opts.JWKSURL,
+		refreshInterval: opts.RefreshInterval,
+		keys:            make(map[string]any),
+	}
+	if ks.url != "" {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if opts.JWKSCAFile != "" {
+			ca, err := os.ReadFile(opts.JWKSCAFile)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("no certificates in %q", opts.JWKSCAFile)
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		}
+		ks.client = &http.Client{Transport: transport, Timeout: jwksFetchTimeout}
+	}
+
+	ks.lastAttempt = time.Now()
+	keys, err := ks.load()
+	if err != nil {
+		// a misconfigured file fails fast, while the issuer may be briefly
+		// unreachable and is retried when tokens are verified.
+		if ks.url == "" {
+			return nil, err
+		}
+		lg.Warn("failed to fetch the JWKS document", zap.String("url", ks.url), zap.Error(err))
+		return ks, nil
+	}
+	ks.keys, ks.loaded = keys, ks.lastAttempt
+	return ks, nil
+}
+
+// keyFunc returns the key of the token. The document is reloaded at most once
+// every minJWKSRefreshInterval, without holding ks.mu, so that the tokens of
+// known keys are verified while the issuer is slow. Only the tokens of an
+// unknown key wait for the reload.
+func (ks *jwks) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	ks.mu.Lock()
+	_, known := ks.lookupLocked(kid)
+	now := time.Now()
+	reloading := ks.reloading
+	if reloading == nil && (!known || now.Sub(ks.loaded) > ks.refreshInterval) && now.Sub(ks.lastAttempt) > minJWKSRefreshInterval {
+		reloading = ks.reloadLocked(now)
+	}
+	ks.mu.Unlock()
+	if !known && reloading != nil {
+		<-reloading
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	key, ok := ks.lookupLocked(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// lookupLocked returns the key of kid. A token without kid can only be verified
+// with the only key of the document.
+func (ks *jwks) lookupLocked(kid string) (any, bool) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// reloadLocked starts reloading the document, and returns a channel closed
+// once it is reloaded.
+func (ks *jwks) reloadLocked(now time.Time) chan struct{} {
+	ks.lastAttempt = now
+	done := make(chan struct{})
+	ks.reloading = done
+	go func() {
+		keys, err := ks.load()
+		ks.mu.Lock()
+		defer ks.mu.Unlock()
+		if err != nil {
+			ks.lg.Warn("failed to reload the JWKS document", zap.Error(err))
+		} else {
+			ks.keys, ks.loaded = keys, now
+		}
+		ks.reloading = nil
+		close(done)
+	}()
+	return done
+}
+
+func (ks *jwks) load() (map[string]any, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if ks.url != "" {
+		data, err = ks.fetch()
+	} else {
+		data, err = os.ReadFile(ks.file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
+
+func (ks *jwks) fetch() ([]byte, error) {
+	resp, err := ks.client.Get(ks.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS returns the public signing keys of a JWKS document by their key IDs.
+func parseJWKS(data []byte) (map[string]any, error) {
+	var doc struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]any)
+	for _, jwk := range doc.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", jwk.Kid, err)
+		}
+		if key == nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys in the JWKS document")
+	}
+	return keys, nil
+}
+
+// publicKey returns the public key of the JWK, or nil for unsupported key types.
+func (jwk *jsonWebKey) publicKey() (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 2 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var (
+			curve     elliptic.Curve
+			ecdhCurve ecdh.Curve
+		)
+		switch jwk.Crv {
+		case "P-256":
+			curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+		case "P-384":
+			curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+		case "P-521":
+			curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeJWKInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		// the point is validated by encoding it for crypto/ecdh.
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x.Bytes()) > size || len(y.Bytes()) > size {
+			return nil, errors.New("invalid EC point")
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		x.FillBytes(point[1 : 1+size])
+		y.FillBytes(point[1+size:])
+		if _, err = ecdhCurve.NewPublicKey(point); err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, nil
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,439 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+// testSigner is a private key of an external token issuer with its JWK.
+type testSigner struct {
+	kid    string
+	method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+func newTestSigner(t *testing.T, kid string, method jwt.SigningMethod) *testSigner {
+	var (
+		key crypto.Signer
+		err error
+	)
+	switch method {
+	case jwt.SigningMethodRS256, jwt.SigningMethodPS256:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case jwt.SigningMethodES256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jwt.SigningMethodES384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case jwt.SigningMethodEdDSA:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("unsupported method %v", method.Alg())
+	}
+	require.NoError(t, err)
+	return &testSigner{kid: kid, method: method, key: key}
+}
+
+func (s *testSigner) jwk() map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := s.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "kid": s.kid, "use": "sig", "n": enc(pub.N.Bytes()), "e": enc(big.NewInt(int64(pub.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return map[string]string{"kty": "EC", "kid": s.kid, "crv": pub.Curve.Params().Name, "x": enc(pub.X.FillBytes(make([]byte, size))), "y": enc(pub.Y.FillBytes(make([]byte, size)))}
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "kid": s.kid, "crv": "Ed25519", "x": enc(pub)}
+	}
+	return nil
+}
+
+func (s *testSigner) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.kid != "" {
+		token.Header["kid"] = s.kid
+	}
+	signed, err := token.SignedString(s.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func jwksDocument(t *testing.T, signers ...*testSigner) []byte {
+	var doc struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for _, s := range signers {
+		doc.Keys = append(doc.Keys, s.jwk())
+	}
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+func writeJWKSFile(t *testing.T, signers ...*testSigner) string {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksDocument(t, signers...), 0o600))
+	return path
+}
+
+func validClaims(sub string) jwt.MapClaims {
+	return jwt.MapClaims{"sub": sub, "aud": "etcd", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func TestOIDCInfo(t *testing.T) {
+	signers := []*testSigner{
+		newTestSigner(t, "rsa", jwt.SigningMethodRS256),
+		newTestSigner(t, "rsapss", jwt.SigningMethodPS256),
+		newTestSigner(t, "ec256", jwt.SigningMethodES256),
+		newTestSigner(t, "ec384", jwt.SigningMethodES384),
+		newTestSigner(t, "ed25519", jwt.SigningMethodEdDSA),
+	}
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSFile: writeJWKSFile(t, signers...), optAudience: "etcd"}, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+
+	for _, s := range signers {
+		t.Run(s.kid, func(t *testing.T) {
+			ai, ok := tp.info(context.TODO(), s.sign(t, validClaims("alice")), 42)
+			require.Truef(t, ok, "failed to verify a token signed with %s", s.kid)
+			assert.Equal(t, &AuthInfo{Username: "alice", Revision: 42}, ai)
+		})
+	}
+}
+
+func TestOIDCInfoInvalid(t *testing.T) {
+	signer := newTestSigner(t, "key", jwt.SigningMethodES256)
+	unknown := newTestSigner(t, "key", jwt.SigningMethodES256)
+	opts := map[string]string{
+		optJWKSFile:   writeJWKSFile(t, signer),
+		optIssuer:     "https://issuer.example.com",
+		optAudience:   "etcd",
+		optRolesClaim: "groups",
+	}
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), opts, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+
+	claims := func(mutate func(jwt.MapClaims)) jwt.MapClaims {
+		c := validClaims("alice")
+		c["iss"] = "https://issuer.example.com"
+		if mutate != nil {
+			mutate(c)
+		}
+		return c
+	}
+
+	_, ok := tp.info(context.TODO(), signer.sign(t, claims(nil)), 1)
+	require.True(t, ok)
+
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil))
+	hmacToken, err := hmac.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"expired":        signer.sign(t, claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() })),
+		"no-expiry":      signer.sign(t, claims(func(c jwt.MapClaims) { delete(c, "exp") })),
+		"wrong-issuer":   signer.sign(t, claims(func(c jwt.MapClaims) { c["iss"] = "https://other.example.com" })),
+		"wrong-audience": signer.sign(t, claims(func(c jwt.MapClaims) { c["aud"] = "other" })),
+		"no-audience":    signer.sign(t, claims(func(c jwt.MapClaims) { delete(c, "aud") })),
+		"no-subject":     signer.sign(t, claims(func(c jwt.MapClaims) { delete(c, "sub") })),
+		"unknown-key":    unknown.sign(t, claims(nil)),
+		"hmac":           hmacToken,
+		"malformed":      "a.b.c",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, ok := tp.info(context.TODO(), token, 1)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestOIDCInfoClaims(t *testing.T) {
+	signer := newTestSigner(t, "", jwt.SigningMethodEdDSA)
+	opts := map[string]string{
+		optJWKSFile:       writeJWKSFile(t, signer),
+		optUsernameClaim:  "email",
+		optUsernamePrefix: "oidc:",
+		optRolesClaim:     "groups",
+		optAudience:       "etcd",
+	}
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), opts, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		groups any
+		roles  []string
+	}{
+		{name: "none"},
+		{name: "string", groups: "readers", roles: []string{"readers"}},
+		{name: "list", groups: []string{"writers", "readers", "writers", ""}, roles: []string{"readers", "writers"}},
+		{name: "root", groups: []string{"root", "readers"}, roles: []string{"readers"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims("ignored")
+			claims["email"] = "alice@example.com"
+			if tt.groups != nil {
+				claims["groups"] = tt.groups
+			}
+			ai, ok := tp.info(context.TODO(), signer.sign(t, claims), 1)
+			require.True(t, ok)
+			assert.Equal(t, "oidc:alice@example.com", ai.Username)
+			assert.Equal(t, tt.roles, ai.Roles)
+		})
+	}
+}
+
+func TestOIDCSimpleToken(t *testing.T) {
+	signer := newTestSigner(t, "key", jwt.SigningMethodES256)
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSFile: writeJWKSFile(t, signer), optAudience: "etcd"}, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+	tp.enable()
+	defer tp.disable()
+
+	ctx := context.WithValue(context.WithValue(context.TODO(), AuthenticateParamIndex{}, uint64(1)), AuthenticateParamSimpleTokenPrefix{}, "dummy")
+	token, err := tp.assign(ctx, "bob", 3)
+	require.NoError(t, err)
+
+	ai, ok := tp.info(context.TODO(), token, 3)
+	require.True(t, ok)
+	assert.Equal(t, &AuthInfo{Username: "bob", Revision: 3}, ai)
+}
+
+func TestOIDCJWKSURL(t *testing.T) {
+	first := newTestSigner(t, "first", jwt.SigningMethodES256)
+	second := newTestSigner(t, "second", jwt.SigningMethodRS256)
+
+	var (
+		mu       sync.Mutex
+		doc      = jwksDocument(t, first)
+		requests int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		w.Write(doc)
+	}))
+	defer srv.Close()
+
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSURL: srv.URL, optAudience: "etcd"}, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+
+	_, ok := tp.info(context.TODO(), first.sign(t, validClaims("alice")), 1)
+	require.True(t, ok)
+
+	// the issuer rotates its keys, and the document is reloaded for the unknown key.
+	mu.Lock()
+	doc = jwksDocument(t, first, second)
+	mu.Unlock()
+	tp.keys.mu.Lock()
+	tp.keys.lastAttempt = time.Time{}
+	tp.keys.mu.Unlock()
+
+	_, ok = tp.info(context.TODO(), second.sign(t, validClaims("alice")), 1)
+	require.True(t, ok)
+
+	// reloads for unknown keys are rate limited.
+	unknown := newTestSigner(t, "unknown", jwt.SigningMethodES256)
+	_, ok = tp.info(context.TODO(), unknown.sign(t, validClaims("alice")), 1)
+	require.False(t, ok)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, requests)
+}
+
+func TestOIDCJWKSURLSlowReload(t *testing.T) {
+	known := newTestSigner(t, "known", jwt.SigningMethodES256)
+	rotated := newTestSigner(t, "rotated", jwt.SigningMethodES256)
+
+	var (
+		mu   sync.Mutex
+		doc  = jwksDocument(t, known)
+		hang chan struct{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		h, d := hang, doc
+		mu.Unlock()
+		if h != nil {
+			<-h
+		}
+		w.Write(d)
+	}))
+	defer srv.Close()
+
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSURL: srv.URL, optAudience: "etcd"}, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+
+	// the issuer is slow to serve its rotated keys.
+	release := make(chan struct{})
+	mu.Lock()
+	doc, hang = jwksDocument(t, known, rotated), release
+	mu.Unlock()
+	tp.keys.mu.Lock()
+	tp.keys.lastAttempt = time.Time{}
+	tp.keys.mu.Unlock()
+
+	rotatedOK := make(chan bool)
+	go func() {
+		_, ok := tp.info(context.TODO(), rotated.sign(t, validClaims("alice")), 1)
+		rotatedOK <- ok
+	}()
+	require.Eventually(t, func() bool {
+		tp.keys.mu.Lock()
+		defer tp.keys.mu.Unlock()
+		return tp.keys.reloading != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the tokens of known keys don't wait for the reload.
+	_, ok := tp.info(context.TODO(), known.sign(t, validClaims("alice")), 1)
+	require.True(t, ok)
+
+	close(release)
+	require.True(t, <-rotatedOK)
+}
+
+func TestOIDCJWKSURLUnavailable(t *testing.T) {
+	signer := newTestSigner(t, "key", jwt.SigningMethodES256)
+	var available bool
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(jwksDocument(t, signer))
+	}))
+	defer srv.Close()
+
+	// an unreachable issuer doesn't prevent the server from starting.
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSURL: srv.URL, optAudience: "etcd"}, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+	_, ok := tp.info(context.TODO(), signer.sign(t, validClaims("alice")), 1)
+	require.False(t, ok)
+
+	mu.Lock()
+	available = true
+	mu.Unlock()
+	tp.keys.mu.Lock()
+	tp.keys.lastAttempt = time.Time{}
+	tp.keys.mu.Unlock()
+
+	_, ok = tp.info(context.TODO(), signer.sign(t, validClaims("alice")), 1)
+	require.True(t, ok)
+}
+
+func TestOIDCBadOptions(t *testing.T) {
+	jwksFile := writeJWKSFile(t, newTestSigner(t, "key", jwt.SigningMethodES256))
+	invalidFile := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(invalidFile, []byte(`{"keys":[{"kty":"EC","kid":"key","crv":"P-256","x":"AQ","y":"AQ"}]}`), 0o600))
+
+	tests := map[string]map[string]string{
+		"none":             {},
+		"no-audience":      {optJWKSFile: jwksFile},
+		"file-and-url":     {optJWKSFile: jwksFile, optJWKSURL: "https://issuer.example.com/keys", optAudience: "etcd"},
+		"ca-without-url":   {optJWKSFile: jwksFile, optJWKSCAFile: jwksFile, optAudience: "etcd"},
+		"short-interval":   {optJWKSFile: jwksFile, optJWKSRefreshInterval: "1s", optAudience: "etcd"},
+		"invalid-interval": {optJWKSFile: jwksFile, optJWKSRefreshInterval: "hourly", optAudience: "etcd"},
+		"missing-file":     {optJWKSFile: filepath.Join(t.TempDir(), "missing.json"), optAudience: "etcd"},
+		"invalid-point":    {optJWKSFile: invalidFile, optAudience: "etcd"},
+	}
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTokenProviderOIDC(zaptest.NewLogger(t), opts, dummyIndexWaiter, simpleTokenTTLDefault)
+			require.ErrorIs(t, err, ErrInvalidAuthOpts)
+		})
+	}
+}
+
+func TestIsOpPermittedWithTokenRoles(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "readers"})
+	require.NoError(t, err)
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "readers",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("a"), RangeEnd: []byte("b")},
+	})
+	require.NoError(t, err)
+
+	// the user foo exists and has role-test; the user alice only exists in the issuer.
+	tests := []struct {
+		name     string
+		authInfo *AuthInfo
+		key      string
+		permType authpb.Permission_Type
+		err      error
+	}{
+		{name: "external-user-without-roles", authInfo: &AuthInfo{Username: "alice"}, key: "a1", permType: authpb.READ, err: ErrPermissionDenied},
+		{name: "external-user-with-roles", authInfo: &AuthInfo{Username: "alice", Roles: []string{"readers"}}, key: "a1", permType: authpb.READ},
+		{name: "external-user-write", authInfo: &AuthInfo{Username: "alice", Roles: []string{"readers"}}, key: "a1", permType: authpb.WRITE, err: ErrPermissionDenied},
+		{name: "external-user-unknown-role", authInfo: &AuthInfo{Username: "alice", Roles: []string{"writers"}}, key: "a1", permType: authpb.READ, err: ErrPermissionDenied},
+		{name: "user-token-roles", authInfo: &AuthInfo{Username: "foo", Roles: []string{"readers"}}, key: "a1", permType: authpb.READ},
+		{name: "user-own-roles", authInfo: &AuthInfo{Username: "foo", Roles: []string{"readers"}}, key: "foo", permType: authpb.WRITE},
+	}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "role-test",
+		Perm: &authpb.Permission{PermType: authpb.WRITE, Key: []byte("foo")},
+	})
+	require.NoError(t, err)
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.authInfo.Revision = as.Revision()
+			err := as.isOpPermitted(tt.authInfo, []byte(tt.key), nil, tt.permType)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	// the permissions of any number of role sets are cached in bounded memory.
+	for i := 0; i < maxTokenRolePermCacheEntries+10; i++ {
+		ai := &AuthInfo{Username: "alice", Roles: []string{"readers", fmt.Sprintf("role-%d", i)}, Revision: as.Revision()}
+		require.NoError(t, as.isOpPermitted(ai, []byte("a1"), nil, authpb.READ))
+	}
+	require.Equal(t, maxTokenRolePermCacheEntries, as.tokenRolePermCache.Len())
+}
@@ -15,22 +15,35 @@
 package auth
 
 import (
+	"fmt"
+	"strings"
+
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/api/v3/authpb"
 	"go.etcd.io/etcd/pkg/v3/adt"
 )
 
+// maxTokenRolePermCacheEntries is the maximum number of role sets of tokens
+// whose permissions are cached.
+const maxTokenRolePermCacheEntries = 1024
+
 func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissions {
 	user := tx.UnsafeGetUser(userName)
 	if user == nil {
 		return nil
 	}
+	return mergeRolePerms(tx, user.Roles)
+}
 
+func mergeRolePerms(tx UnsafeAuthReader, roles []string) *unifiedRangePermissions {
 	readPerms := adt.NewIntervalTree()
 	writePerms := adt.NewIntervalTree()
+	adminPerms := adt.NewIntervalTree()
+	readDenyPerms := adt.NewIntervalTree()
+	writeDenyPerms := adt.NewIntervalTree()
 
-	for _, roleName := range user.Roles {
+	for _, roleName := range roles {
 		role := tx.UnsafeGetRole(roleName)
 		if role == nil {
 			continue
@@ -50,6 +63,21 @@ func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissio
 				ivl = adt.NewBytesAffinePoint(perm.Key)
 			}
 
+			if perm.Deny {
+				switch perm.PermType {
+				case authpb.READWRITE:
+					readDenyPerms.Insert(ivl, struct{}{})
+					writeDenyPerms.Insert(ivl, struct{}{})
+
+				case authpb.READ:
+					readDenyPerms.Insert(ivl, struct{}{})
+
+				case authpb.WRITE:
+					writeDenyPerms.Insert(ivl, struct{}{})
+				}
+				continue
+			}
+
 			switch perm.PermType {
 			case authpb.READWRITE:
 				readPerms.Insert(ivl, struct{}{})
@@ -60,6 +88,11 @@ func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissio
 
 			case authpb.WRITE:
 				writePerms.Insert(ivl, struct{}{})
+
+			case authpb.ADMIN:
+				readPerms.Insert(ivl, struct{}{})
+				writePerms.Insert(ivl, struct{}{})
+				adminPerms.Insert(ivl, struct{}{})
 			}
 		}
 	}
@@ -67,6 +100,10 @@ func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissio
 	return &unifiedRangePermissions{
 		readPerms:  readPerms,
 		writePerms: writePerms,
+		adminPerms: adminPerms,
+
+		readDenyPerms:  readDenyPerms,
+		writeDenyPerms: writeDenyPerms,
 	}
 }
 
@@ -85,9 +122,11 @@ func checkKeyInterval(
 	ivl := adt.NewBytesAffineInterval(key, rangeEnd)
 	switch permtyp {
 	case authpb.READ:
-		return cachedPerms.readPerms.Contains(ivl)
+		return cachedPerms.readPerms.Contains(ivl) && !isDenied(cachedPerms.readDenyPerms, ivl)
 	case authpb.WRITE:
-		return cachedPerms.writePerms.Contains(ivl)
+		return cachedPerms.writePerms.Contains(ivl) && !isDenied(cachedPerms.writeDenyPerms, ivl)
+	case authpb.ADMIN:
+		return cachedPerms.adminPerms.Contains(ivl) && !isAdminDenied(cachedPerms, ivl)
 	default:
 		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
 	}
@@ -98,34 +137,75 @@ func checkKeyPoint(lg *zap.Logger, cachedPerms *unifiedRangePermissions, key []b
 	pt := adt.NewBytesAffinePoint(key)
 	switch permtyp {
 	case authpb.READ:
-		return cachedPerms.readPerms.Intersects(pt)
+		return cachedPerms.readPerms.Intersects(pt) && !isDenied(cachedPerms.readDenyPerms, pt)
 	case authpb.WRITE:
-		return cachedPerms.writePerms.Intersects(pt)
+		return cachedPerms.writePerms.Intersects(pt) && !isDenied(cachedPerms.writeDenyPerms, pt)
+	case authpb.ADMIN:
+		return cachedPerms.adminPerms.Intersects(pt) && !isAdminDenied(cachedPerms, pt)
 	default:
 		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
 	}
 	return false
 }
 
-func (as *authStore) isRangeOpPermitted(userName string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
+// isDenied returns true if any key of ivl is denied, since a deny permission takes
+// precedence over the permissions granting the access.
+func isDenied(denyPerms adt.IntervalTree, ivl adt.Interval) bool {
+	return denyPerms != nil && denyPerms.Intersects(ivl)
+}
+
+// isAdminDenied returns true if any key of ivl is denied to be read or written, since
+// managing the access to a key could otherwise grant the access denied to the admin.
+func isAdminDenied(cachedPerms *unifiedRangePermissions, ivl adt.Interval) bool {
+	return isDenied(cachedPerms.readDenyPerms, ivl) || isDenied(cachedPerms.writeDenyPerms, ivl)
+}
+
+func (as *authStore) isRangeOpPermitted(tx UnsafeAuthReader, authInfo *AuthInfo, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
 	// assumption: tx is Lock()ed
+	if len(authInfo.Roles) > 0 {
+		return checkRangePerm(as.lg, as.tokenRolePerms(tx, authInfo), key, rangeEnd, permtyp)
+	}
+
 	as.rangePermCacheMu.RLock()
 	defer as.rangePermCacheMu.RUnlock()
 
-	rangePerm, ok := as.rangePermCache[userName]
+	rangePerm, ok := as.rangePermCache[authInfo.Username]
 	if !ok {
 		as.lg.Error(
 			"user doesn't exist",
-			zap.String("user-name", userName),
+			zap.String("user-name", authInfo.Username),
 		)
 		return false
 	}
+	return checkRangePerm(as.lg, rangePerm, key, rangeEnd, permtyp)
+}
 
+func checkRangePerm(lg *zap.Logger, rangePerm *unifiedRangePermissions, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
 	if len(rangeEnd) == 0 {
-		return checkKeyPoint(as.lg, rangePerm, key, permtyp)
+		return checkKeyPoint(lg, rangePerm, key, permtyp)
 	}
 
-	return checkKeyInterval(as.lg, rangePerm, key, rangeEnd, permtyp)
+	return checkKeyInterval(lg, rangePerm, key, rangeEnd, permtyp)
+}
+
+// tokenRolePerms returns the merged permissions of the roles of the user and the
+// roles granted by its token. They are cached per auth revision, since the role
+// sets of tokens are not known before the tokens are used.
+func (as *authStore) tokenRolePerms(tx UnsafeAuthReader, authInfo *AuthInfo) *unifiedRangePermissions {
+	cacheKey := fmt.Sprintf("%d\x00%s\x00%s", as.Revision(), authInfo.Username, strings.Join(authInfo.Roles, "\x00"))
+	as.tokenRolePermCacheMu.Lock()
+	defer as.tokenRolePermCacheMu.Unlock()
+	if perms, ok := as.tokenRolePermCache.Get(cacheKey); ok {
+		return perms.(*unifiedRangePermissions)
+	}
+
+	roles := authInfo.Roles
+	if user := tx.UnsafeGetUser(authInfo.Username); user != nil {
+		roles = append(append([]string{}, user.Roles...), roles...)
+	}
+	perms := mergeRolePerms(tx, roles)
+	as.tokenRolePermCache.Add(cacheKey, perms)
+	return perms
 }
 
 func (as *authStore) refreshRangePermCache(tx UnsafeAuthReader) {
@@ -138,6 +218,9 @@ func (as *authStore) refreshRangePermCache(tx UnsafeAuthReader) {
 	as.lg.Debug("Refreshing rangePermCache")
 
 	as.rangePermCache = make(map[string]*unifiedRangePermissions)
+	as.tokenRolePermCacheMu.Lock()
+	as.tokenRolePermCache.Clear()
+	as.tokenRolePermCacheMu.Unlock()
 
 	users := tx.UnsafeGetAllUsers()
 	for _, user := range users {
@@ -157,6 +240,11 @@ func (as *authStore) refreshRangePermCache(tx UnsafeAuthReader) {
 type unifiedRangePermissions struct {
 	readPerms  adt.IntervalTree
 	writePerms adt.IntervalTree
+	// adminPerms holds the key ranges the user administers as a delegated admin.
+	adminPerms adt.IntervalTree
+
+	readDenyPerms  adt.IntervalTree
+	writeDenyPerms adt.IntervalTree
 }
 
 // Constraints related to key range
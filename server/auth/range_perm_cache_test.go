@@ -135,6 +135,41 @@ func TestKeyPermission(t *testing.T) {
 	}
 }
 
+func TestDenyPermission(t *testing.T) {
+	readPerms := adt.NewIntervalTree()
+	readPerms.Insert(adt.NewBytesAffineInterval([]byte("/app/"), []byte("/app0")), struct{}{})
+	readDenyPerms := adt.NewIntervalTree()
+	readDenyPerms.Insert(adt.NewBytesAffineInterval([]byte("/app/secrets/"), []byte("/app/secrets0")), struct{}{})
+	readDenyPerms.Insert(adt.NewBytesAffinePoint([]byte("/app/key")), struct{}{})
+	perms := &unifiedRangePermissions{readPerms: readPerms, readDenyPerms: readDenyPerms}
+
+	tests := []struct {
+		begin []byte
+		end   []byte
+		want  bool
+	}{
+		{[]byte("/app/a"), nil, true},
+		{[]byte("/app/secrets/a"), nil, false},
+		{[]byte("/app/key"), nil, false},
+		{[]byte("/app/a"), []byte("/app/b"), true},
+		{[]byte("/app/"), []byte("/app0"), false},
+		{[]byte("/app/secrets/"), []byte("/app/secrets0"), false},
+		{[]byte("/app/k"), []byte("/app/l"), false},
+		{[]byte("/app/secrets0"), []byte("/app0"), true},
+	}
+	for i, tt := range tests {
+		var result bool
+		if len(tt.end) == 0 {
+			result = checkKeyPoint(zaptest.NewLogger(t), perms, tt.begin, authpb.READ)
+		} else {
+			result = checkKeyInterval(zaptest.NewLogger(t), perms, tt.begin, tt.end, authpb.READ)
+		}
+		if result != tt.want {
+			t.Errorf("#%d: result=%t, want=%t", i, result, tt.want)
+		}
+	}
+}
+
 func TestRangeCheck(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -25,6 +25,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/groupcache/lru"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/credentials"
@@ -72,11 +73,16 @@ const (
 
 	tokenTypeSimple = "simple"
 	tokenTypeJWT    = "jwt"
+	tokenTypeOIDC   = "oidc"
 )
 
 type AuthInfo struct {
 	Username string
 	Revision uint64
+	// Roles are the roles granted by the claims of an externally issued token,
+	// in addition to the roles of the user. The user doesn't need to exist if
+	// the token grants roles.
+	Roles []string
 }
 
 // AuthenticateParamIndex is used for a key of context in the parameters of Authenticate()
@@ -153,6 +159,10 @@ type AuthStore interface {
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
+	// IsDelegatedAdminPermitted checks whether the user can perform the user or role
+	// management request with the ADMIN permissions of its roles
+	IsDelegatedAdminPermitted(authInfo *AuthInfo, r *pb.InternalRaftRequest) error
+
 	// GenTokenPrefix produces a random string in a case of simple token
 	// in a case of JWT, it produces an empty string
 	GenTokenPrefix() (string, error)
@@ -257,6 +267,13 @@ type authStore struct {
 	rangePermCache   map[string]*unifiedRangePermissions // username -> unifiedRangePermissions
 	rangePermCacheMu sync.RWMutex
 
+	// tokenRolePermCache holds the permissions of the users with the roles granted by
+	// their tokens, and is invalidated with rangePermCache. It keeps the
+	// maxTokenRolePermCacheEntries most recently used role sets, as any number
+	// of them can be granted by tokens.
+	tokenRolePermCache   *lru.Cache
+	tokenRolePermCacheMu sync.Mutex
+
 	tokenProvider TokenProvider
 	bcryptCost    int // the algorithm cost / strength for hashing auth passwords
 }
@@ -811,6 +828,10 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	if !isValidPermissionRange(r.Perm.Key, r.Perm.RangeEnd) {
 		return nil, ErrInvalidAuthMgmt
 	}
+	if r.Perm.Deny && r.Perm.PermType == authpb.ADMIN {
+		// the administration of a key range can't be denied.
+		return nil, ErrInvalidAuthMgmt
+	}
 
 	tx := as.be.BatchTx()
 	tx.Lock()
@@ -828,12 +849,14 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) && bytes.Equal(role.KeyPermission[idx].RangeEnd, r.Perm.RangeEnd) {
 		// update existing permission
 		role.KeyPermission[idx].PermType = r.Perm.PermType
+		role.KeyPermission[idx].Deny = r.Perm.Deny
 	} else {
 		// append new permission to the role
 		newPerm := &authpb.Permission{
 			Key:      r.Perm.Key,
 			RangeEnd: r.Perm.RangeEnd,
 			PermType: r.Perm.PermType,
+			Deny:     r.Perm.Deny,
 		}
 
 		role.KeyPermission = append(role.KeyPermission, newPerm)
@@ -849,18 +872,20 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 		"granted/updated a permission to a user",
 		zap.String("user-name", r.Name),
 		zap.String("permission-name", authpb.Permission_Type_name[int32(r.Perm.PermType)]),
+		zap.Bool("deny", r.Perm.Deny),
 		zap.ByteString("key", r.Perm.Key),
 		zap.ByteString("range-end", r.Perm.RangeEnd),
 	)
 	return &pb.AuthRoleGrantPermissionResponse{}, nil
 }
 
-func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
+func (as *authStore) isOpPermitted(authInfo *AuthInfo, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
 	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.IsAuthEnabled() {
 		return nil
 	}
 
+	userName, revision := authInfo.Username, authInfo.Revision
 	// only gets rev == 0 when passed AuthInfo{}; no user given
 	if revision == 0 {
 		return ErrUserEmpty
@@ -880,17 +905,17 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 	defer tx.RUnlock()
 
 	user := tx.UnsafeGetUser(userName)
-	if user == nil {
+	if user == nil && len(authInfo.Roles) == 0 {
 		as.lg.Error("cannot find a user for permission check", zap.String("user-name", userName))
 		return ErrPermissionDenied
 	}
 
 	// root role should have permission on all ranges
-	if hasRootRole(user) {
+	if user != nil && hasRootRole(user) {
 		return nil
 	}
 
-	if as.isRangeOpPermitted(userName, key, rangeEnd, permTyp) {
+	if as.isRangeOpPermitted(tx, authInfo, key, rangeEnd, permTyp) {
 		return nil
 	}
 
@@ -898,15 +923,15 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 }
 
 func (as *authStore) IsPutPermitted(authInfo *AuthInfo, key []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, nil, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, nil, authpb.WRITE)
 }
 
 func (as *authStore) IsRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.READ)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.READ)
 }
 
 func (as *authStore) IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.WRITE)
 }
 
 func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
@@ -933,6 +958,138 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 	return nil
 }
 
+// IsDelegatedAdminPermitted permits a user that is not root to manage the users and
+// roles within the key ranges of its ADMIN permissions, less the keys it is denied
+// to read or write:
+//   - any user or role can be added.
+//   - a role can be deleted, granted to or revoked from a user, or have its permissions
+//     revoked, only if all of its permissions are within the ranges.
+//   - a permission can be granted to such a role only if it is within the ranges.
+//   - deny permissions are kept to root: they can't be granted, and the roles holding
+//     them can't be deleted, granted, revoked or have their permissions revoked.
+//   - a user can be deleted, have its password changed, or have roles granted or
+//     revoked only if it was added by the delegated admin, or has roles and all of them
+//     are managed within the ranges; all the roles of the users added by the delegated
+//     admin must be managed within the ranges as well.
+//
+// Enabling and disabling auth, listing the users and roles, and the root user and
+// role are kept to root.
+func (as *authStore) IsDelegatedAdminPermitted(authInfo *AuthInfo, r *pb.InternalRaftRequest) error {
+	if !as.IsAuthEnabled() {
+		return nil
+	}
+	if authInfo == nil || authInfo.Username == "" {
+		return ErrUserEmpty
+	}
+
+	tx := as.be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	if tx.UnsafeGetUser(authInfo.Username) == nil {
+		return ErrUserNotFound
+	}
+
+	as.rangePermCacheMu.RLock()
+	defer as.rangePermCacheMu.RUnlock()
+	perms, ok := as.rangePermCache[authInfo.Username]
+	if !ok || perms.adminPerms.Len() == 0 {
+		return ErrPermissionDenied
+	}
+	d := &delegatedAdmin{lg: as.lg, tx: tx, username: authInfo.Username, perms: perms}
+
+	var permitted bool
+	switch {
+	case r.AuthUserAdd != nil:
+		permitted = r.AuthUserAdd.Name != rootUser
+	case r.AuthRoleAdd != nil:
+		permitted = r.AuthRoleAdd.Name != rootRole
+	case r.AuthUserDelete != nil:
+		permitted = d.managesUser(r.AuthUserDelete.Name)
+	case r.AuthUserChangePassword != nil:
+		permitted = d.managesUser(r.AuthUserChangePassword.Name)
+	case r.AuthUserGet != nil:
+		permitted = d.managesUser(r.AuthUserGet.Name)
+	case r.AuthUserGrantRole != nil:
+		permitted = d.managesUser(r.AuthUserGrantRole.User) && d.managesRole(r.AuthUserGrantRole.Role)
+	case r.AuthUserRevokeRole != nil:
+		permitted = d.managesUser(r.AuthUserRevokeRole.Name) && d.managesRole(r.AuthUserRevokeRole.Role)
+	case r.AuthRoleGrantPermission != nil:
+		perm := r.AuthRoleGrantPermission.Perm
+		permitted = perm != nil && d.managesRole(r.AuthRoleGrantPermission.Name) && d.managesPermission(perm)
+	case r.AuthRoleRevokePermission != nil:
+		permitted = d.managesRole(r.AuthRoleRevokePermission.Role)
+	case r.AuthRoleGet != nil:
+		permitted = d.managesRole(r.AuthRoleGet.Role)
+	case r.AuthRoleDelete != nil:
+		permitted = d.managesRole(r.AuthRoleDelete.Role)
+	}
+	if !permitted {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// delegatedAdmin checks the users and roles against the ADMIN permissions of a user.
+type delegatedAdmin struct {
+	lg       *zap.Logger
+	tx       UnsafeAuthReader
+	username string
+	perms    *unifiedRangePermissions
+}
+
+func (d *delegatedAdmin) managesPermission(perm *authpb.Permission) bool {
+	// lifting a deny permission would grant access out of the ranges of
+	// the delegated admin, e.g. to the keys denied by root.
+	if perm.Deny {
+		return false
+	}
+	if !isValidPermissionRange(perm.Key, perm.RangeEnd) {
+		return false
+	}
+	if len(perm.RangeEnd) == 0 {
+		return checkKeyPoint(d.lg, d.perms, perm.Key, authpb.ADMIN)
+	}
+	return checkKeyInterval(d.lg, d.perms, perm.Key, perm.RangeEnd, authpb.ADMIN)
+}
+
+func (d *delegatedAdmin) managesRole(name string) bool {
+	if name == rootRole {
+		return false
+	}
+	role := d.tx.UnsafeGetRole(name)
+	if role == nil {
+		return false
+	}
+	for _, perm := range role.KeyPermission {
+		if !d.managesPermission(perm) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *delegatedAdmin) managesUser(name string) bool {
+	if name == rootUser {
+		return false
+	}
+	user := d.tx.UnsafeGetUser(name)
+	if user == nil {
+		return false
+	}
+	// a user without roles may belong to any tenant, unless the delegated
+	// admin added it.
+	addedBy := user.Options != nil && user.Options.AddedBy == d.username
+	if len(user.Roles) == 0 && !addedBy {
+		return false
+	}
+	for _, role := range user.Roles {
+		if !d.managesRole(role) {
+			return false
+		}
+	}
+	return true
+}
+
 func (as *authStore) IsAuthEnabled() bool {
 	as.enabledMu.RLock()
 	defer as.enabledMu.RUnlock()
@@ -968,8 +1125,10 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 		be:             be,
 		enabled:        enabled,
 		rangePermCache: make(map[string]*unifiedRangePermissions),
-		tokenProvider:  tp,
-		bcryptCost:     bcryptCost,
+
+		tokenRolePermCache: lru.New(maxTokenRolePermCacheEntries),
+		tokenProvider:      tp,
+		bcryptCost:         bcryptCost,
 	}
 
 	if enabled {
@@ -1139,6 +1298,9 @@ func NewTokenProvider(
 	case tokenTypeJWT:
 		return newTokenProviderJWT(lg, typeSpecificOpts)
 
+	case tokenTypeOIDC:
+		return newTokenProviderOIDC(lg, typeSpecificOpts, indexWaiter, TokenTTL)
+
 	case "":
 		return newTokenProviderNop()
 
@@ -401,7 +401,7 @@ func TestIsOpPermitted(t *testing.T) {
 
 	// check permission reflected to user
 
-	err = as.isOpPermitted("foo", as.Revision(), perm.Key, perm.RangeEnd, perm.PermType)
+	err = as.isOpPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}, perm.Key, perm.RangeEnd, perm.PermType)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -411,7 +411,7 @@ func TestIsOpPermitted(t *testing.T) {
 	as.rangePermCacheMu.Lock()
 	delete(as.rangePermCache, "foo")
 	as.rangePermCacheMu.Unlock()
-	if err := as.isOpPermitted("foo", as.Revision(), perm.Key, perm.RangeEnd, perm.PermType); !errors.Is(err, ErrPermissionDenied) {
+	if err := as.isOpPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}, perm.Key, perm.RangeEnd, perm.PermType); !errors.Is(err, ErrPermissionDenied) {
 		t.Fatal(err)
 	}
 }
@@ -647,6 +647,42 @@ func TestRoleGrantInvalidPermission(t *testing.T) {
 	}
 }
 
+func TestRoleGrantDenyPermission(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-deny"})
+	require.NoError(t, err)
+	for _, role := range []string{"role-test", "role-deny"} {
+		_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: role})
+		require.NoError(t, err)
+	}
+	for _, r := range []*pb.AuthRoleGrantPermissionRequest{
+		{Name: "role-test", Perm: &authpb.Permission{PermType: authpb.READWRITE, Key: []byte("/app/"), RangeEnd: []byte("/app0")}},
+		// the deny permission of another role of the user takes precedence.
+		{Name: "role-deny", Perm: &authpb.Permission{PermType: authpb.WRITE, Key: []byte("/app/secrets/"), RangeEnd: []byte("/app/secrets0"), Deny: true}},
+	} {
+		_, err = as.RoleGrantPermission(r)
+		require.NoError(t, err)
+	}
+
+	ai := &AuthInfo{Username: "foo", Revision: as.Revision()}
+	require.NoError(t, as.IsPutPermitted(ai, []byte("/app/a")))
+	require.ErrorIs(t, as.IsPutPermitted(ai, []byte("/app/secrets/a")), ErrPermissionDenied)
+	require.ErrorIs(t, as.IsDeleteRangePermitted(ai, []byte("/app/"), []byte("/app0")), ErrPermissionDenied)
+	require.NoError(t, as.IsRangePermitted(ai, []byte("/app/secrets/a"), nil))
+
+	// granting the permission again replaces the deny permission.
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-deny", Perm: &authpb.Permission{PermType: authpb.WRITE, Key: []byte("/app/secrets/"), RangeEnd: []byte("/app/secrets0")}})
+	require.NoError(t, err)
+	ai.Revision = as.Revision()
+	require.NoError(t, as.IsPutPermitted(ai, []byte("/app/secrets/a")))
+
+	// the administration of a key range can't be denied.
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-deny", Perm: &authpb.Permission{PermType: authpb.ADMIN, Key: []byte("/app/"), RangeEnd: []byte("/app0"), Deny: true}})
+	require.ErrorIs(t, err, ErrInvalidAuthMgmt)
+}
+
 func TestRootRoleGrantPermission(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -949,6 +985,124 @@ func TestIsAdminPermitted(t *testing.T) {
 	}
 }
 
+func TestIsDelegatedAdminPermitted(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	grant := func(role string, permType authpb.Permission_Type, key, rangeEnd string) {
+		_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: role, Perm: &authpb.Permission{
+			PermType: permType,
+			Key:      []byte(key),
+			RangeEnd: []byte(rangeEnd),
+		}})
+		require.NoError(t, err)
+	}
+	for _, role := range []string{"tenant-admin", "a-reader", "b-reader", "a-denied"} {
+		_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: role})
+		require.NoError(t, err)
+	}
+	grant("tenant-admin", authpb.ADMIN, "a/", "a0")
+	grant("a-reader", authpb.READ, "a/x", "")
+	grant("b-reader", authpb.READ, "b/", "b0")
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "a-denied", Perm: &authpb.Permission{
+		PermType: authpb.READWRITE,
+		Key:      []byte("a/secret"),
+		Deny:     true,
+	}})
+	require.NoError(t, err)
+	for user, role := range map[string]string{"alice": "tenant-admin", "bob": "a-reader", "carol": "b-reader", "dave": "", "frank": "a-denied"} {
+		_, err := as.UserAdd(&pb.AuthUserAddRequest{Name: user, Options: &authpb.UserAddOptions{NoPassword: true}})
+		require.NoError(t, err)
+		if role != "" {
+			_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: user, Role: role})
+			require.NoError(t, err)
+		}
+	}
+	// root denies alice the access to a/secret within its ADMIN range.
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "alice", Role: "a-denied"})
+	require.NoError(t, err)
+	// erin was added by alice, and has no roles yet.
+	_, err = as.UserAdd(&pb.AuthUserAddRequest{Name: "erin", Options: &authpb.UserAddOptions{NoPassword: true, AddedBy: "alice"}})
+	require.NoError(t, err)
+
+	// the ADMIN permission grants read and write access to the range.
+	alice := &AuthInfo{Username: "alice", Revision: as.Revision()}
+	require.NoError(t, as.IsPutPermitted(alice, []byte("a/k")))
+	require.NoError(t, as.IsRangePermitted(alice, []byte("a/"), []byte("a/s")))
+	require.ErrorIs(t, as.IsPutPermitted(alice, []byte("b/k")), ErrPermissionDenied)
+	require.ErrorIs(t, as.IsRangePermitted(alice, []byte("a/secret"), nil), ErrPermissionDenied)
+
+	readPerm := func(key, rangeEnd string) *authpb.Permission {
+		return &authpb.Permission{PermType: authpb.READ, Key: []byte(key), RangeEnd: []byte(rangeEnd)}
+	}
+	tests := []struct {
+		name      string
+		r         *pb.InternalRaftRequest
+		permitted bool
+	}{
+		{name: "add user", r: &pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "eve"}}, permitted: true},
+		{name: "add root user", r: &pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "root"}}},
+		{name: "add role", r: &pb.InternalRaftRequest{AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "a-writer"}}, permitted: true},
+		{name: "grant permission in range", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/y/", "a/y0")}}, permitted: true},
+		{name: "grant key in range", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/y", "")}}, permitted: true},
+		{name: "grant permission on key denied to the admin", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/secret", "")}}},
+		{name: "grant permission overlapping range denied to the admin", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/", "a0")}}},
+		{name: "grant permission out of range", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/", "b0")}}},
+		{name: "grant open ended permission", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: readPerm("a/", "\x00")}}},
+		{name: "grant permission to unmanaged role", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "b-reader", Perm: readPerm("a/y", "")}}},
+		{name: "grant permission to root role", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "root", Perm: readPerm("a/y", "")}}},
+		{name: "grant permission to missing role", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "missing", Perm: readPerm("a/y", "")}}},
+		{name: "grant deny permission in range", r: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: "a-reader", Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("a/y"), Deny: true}}}},
+		{name: "revoke deny permission", r: &pb.InternalRaftRequest{AuthRoleRevokePermission: &pb.AuthRoleRevokePermissionRequest{Role: "a-denied", Key: []byte("a/secret")}}},
+		{name: "revoke role with deny permission", r: &pb.InternalRaftRequest{AuthUserRevokeRole: &pb.AuthUserRevokeRoleRequest{Name: "frank", Role: "a-denied"}}},
+		{name: "delete role with deny permission", r: &pb.InternalRaftRequest{AuthRoleDelete: &pb.AuthRoleDeleteRequest{Role: "a-denied"}}},
+		{name: "revoke permission", r: &pb.InternalRaftRequest{AuthRoleRevokePermission: &pb.AuthRoleRevokePermissionRequest{Role: "a-reader", Key: []byte("a/x")}}, permitted: true},
+		{name: "revoke permission of unmanaged role", r: &pb.InternalRaftRequest{AuthRoleRevokePermission: &pb.AuthRoleRevokePermissionRequest{Role: "b-reader", Key: []byte("b/"), RangeEnd: []byte("b0")}}},
+		{name: "grant role", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "bob", Role: "a-reader"}}, permitted: true},
+		{name: "grant role overlapping range denied to the admin", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "bob", Role: "tenant-admin"}}},
+		{name: "grant role to user added by the admin", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "erin", Role: "a-reader"}}, permitted: true},
+		{name: "grant role to user without roles", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "dave", Role: "a-reader"}}},
+		{name: "grant role to unmanaged user", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "carol", Role: "a-reader"}}},
+		{name: "grant unmanaged role", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "bob", Role: "b-reader"}}},
+		{name: "grant root role", r: &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "bob", Role: "root"}}},
+		{name: "revoke role", r: &pb.InternalRaftRequest{AuthUserRevokeRole: &pb.AuthUserRevokeRoleRequest{Name: "bob", Role: "a-reader"}}, permitted: true},
+		{name: "revoke role of unmanaged user", r: &pb.InternalRaftRequest{AuthUserRevokeRole: &pb.AuthUserRevokeRoleRequest{Name: "carol", Role: "b-reader"}}},
+		{name: "delete user", r: &pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "bob"}}, permitted: true},
+		{name: "delete user without roles", r: &pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "dave"}}},
+		{name: "delete user added by the admin", r: &pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "erin"}}, permitted: true},
+		{name: "delete unmanaged user", r: &pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "carol"}}},
+		{name: "delete root user", r: &pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "root"}}},
+		{name: "change password", r: &pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "bob"}}, permitted: true},
+		{name: "change password of unmanaged user", r: &pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "carol"}}},
+		{name: "change password of user without roles", r: &pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "dave"}}},
+		{name: "change password of user added by the admin", r: &pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "erin"}}, permitted: true},
+		{name: "get user", r: &pb.InternalRaftRequest{AuthUserGet: &pb.AuthUserGetRequest{Name: "bob"}}, permitted: true},
+		{name: "get user without roles", r: &pb.InternalRaftRequest{AuthUserGet: &pb.AuthUserGetRequest{Name: "dave"}}},
+		{name: "get unmanaged user", r: &pb.InternalRaftRequest{AuthUserGet: &pb.AuthUserGetRequest{Name: "carol"}}},
+		{name: "get role", r: &pb.InternalRaftRequest{AuthRoleGet: &pb.AuthRoleGetRequest{Role: "a-reader"}}, permitted: true},
+		{name: "delete role", r: &pb.InternalRaftRequest{AuthRoleDelete: &pb.AuthRoleDeleteRequest{Role: "a-reader"}}, permitted: true},
+		{name: "delete unmanaged role", r: &pb.InternalRaftRequest{AuthRoleDelete: &pb.AuthRoleDeleteRequest{Role: "b-reader"}}},
+		{name: "list users", r: &pb.InternalRaftRequest{AuthUserList: &pb.AuthUserListRequest{}}},
+		{name: "disable auth", r: &pb.InternalRaftRequest{AuthDisable: &pb.AuthDisableRequest{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := as.IsDelegatedAdminPermitted(alice, tt.r)
+			if tt.permitted {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrPermissionDenied)
+			}
+		})
+	}
+
+	// users without ADMIN permissions are not delegated admins.
+	err = as.IsDelegatedAdminPermitted(&AuthInfo{Username: "bob", Revision: 1}, &pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "eve"}})
+	require.ErrorIs(t, err, ErrPermissionDenied)
+	err = as.IsDelegatedAdminPermitted(&AuthInfo{Username: "missing", Revision: 1}, &pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "eve"}})
+	require.ErrorIs(t, err, ErrUserNotFound)
+}
+
 func TestRecoverFromSnapshot(t *testing.T) {
 	as, teardown := setupAuthStore(t)
 	defer teardown(t)
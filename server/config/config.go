@@ -32,8 +32,11 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/featuregate"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/server/v3/audit"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
+	"go.etcd.io/etcd/server/v3/storage/walarchive"
 )
 
 const (
@@ -67,6 +70,15 @@ type ServerConfig struct {
 	MaxSnapFiles uint
 	MaxWALFiles  uint
 
+	// WALArchiveSink is the sink finished WAL segments and snapshot files are
+	// copied to. WAL segments and snapshot files are not purged until they are
+	// archived. Archiving is disabled if nil.
+	WALArchiveSink walarchive.Sink
+
+	// EncryptionKMS wraps the data encryption keys of the values of the key
+	// bucket. The values are stored in plaintext if nil.
+	EncryptionKMS encryption.KMS
+
 	// BackendBatchInterval is the maximum time before commit the backend transaction.
 	BackendBatchInterval time.Duration
 	// BackendBatchLimit is the maximum operations before commit the backend transaction.
@@ -123,6 +135,10 @@ type ServerConfig struct {
 
 	AutoCompactionRetention time.Duration
 	AutoCompactionMode      string
+	// AutoCompactionTargetBackendBytes is the backend size targeted by the
+	// adaptive auto compaction. It is 80% of the backend quota if zero.
+	AutoCompactionTargetBackendBytes int64
+
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
 	QuotaBackendBytes       int64
@@ -204,6 +220,38 @@ type ServerConfig struct {
 	// MaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	MaxLearners int `json:"max-learners"`
 
+	// LearnerAutoPromoteMaxLag is the maximum number of entries a learner can be
+	// behind the leader to be considered caught up by the LearnerAutoPromotion feature.
+	LearnerAutoPromoteMaxLag uint64 `json:"learner-auto-promote-max-lag"`
+	// LearnerAutoPromoteDuration is the duration a learner must stay caught up
+	// before the leader promotes it, if the LearnerAutoPromotion feature is enabled.
+	LearnerAutoPromoteDuration time.Duration `json:"learner-auto-promote-duration"`
+
+	// LeaseRead enables the leader to confirm linearizable reads with its
+	// leader lease (raft ReadOnlyLeaseBased) instead of a quorum round.
+	LeaseRead bool `json:"lease-read"`
+	// LeaseReadMaxClockDrift is the maximum delay of a tick of the leader
+	// that its leader lease tolerates.
+	LeaseReadMaxClockDrift time.Duration `json:"lease-read-max-clock-drift"`
+
+	// HealthMaxApplyLag is the maximum number of committed entries the member
+	// can have not applied yet to be ready. Zero disables the check.
+	HealthMaxApplyLag uint64 `json:"health-max-apply-lag"`
+	// HealthMaxWALFsyncDuration is the maximum 99th percentile of the recent
+	// WAL fsync durations, and duration of an fsync in progress, for the
+	// member to be ready. Zero disables the check.
+	HealthMaxWALFsyncDuration time.Duration `json:"health-max-wal-fsync-duration"`
+
+	// GRPCRateLimit is the number of gRPC requests per second admitted per
+	// client, with bursts of GRPCRateLimitBurst requests. Zero disables the
+	// rate limit.
+	GRPCRateLimit      float64 `json:"grpc-rate-limit"`
+	GRPCRateLimitBurst int     `json:"grpc-rate-limit-burst"`
+
+	// Auditor records the mutating, auth, cluster and maintenance requests of
+	// the clients. Auditing is disabled if nil.
+	Auditor *audit.Auditor `json:"-"`
+
 	// ExperimentalMaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	ExperimentalMaxLearners int `json:"experimental-max-learners"`
 
@@ -45,22 +45,33 @@ import (
 	"go.etcd.io/etcd/pkg/v3/featuregate"
 	"go.etcd.io/etcd/pkg/v3/flags"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/server/v3/audit"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/features"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
+	"go.etcd.io/etcd/server/v3/storage/walarchive"
 )
 
 const (
 	ClusterStateFlagNew      = "new"
 	ClusterStateFlagExisting = "existing"
 
+	// ReadIndexModeSafe confirms every linearizable read with a quorum round.
+	ReadIndexModeSafe = "safe"
+	// ReadIndexModeLease lets the leader confirm linearizable reads with its
+	// leader lease while it holds one.
+	ReadIndexModeLease = "lease"
+
 	DefaultName                        = "default"
 	DefaultMaxSnapshots                = 5
 	DefaultMaxWALs                     = 5
+	DefaultWALArchiveTimeout           = time.Minute
 	DefaultMaxTxnOps                   = uint(128)
 	DefaultWarningApplyDuration        = 100 * time.Millisecond
 	DefaultWarningUnaryRequestDuration = 300 * time.Millisecond
@@ -74,6 +85,13 @@ const (
 	DefaultAutoCompactionRetention     = "0"
 	DefaultAuthToken                   = "simple"
 	DefaultCompactHashCheckTime        = time.Minute
+	DefaultLearnerAutoPromoteMaxLag    = 1000
+	DefaultLearnerAutoPromoteDuration  = 30 * time.Second
+	DefaultReadIndexMode               = ReadIndexModeSafe
+	DefaultLeaseReadMaxClockDrift      = 100 * time.Millisecond
+	DefaultGRPCRateLimitBurst          = 100
+	DefaultAuditLogLevel               = string(audit.LevelMetadata)
+	DefaultAuditLogMaxSize             = 100
 	DefaultLoggingFormat               = "json"
 
 	DefaultDiscoveryDialTimeout       = 2 * time.Second
@@ -83,6 +101,7 @@ const (
 	DefaultDiscoveryInsecureTransport = true
 	DefaultSelfSignedCertValidity     = 1
 	DefaultTLSMinVersion              = string(tlsutil.TLSVersion12)
+	DefaultTLSReloadInterval          = 10 * time.Second
 
 	DefaultListenPeerURLs   = "http://localhost:2380"
 	DefaultListenClientURLs = "http://localhost:2379"
@@ -182,6 +201,14 @@ var (
 	// revision 5000 when the current revision is 6000.
 	// This runs every 5-minute if enough of logs have proceeded.
 	CompactorModeRevision = v3compactor.ModeRevision
+
+	// CompactorModeAdaptive is backend size based compaction mode
+	// for "Config.AutoCompactionMode" field.
+	// If "AutoCompactionMode" is CompactorModeAdaptive and
+	// "AutoCompactionRetention" is "1h", it compacts the revisions which
+	// do not fit "AutoCompactionTargetBackendBytes", given the observed
+	// revision rate, but always keeps the revisions of the last hour.
+	CompactorModeAdaptive = v3compactor.ModeAdaptive
 )
 
 func init() {
@@ -225,6 +252,26 @@ type Config struct {
 	//revive:disable-next-line:var-naming
 	MaxWalFiles uint `json:"max-wals"`
 
+	// WALArchiveURL is the URL of the sink finished WAL segments and snapshot
+	// files are copied to: a local directory, or an http(s) URL accepting PUT
+	// requests. Archiving is disabled if empty.
+	WALArchiveURL string `json:"wal-archive-url"`
+	// WALArchiveTimeout is the timeout of a request to an http(s) WAL archive
+	// sink, including the upload of a WAL segment.
+	WALArchiveTimeout time.Duration `json:"wal-archive-timeout"`
+	// WALArchiveSink is the sink finished WAL segments and snapshot files are
+	// copied to. It takes precedence over WALArchiveURL.
+	WALArchiveSink walarchive.Sink `json:"-"`
+
+	// EncryptionKeyFile is the file of the key encryption keys wrapping the
+	// data encryption keys of the values of the key bucket. See
+	// encryption.NewLocalKMS for its format. The values are stored in
+	// plaintext if empty. WAL entries are not encrypted.
+	EncryptionKeyFile string `json:"encryption-key-file"`
+	// EncryptionKMS wraps the data encryption keys of the values of the key
+	// bucket. It takes precedence over EncryptionKeyFile.
+	EncryptionKMS encryption.KMS `json:"-"`
+
 	// TickMs is the number of milliseconds between heartbeat ticks.
 	// TODO: decouple tickMs and heartbeat tick (current heartbeat tick = 1).
 	// make ticks a cluster wide configuration.
@@ -302,6 +349,12 @@ type Config struct {
 	//revive:disable-next-line:var-naming
 	TlsMaxVersion string `json:"tls-max-version"`
 
+	// TLSReloadInterval is the interval at which the files of the client and
+	// peer TLS configurations, including the trusted CA and CRL files, are
+	// checked and reloaded if they changed. 0 disables the checks; the files
+	// are still reloaded by Etcd.ReloadTLS, e.g. on SIGHUP.
+	TLSReloadInterval time.Duration `json:"tls-reload-interval"`
+
 	ClusterState          string `json:"initial-cluster-state"`
 	DNSCluster            string `json:"discovery-srv"`
 	DNSClusterServiceName string `json:"discovery-srv-name"`
@@ -314,13 +367,16 @@ type Config struct {
 	InitialClusterToken string `json:"initial-cluster-token"`
 	StrictReconfigCheck bool   `json:"strict-reconfig-check"`
 
-	// AutoCompactionMode is either 'periodic' or 'revision'.
+	// AutoCompactionMode is either 'periodic', 'revision' or 'adaptive'.
 	AutoCompactionMode string `json:"auto-compaction-mode"`
 	// AutoCompactionRetention is either duration string with time unit
 	// (e.g. '5m' for 5-minute), or revision unit (e.g. '5000').
 	// If no time unit is provided and compaction mode is 'periodic',
 	// the unit defaults to hour. For example, '5' translates into 5-hour.
 	AutoCompactionRetention string `json:"auto-compaction-retention"`
+	// AutoCompactionTargetBackendBytes is the backend size targeted by the
+	// 'adaptive' compaction mode. It defaults to 80% of the backend quota.
+	AutoCompactionTargetBackendBytes int64 `json:"auto-compaction-target-backend-bytes"`
 
 	// GRPCKeepAliveMinTime is the minimum interval that a client should
 	// wait before pinging server. When client pings "too fast", server
@@ -394,6 +450,17 @@ type Config struct {
 	//	}
 	//	embed.StartEtcd(cfg)
 	ServiceRegister func(*grpc.Server) `json:"-"`
+	// LivezChecks and ReadyzChecks are for registering users' health checks
+	// on /livez and /readyz, in addition to the built-in ones, and only used
+	// for embedding etcd into other applications. The map key is the name of
+	// the check, served on its own sub path as the built-in checks are.
+	LivezChecks  map[string]etcdhttp.HealthCheck `json:"-"`
+	ReadyzChecks map[string]etcdhttp.HealthCheck `json:"-"`
+
+	// ConfigLoader loads the configuration applied by Etcd.ReloadConfig,
+	// usually from the configuration file the server was started with. The
+	// configuration cannot be reloaded if it is not set.
+	ConfigLoader func() (*Config, error) `json:"-"`
 
 	AuthToken  string `json:"auth-token"`
 	BcryptCost uint   `json:"bcrypt-cost"`
@@ -470,6 +537,55 @@ type Config struct {
 	ExperimentalWarningUnaryRequestDuration time.Duration `json:"experimental-warning-unary-request-duration"`
 	// MaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	MaxLearners int `json:"max-learners"`
+	// LearnerAutoPromoteMaxLag is the maximum number of entries a learner can be
+	// behind the leader to be considered caught up by the LearnerAutoPromotion feature.
+	LearnerAutoPromoteMaxLag uint64 `json:"learner-auto-promote-max-lag"`
+	// LearnerAutoPromoteDuration is the duration a learner must stay caught up
+	// before the leader promotes it, if the LearnerAutoPromotion feature is enabled.
+	LearnerAutoPromoteDuration time.Duration `json:"learner-auto-promote-duration"`
+	// ReadIndexMode is how linearizable reads are confirmed, "safe" or "lease".
+	ReadIndexMode string `json:"read-index-mode"`
+	// LeaseReadMaxClockDrift is the maximum delay of a tick of the leader
+	// tolerated by the "lease" read index mode.
+	LeaseReadMaxClockDrift time.Duration `json:"lease-read-max-clock-drift"`
+	// HealthMaxApplyLag is the maximum number of committed entries not
+	// applied yet for /readyz to succeed. Zero disables the check.
+	HealthMaxApplyLag uint64 `json:"health-max-apply-lag"`
+	// HealthMaxWALFsyncDuration is the maximum 99th percentile of the WAL
+	// fsync durations of the last minute, and duration of an fsync in
+	// progress, for /readyz to succeed. Zero disables the check.
+	HealthMaxWALFsyncDuration time.Duration `json:"health-max-wal-fsync-duration"`
+	// GRPCRateLimit is the number of gRPC requests per second admitted per
+	// client: per user if authenticated, or else per client certificate or
+	// address. Zero disables the rate limit.
+	GRPCRateLimit float64 `json:"grpc-rate-limit"`
+	// GRPCRateLimitBurst is the number of gRPC requests a client can send at
+	// once under GRPCRateLimit.
+	GRPCRateLimitBurst int `json:"grpc-rate-limit-burst"`
+
+	// AuditLogPath is the file the audit events of the mutating, auth,
+	// cluster and maintenance requests are written to, as JSON lines.
+	// Auditing is disabled if empty, unless AuditWriter is set.
+	AuditLogPath string `json:"audit-log-path"`
+	// AuditLogLevel is the level of the audited requests: "none", "metadata"
+	// or "request", which also records the values.
+	AuditLogLevel string `json:"audit-log-level"`
+	// AuditLogPolicy is a comma separated list of <prefix>=<level> overriding
+	// AuditLogLevel for the keys with the longest matching prefix. A key range
+	// is audited at the most detailed level of the keys in it.
+	AuditLogPolicy string `json:"audit-log-policy"`
+	// AuditLogMaxSize is the size in megabytes at which the audit log file
+	// is rotated.
+	AuditLogMaxSize int `json:"audit-log-max-size"`
+	// AuditLogMaxBackups is the number of rotated audit log files to keep.
+	// Zero keeps all.
+	AuditLogMaxBackups int `json:"audit-log-max-backups"`
+	// AuditLogMaxAge is the number of days to keep the rotated audit log
+	// files. Zero keeps them regardless of their age.
+	AuditLogMaxAge int `json:"audit-log-max-age"`
+	// AuditWriter is the writer of the audit events. It takes precedence over
+	// AuditLogPath.
+	AuditWriter audit.Writer `json:"-"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster           bool   `json:"force-new-cluster"`
@@ -555,6 +671,9 @@ type Config struct {
 	// Do not set logger directly.
 	loggerMu *sync.RWMutex
 	logger   *zap.Logger
+	// logLevel is the level of the logger built by "setupLogging", changed by
+	// the configuration reloads. It is nil if ZapLoggerBuilder is set.
+	logLevel *zap.AtomicLevel
 	// EnableGRPCGateway enables grpc gateway.
 	// The gateway translates a RESTful HTTP API into gRPC.
 	EnableGRPCGateway bool `json:"enable-grpc-gateway"`
@@ -651,6 +770,8 @@ func NewConfig() *Config {
 		MaxSnapFiles: DefaultMaxSnapshots,
 		MaxWalFiles:  DefaultMaxWALs,
 
+		WALArchiveTimeout: DefaultWALArchiveTimeout,
+
 		Name: DefaultName,
 
 		SnapshotCount:                      etcdserver.DefaultSnapshotCount,
@@ -694,6 +815,7 @@ func NewConfig() *Config {
 		AuthTokenTTL:           300,
 		SelfSignedCertValidity: DefaultSelfSignedCertValidity,
 		TlsMinVersion:          DefaultTLSMinVersion,
+		TLSReloadInterval:      DefaultTLSReloadInterval,
 
 		PreVote: true,
 
@@ -714,6 +836,13 @@ func NewConfig() *Config {
 		ExperimentalMemoryMlock:             false,
 		ExperimentalStopGRPCServiceOnDefrag: false,
 		MaxLearners:                         membership.DefaultMaxLearners,
+		LearnerAutoPromoteMaxLag:            DefaultLearnerAutoPromoteMaxLag,
+		LearnerAutoPromoteDuration:          DefaultLearnerAutoPromoteDuration,
+		ReadIndexMode:                       DefaultReadIndexMode,
+		LeaseReadMaxClockDrift:              DefaultLeaseReadMaxClockDrift,
+		GRPCRateLimitBurst:                  DefaultGRPCRateLimitBurst,
+		AuditLogLevel:                       DefaultAuditLogLevel,
+		AuditLogMaxSize:                     DefaultAuditLogMaxSize,
 
 		ExperimentalTxnModeWriteWithSharedBuffer:  DefaultExperimentalTxnModeWriteWithSharedBuffer,
 		ExperimentalDistributedTracingAddress:     DefaultDistributedTracingAddress,
@@ -774,6 +903,9 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	)
 	fs.UintVar(&cfg.MaxSnapFiles, "max-snapshots", cfg.MaxSnapFiles, "Maximum number of snapshot files to retain (0 is unlimited). Deprecated in v3.6 and will be decommissioned in v3.7.")
 	fs.UintVar(&cfg.MaxWalFiles, "max-wals", cfg.MaxWalFiles, "Maximum number of wal files to retain (0 is unlimited).")
+	fs.StringVar(&cfg.WALArchiveURL, "wal-archive-url", cfg.WALArchiveURL, "Directory or http(s) URL to copy finished WAL segments and snapshot files to. WAL and snapshot files are not purged until they are archived.")
+	fs.DurationVar(&cfg.WALArchiveTimeout, "wal-archive-timeout", cfg.WALArchiveTimeout, "Timeout of a request to an http(s) --wal-archive-url, including the upload of a WAL segment.")
+	fs.StringVar(&cfg.EncryptionKeyFile, "encryption-key-file", cfg.EncryptionKeyFile, "Path to the key encryption keys wrapping the data encryption keys of the stored values. The first key wraps the new data encryption keys. Values are stored in plaintext if empty. WAL entries are not encrypted.")
 	fs.StringVar(&cfg.Name, "name", cfg.Name, "Human-readable name for this member.")
 	fs.Uint64Var(&cfg.SnapshotCount, "snapshot-count", cfg.SnapshotCount, "Number of committed transactions to trigger a snapshot to disk. Deprecated in v3.6 and will be decommissioned in v3.7.")
 	fs.UintVar(&cfg.TickMs, "heartbeat-interval", cfg.TickMs, "Time (in milliseconds) of a heartbeat interval.")
@@ -865,6 +997,7 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&cfg.PeerTLSInfo.SkipClientSANVerify, "peer-skip-client-san-verification", false, "Skip verification of SAN field in client certificate for peer connections.")
 	fs.StringVar(&cfg.TlsMinVersion, "tls-min-version", string(tlsutil.TLSVersion12), "Minimum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3.")
 	fs.StringVar(&cfg.TlsMaxVersion, "tls-max-version", string(tlsutil.TLSVersionDefault), "Maximum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3 (empty defers to Go).")
+	fs.DurationVar(&cfg.TLSReloadInterval, "tls-reload-interval", cfg.TLSReloadInterval, "Interval at which the client and peer TLS files, including the trusted CA and CRL files, are checked and reloaded if changed (0 to only reload on SIGHUP).")
 
 	fs.Var(
 		flags.NewUniqueURLsWithExceptions("*", "*"),
@@ -882,7 +1015,8 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&cfg.LogRotationConfigJSON, "log-rotation-config-json", DefaultLogRotationConfig, "Configures log rotation if enabled with a JSON logger config. Default: MaxSize=100(MB), MaxAge=0(days,no limit), MaxBackups=0(no limit), LocalTime=false(UTC), Compress=false(gzip)")
 
 	fs.StringVar(&cfg.AutoCompactionRetention, "auto-compaction-retention", "0", "Auto compaction retention for mvcc key value store. 0 means disable auto compaction.")
-	fs.StringVar(&cfg.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.")
+	fs.Int64Var(&cfg.AutoCompactionTargetBackendBytes, "auto-compaction-target-backend-bytes", cfg.AutoCompactionTargetBackendBytes, "Backend size (in bytes) targeted by the 'adaptive' auto compaction mode. Set to 0 to target 80% of the backend quota.")
+	fs.StringVar(&cfg.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision|adaptive. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention. 'adaptive' for retention fitting 'auto-compaction-target-backend-bytes', with a duration based minimum retention.")
 
 	// pprof profiler via HTTP
 	fs.BoolVar(&cfg.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\"")
@@ -954,6 +1088,20 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.UintVar(&cfg.BootstrapDefragThresholdMegabytes, "bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
 	// TODO: delete in v3.7
 	fs.IntVar(&cfg.MaxLearners, "max-learners", membership.DefaultMaxLearners, "Sets the maximum number of learners that can be available in the cluster membership.")
+	fs.Uint64Var(&cfg.LearnerAutoPromoteMaxLag, "learner-auto-promote-max-lag", cfg.LearnerAutoPromoteMaxLag, "Maximum number of entries a learner can be behind the leader to be considered caught up for auto-promotion. Requires the LearnerAutoPromotion feature gate.")
+	fs.DurationVar(&cfg.LearnerAutoPromoteDuration, "learner-auto-promote-duration", cfg.LearnerAutoPromoteDuration, "Duration a learner must stay caught up with the leader before it is promoted automatically. Requires the LearnerAutoPromotion feature gate.")
+	fs.StringVar(&cfg.ReadIndexMode, "read-index-mode", cfg.ReadIndexMode, "How linearizable reads are confirmed: 'safe' (a quorum round per read) or 'lease' (the leader lease, without a quorum round; followers still get the read index from the leader).")
+	fs.DurationVar(&cfg.LeaseReadMaxClockDrift, "lease-read-max-clock-drift", cfg.LeaseReadMaxClockDrift, "Maximum delay of a tick of the leader tolerated by the 'lease' read index mode. A leader whose tick is delayed more does not confirm reads with its lease for an election timeout.")
+	fs.Uint64Var(&cfg.HealthMaxApplyLag, "health-max-apply-lag", cfg.HealthMaxApplyLag, "Maximum number of committed entries not applied yet for /readyz to succeed. 0 disables the check.")
+	fs.DurationVar(&cfg.HealthMaxWALFsyncDuration, "health-max-wal-fsync-duration", cfg.HealthMaxWALFsyncDuration, "Maximum 99th percentile of the WAL fsync durations of the last minute, and duration of an fsync in progress, for /readyz to succeed. 0 disables the check.")
+	fs.Float64Var(&cfg.GRPCRateLimit, "grpc-rate-limit", cfg.GRPCRateLimit, "Number of gRPC requests per second admitted per user, or per client certificate or address if unauthenticated. Lease, auth and status requests have a separate bucket of 4 times the rate and burst, bulk ranges only use the burst above half of it. 0 disables the rate limit.")
+	fs.IntVar(&cfg.GRPCRateLimitBurst, "grpc-rate-limit-burst", cfg.GRPCRateLimitBurst, "Number of gRPC requests a client can send at once under --grpc-rate-limit.")
+	fs.StringVar(&cfg.AuditLogPath, "audit-log-path", cfg.AuditLogPath, "File the audit events of the mutating, auth, cluster and maintenance requests are written to, as JSON lines. Auditing is disabled if empty.")
+	fs.StringVar(&cfg.AuditLogLevel, "audit-log-level", cfg.AuditLogLevel, "Level of the audited requests: 'none', 'metadata' or 'request', which also records the values.")
+	fs.StringVar(&cfg.AuditLogPolicy, "audit-log-policy", cfg.AuditLogPolicy, "Comma separated list of <prefix>=<level> overriding --audit-log-level for the keys with the longest matching prefix, e.g. '/secrets/=none,/config/=request'. A key range is audited at the most detailed level of the keys in it.")
+	fs.IntVar(&cfg.AuditLogMaxSize, "audit-log-max-size", cfg.AuditLogMaxSize, "Size in megabytes at which the audit log file is rotated.")
+	fs.IntVar(&cfg.AuditLogMaxBackups, "audit-log-max-backups", cfg.AuditLogMaxBackups, "Number of rotated audit log files to keep. 0 keeps all.")
+	fs.IntVar(&cfg.AuditLogMaxAge, "audit-log-max-age", cfg.AuditLogMaxAge, "Number of days to keep the rotated audit log files. 0 keeps them regardless of their age.")
 	fs.Uint64Var(&cfg.ExperimentalSnapshotCatchUpEntries, "experimental-snapshot-catchup-entries", cfg.ExperimentalSnapshotCatchUpEntries, "Number of entries for a slow follower to catch up after compacting the raft storage entries. Deprecated in v3.6 and will be decommissioned in v3.7. Use --snapshot-catchup-entries instead.")
 	fs.Uint64Var(&cfg.SnapshotCatchUpEntries, "snapshot-catchup-entries", cfg.SnapshotCatchUpEntries, "Number of entries for a slow follower to catch up after compacting the raft storage entries.")
 
@@ -974,6 +1122,18 @@ func ConfigFromFile(path string) (*Config, error) {
 	return &cfg.Config, nil
 }
 
+// ConfigFromFileWithLogger is ConfigFromFile, except that the configuration
+// logs with lg instead of building a new logger, e.g. to reload the
+// configuration file of a running server.
+func ConfigFromFileWithLogger(path string, lg *zap.Logger) (*Config, error) {
+	cfg := &configYAML{Config: *NewConfig()}
+	cfg.ZapLoggerBuilder = NewZapLoggerBuilder(lg)
+	if err := cfg.configFromFile(path); err != nil {
+		return nil, err
+	}
+	return &cfg.Config, nil
+}
+
 func (cfg *configYAML) configFromFile(path string) error {
 	b, err := os.ReadFile(path)
 	if err != nil {
@@ -1040,8 +1200,7 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.configJSON.ListenPeerURLs != "" {
 		u, err := types.NewURLs(strings.Split(cfg.configJSON.ListenPeerURLs, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up listen-peer-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up listen-peer-urls: %w", err)
 		}
 		cfg.Config.ListenPeerUrls = u
 	}
@@ -1049,8 +1208,7 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.configJSON.ListenClientURLs != "" {
 		u, err := types.NewURLs(strings.Split(cfg.configJSON.ListenClientURLs, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up listen-client-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up listen-client-urls: %w", err)
 		}
 		cfg.Config.ListenClientUrls = u
 	}
@@ -1058,8 +1216,7 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.configJSON.ListenClientHTTPURLs != "" {
 		u, err := types.NewURLs(strings.Split(cfg.configJSON.ListenClientHTTPURLs, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up listen-client-http-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up listen-client-http-urls: %w", err)
 		}
 		cfg.Config.ListenClientHttpUrls = u
 	}
@@ -1067,8 +1224,7 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.configJSON.AdvertisePeerURLs != "" {
 		u, err := types.NewURLs(strings.Split(cfg.configJSON.AdvertisePeerURLs, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up initial-advertise-peer-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up initial-advertise-peer-urls: %w", err)
 		}
 		cfg.Config.AdvertisePeerUrls = u
 	}
@@ -1076,8 +1232,7 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.configJSON.AdvertiseClientURLs != "" {
 		u, err := types.NewURLs(strings.Split(cfg.configJSON.AdvertiseClientURLs, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up advertise-peer-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up advertise-peer-urls: %w", err)
 		}
 		cfg.Config.AdvertiseClientUrls = u
 	}
@@ -1085,14 +1240,16 @@ func (cfg *configYAML) configFromFile(path string) error {
 	if cfg.ListenMetricsUrlsJSON != "" {
 		u, err := types.NewURLs(strings.Split(cfg.ListenMetricsUrlsJSON, ","))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "unexpected error setting up listen-metrics-urls: %v\n", err)
-			os.Exit(1)
+			return fmt.Errorf("unexpected error setting up listen-metrics-urls: %w", err)
 		}
 		cfg.ListenMetricsUrls = u
 	}
 
 	if cfg.CORSJSON != "" {
-		uv := flags.NewUniqueURLsWithExceptions(cfg.CORSJSON, "*")
+		uv := flags.NewUniqueURLsWithExceptions("", "*")
+		if err = uv.Set(cfg.CORSJSON); err != nil {
+			return fmt.Errorf("unexpected error setting up cors: %w", err)
+		}
 		cfg.CORS = uv.Values
 	}
 
@@ -1285,6 +1442,13 @@ func (cfg *Config) Validate() error {
 
 	switch cfg.AutoCompactionMode {
 	case CompactorModeRevision, CompactorModePeriodic:
+	case CompactorModeAdaptive:
+		if cfg.AutoCompactionTargetBackendBytes < 0 {
+			return fmt.Errorf("--auto-compaction-target-backend-bytes[%d] must not be negative", cfg.AutoCompactionTargetBackendBytes)
+		}
+		if cfg.AutoCompactionTargetBackendBytes == 0 && cfg.QuotaBackendBytes < 0 {
+			return errors.New("--auto-compaction-target-backend-bytes must be set with the 'adaptive' auto-compaction-mode when the backend quota is disabled")
+		}
 	case "":
 		return errors.New("undefined auto-compaction-mode")
 	default:
@@ -1312,6 +1476,52 @@ func (cfg *Config) Validate() error {
 	if cfg.CompactHashCheckTime <= 0 {
 		return fmt.Errorf("--compact-hash-check-time must be >0 (set to %v)", cfg.CompactHashCheckTime)
 	}
+	if cfg.ServerFeatureGate.Enabled(features.LearnerAutoPromotion) && cfg.LearnerAutoPromoteDuration <= 0 {
+		return fmt.Errorf("--learner-auto-promote-duration must be >0 (set to %v)", cfg.LearnerAutoPromoteDuration)
+	}
+	switch cfg.ReadIndexMode {
+	case ReadIndexModeSafe:
+	case ReadIndexModeLease:
+		if cfg.LeaseReadMaxClockDrift < 0 || cfg.LeaseReadMaxClockDrift >= time.Duration(cfg.ElectionMs-cfg.TickMs)*time.Millisecond {
+			return fmt.Errorf("--lease-read-max-clock-drift[%v] must be >=0 and less than --election-timeout[%vms] minus --heartbeat-interval[%vms]", cfg.LeaseReadMaxClockDrift, cfg.ElectionMs, cfg.TickMs)
+		}
+	default:
+		return fmt.Errorf("unknown --read-index-mode %q", cfg.ReadIndexMode)
+	}
+	if cfg.GRPCRateLimit < 0 {
+		return fmt.Errorf("--grpc-rate-limit must be >=0 (set to %v)", cfg.GRPCRateLimit)
+	}
+	if cfg.GRPCRateLimit > 0 && cfg.GRPCRateLimitBurst < 1 {
+		return fmt.Errorf("--grpc-rate-limit-burst must be >=1 (set to %v)", cfg.GRPCRateLimitBurst)
+	}
+	if _, err := cfg.auditPolicy(); err != nil {
+		return fmt.Errorf("invalid audit policy: %w", err)
+	}
+	if cfg.AuditLogMaxSize < 0 || cfg.AuditLogMaxBackups < 0 || cfg.AuditLogMaxAge < 0 {
+		return fmt.Errorf("--audit-log-max-size, --audit-log-max-backups and --audit-log-max-age must be >=0")
+	}
+	if cfg.TLSReloadInterval < 0 {
+		return fmt.Errorf("--tls-reload-interval must be >=0 (set to %v)", cfg.TLSReloadInterval)
+	}
+	if cfg.HealthMaxWALFsyncDuration < 0 {
+		return fmt.Errorf("--health-max-wal-fsync-duration must be >=0 (set to %v)", cfg.HealthMaxWALFsyncDuration)
+	}
+	for _, checks := range []map[string]etcdhttp.HealthCheck{cfg.LivezChecks, cfg.ReadyzChecks} {
+		for name, check := range checks {
+			if name == "" || strings.Contains(name, "/") || check == nil {
+				return fmt.Errorf("invalid health check %q: the name must be non-empty without '/' and the check non-nil", name)
+			}
+		}
+	}
+	if cfg.WALArchiveURL != "" && cfg.WALArchiveTimeout <= 0 {
+		return fmt.Errorf("--wal-archive-timeout must be >0 (set to %v)", cfg.WALArchiveTimeout)
+	}
+	if _, err := cfg.walArchiveSink(); err != nil {
+		return fmt.Errorf("--wal-archive-url is not valid: %w", err)
+	}
+	if _, err := cfg.encryptionKMS(); err != nil {
+		return fmt.Errorf("--encryption-key-file is not valid: %w", err)
+	}
 
 	// If `--name` isn't configured, then multiple members may have the same "default" name.
 	// When adding a new member with the "default" name as well, etcd may regards its peerURL
@@ -1347,6 +1557,34 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// walArchiveSink returns the WAL archive sink of the configuration, or nil if
+// archiving is disabled.
+func (cfg *Config) walArchiveSink() (walarchive.Sink, error) {
+	if cfg.WALArchiveSink != nil || cfg.WALArchiveURL == "" {
+		return cfg.WALArchiveSink, nil
+	}
+	return walarchive.NewSink(cfg.WALArchiveURL, cfg.WALArchiveTimeout)
+}
+
+// encryptionKMS returns the KMS wrapping the data encryption keys, or nil if
+// the values are stored in plaintext.
+func (cfg *Config) encryptionKMS() (encryption.KMS, error) {
+	if cfg.EncryptionKMS != nil || cfg.EncryptionKeyFile == "" {
+		return cfg.EncryptionKMS, nil
+	}
+	return encryption.NewLocalKMS(cfg.EncryptionKeyFile)
+}
+
+// auditPolicy returns the audit policy of the configuration.
+func (cfg *Config) auditPolicy() (*audit.Policy, error) {
+	return audit.ParsePolicy(cfg.AuditLogLevel, cfg.AuditLogPolicy)
+}
+
+// healthChecks returns the users' health checks of the configuration.
+func (cfg *Config) healthChecks() etcdhttp.HealthChecks {
+	return etcdhttp.HealthChecks{Livez: cfg.LivezChecks, Readyz: cfg.ReadyzChecks}
+}
+
 // PeerURLsMapAndToken sets up an initial peer URLsMap and cluster token for bootstrap or discovery.
 func (cfg *Config) PeerURLsMapAndToken(which string) (urlsmap types.URLsMap, token string, err error) {
 	token = cfg.InitialClusterToken
@@ -118,6 +118,7 @@ func (cfg *Config) setupLogging() error {
 					return err
 				}
 				cfg.ZapLoggerBuilder = NewZapLoggerBuilder(lg)
+				cfg.logLevel = &copied.Level
 			}
 		} else {
 			if len(cfg.LogOutputs) > 1 {
@@ -157,6 +158,7 @@ func (cfg *Config) setupLogging() error {
 			)
 			if cfg.ZapLoggerBuilder == nil {
 				cfg.ZapLoggerBuilder = NewZapLoggerBuilder(zap.New(cr, zap.AddCaller(), zap.ErrorOutput(syncer)))
+				cfg.logLevel = &lvl
 			}
 		}
 
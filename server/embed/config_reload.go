@@ -0,0 +1,217 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/logutil"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// reloadableFields are the fields of Config applied by ReloadConfig. The
+// experimental warning apply duration is applied as the warning apply
+// duration set from it by the ConfigLoader.
+var reloadableFields = map[string]bool{
+	"LogLevel":                         true,
+	"MaxRequestBytes":                  true,
+	"WarningApplyDuration":             true,
+	"ExperimentalWarningApplyDuration": true,
+	"QuotaBackendBytes":                true,
+	"AutoCompactionMode":               true,
+	"AutoCompactionRetention":          true,
+	"AutoCompactionTargetBackendBytes": true,
+	"CORS":                             true,
+	"HostWhitelist":                    true,
+}
+
+// configFieldNames are the names of the fields of Config without a JSON name,
+// as set in the configuration file.
+var configFieldNames = map[string]string{
+	"ListenPeerUrls":       "listen-peer-urls",
+	"ListenClientUrls":     "listen-client-urls",
+	"ListenClientHttpUrls": "listen-client-http-urls",
+	"AdvertisePeerUrls":    "initial-advertise-peer-urls",
+	"AdvertiseClientUrls":  "advertise-client-urls",
+	"ListenMetricsUrls":    "listen-metrics-urls",
+	"ClientTLSInfo":        "client-transport-security",
+	"ClientAutoTLS":        "client-transport-security.auto-tls",
+	"PeerTLSInfo":          "peer-transport-security",
+	"PeerAutoTLS":          "peer-transport-security.auto-tls",
+	"CORS":                 "cors",
+	"HostWhitelist":        "host-whitelist",
+	"ServerFeatureGate":    "feature-gates",
+}
+
+// ignoredConfigFields are the fields of Config which are not loaded from the
+// configuration file.
+var ignoredConfigFields = map[string]bool{
+	"FlagsExplicitlySet": true,
+	"UserHandlers":       true,
+	"LivezChecks":        true,
+	"ReadyzChecks":       true,
+	"AuditWriter":        true,
+	"WALArchiveSink":     true,
+	"EncryptionKMS":      true,
+}
+
+// ReloadConfig loads the configuration with the ConfigLoader of the server
+// configuration, and applies the changes of the log level, the maximum request
+// size, the warning apply duration, the backend quota, the auto compaction
+// settings and the CORS and host whitelists. It returns the names of the
+// changed fields applied, and of the changed fields which need a restart.
+//
+// The maximum request size cannot be raised above the size the server started
+// with, as it also limits the size of the messages received by the gRPC
+// servers.
+func (e *Etcd) ReloadConfig() (applied, restartRequired []string, err error) {
+	if e.cfg.ConfigLoader == nil {
+		return nil, nil, errors.ErrConfigReloadNotSupported
+	}
+	lg := e.GetLogger()
+	applied, restartRequired, err = e.reloadConfig()
+	if err != nil {
+		lg.Warn("failed to reload configuration", zap.Error(err))
+		return nil, nil, err
+	}
+	lg.Info(
+		"reloaded configuration",
+		zap.Strings("applied-fields", applied),
+		zap.Strings("restart-required-fields", restartRequired),
+	)
+	return applied, restartRequired, nil
+}
+
+func (e *Etcd) reloadConfig() (applied, restartRequired []string, err error) {
+	e.reloadMu.Lock()
+	defer e.reloadMu.Unlock()
+
+	cfg, err := e.cfg.ConfigLoader()
+	if err != nil {
+		return nil, nil, err
+	}
+	// the fields are compared with the last loaded configuration, as the
+	// configuration of the server is completed at startup.
+	next := *e.loadedCfg
+	changed := false
+	nextv, cfgv := reflect.ValueOf(&next).Elem(), reflect.ValueOf(cfg).Elem()
+	for _, f := range reflect.VisibleFields(nextv.Type()) {
+		if !f.IsExported() || len(f.Index) > 1 || ignoredConfigFields[f.Name] || f.Type.Kind() == reflect.Func {
+			continue
+		}
+		if configFieldEqual(f.Name, nextv.FieldByIndex(f.Index).Interface(), cfgv.FieldByIndex(f.Index).Interface()) {
+			continue
+		}
+		switch {
+		case !reloadableFields[f.Name]:
+			restartRequired = append(restartRequired, configFieldName(f))
+		case f.Name == "MaxRequestBytes" && cfg.MaxRequestBytes > e.Server.Cfg.MaxRequestBytes:
+			restartRequired = append(restartRequired, configFieldName(f))
+		case f.Name == "LogLevel" && e.cfg.logLevel == nil:
+			// the logger is built by a custom ZapLoggerBuilder.
+			restartRequired = append(restartRequired, configFieldName(f))
+		default:
+			nextv.FieldByIndex(f.Index).Set(cfgv.FieldByIndex(f.Index))
+			changed = true
+			applied = append(applied, configFieldName(f))
+		}
+	}
+	if !changed {
+		return applied, restartRequired, nil
+	}
+
+	rc, err := runtimeConfig(&next)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = e.Server.UpdateRuntimeConfig(rc); err != nil {
+		return nil, nil, err
+	}
+	if e.cfg.logLevel != nil {
+		e.cfg.logLevel.SetLevel(logutil.ConvertToZapLevel(next.LogLevel))
+	}
+	e.loadedCfg = &next
+	return applied, restartRequired, nil
+}
+
+// runtimeConfig returns the runtime configuration of the server set by cfg.
+func runtimeConfig(cfg *Config) (etcdserver.RuntimeConfig, error) {
+	// AutoCompactionRetention defaults to "0" if not set.
+	retention := cfg.AutoCompactionRetention
+	if len(retention) == 0 {
+		retention = "0"
+	}
+	autoCompactionRetention, err := parseCompactionRetention(cfg.AutoCompactionMode, retention)
+	if err != nil {
+		return etcdserver.RuntimeConfig{}, err
+	}
+	return etcdserver.RuntimeConfig{
+		MaxRequestBytes:                  cfg.MaxRequestBytes,
+		WarningApplyDuration:             cfg.WarningApplyDuration,
+		QuotaBackendBytes:                cfg.QuotaBackendBytes,
+		AutoCompactionMode:               cfg.AutoCompactionMode,
+		AutoCompactionRetention:          autoCompactionRetention,
+		AutoCompactionTargetBackendBytes: cfg.AutoCompactionTargetBackendBytes,
+		CORS:                             cfg.CORS,
+		HostWhitelist:                    cfg.HostWhitelist,
+	}, nil
+}
+
+// configFieldName returns the name of the field of Config in the
+// configuration file.
+func configFieldName(f reflect.StructField) string {
+	if name, ok := configFieldNames[f.Name]; ok {
+		return name
+	}
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return f.Name
+}
+
+// configFieldEqual returns whether the values of the field name of two loaded
+// configurations are equal.
+func configFieldEqual(name string, a, b any) bool {
+	switch name {
+	case "ClientTLSInfo", "PeerTLSInfo":
+		return reflect.DeepEqual(loadedTLSInfo(a.(transport.TLSInfo)), loadedTLSInfo(b.(transport.TLSInfo)))
+	case "ServerFeatureGate":
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// loadedTLSInfo returns the settings of info loaded from the configuration
+// file.
+func loadedTLSInfo(info transport.TLSInfo) transport.TLSInfo {
+	return transport.TLSInfo{
+		CertFile:            info.CertFile,
+		KeyFile:             info.KeyFile,
+		ClientCertFile:      info.ClientCertFile,
+		ClientKeyFile:       info.ClientKeyFile,
+		TrustedCAFile:       info.TrustedCAFile,
+		ClientCertAuth:      info.ClientCertAuth,
+		CRLFile:             info.CRLFile,
+		SkipClientSANVerify: info.SkipClientSANVerify,
+		AllowedCNs:          info.AllowedCNs,
+		AllowedHostnames:    info.AllowedHostnames,
+	}
+}
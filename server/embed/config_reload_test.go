@@ -0,0 +1,102 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+func TestReloadConfig(t *testing.T) {
+	tdir := t.TempDir()
+	path := filepath.Join(tdir, "etcd.conf.yml")
+	writeConfig := func(extra string) {
+		t.Helper()
+		b := fmt.Sprintf("data-dir: %s\nlog-level: info\nmax-request-bytes: 1000000\n%s", filepath.Join(tdir, "data"), extra)
+		require.NoError(t, os.WriteFile(path, []byte(b), 0o600))
+	}
+	writeConfig("")
+
+	cfg, err := ConfigFromFile(path)
+	require.NoError(t, err)
+	cfg.ConfigLoader = func() (*Config, error) {
+		return ConfigFromFileWithLogger(path, zap.NewNop())
+	}
+	e, err := StartEtcd(cfg)
+	require.NoError(t, err)
+	defer e.Close()
+	<-e.Server.ReadyNotify()
+
+	applied, restartRequired, err := e.ReloadConfig()
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Empty(t, restartRequired)
+
+	writeConfig(`log-level: debug
+max-request-bytes: 500000
+warning-apply-duration: 1000000000
+quota-backend-bytes: 1073741824
+auto-compaction-mode: revision
+auto-compaction-retention: "1000"
+cors: http://example.com:80
+snapshot-count: 1234
+`)
+	applied, restartRequired, err = e.ReloadConfig()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"log-level", "max-request-bytes", "warning-apply-duration", "quota-backend-bytes", "auto-compaction-mode", "auto-compaction-retention", "cors"}, applied)
+	assert.Equal(t, []string{"snapshot-count"}, restartRequired)
+
+	assert.True(t, e.GetLogger().Core().Enabled(zap.DebugLevel))
+	assert.Equal(t, uint(500000), e.Server.MaxRequestBytes())
+	assert.Equal(t, time.Second, e.Server.WarningApplyDuration())
+	assert.Equal(t, int64(1073741824), e.Server.QuotaBackendBytes())
+	assert.True(t, e.Server.AccessController.OriginAllowed("http://example.com:80"))
+	assert.False(t, e.Server.AccessController.OriginAllowed("http://example.org"))
+
+	// the maximum request size cannot be raised above the size the server
+	// started with.
+	writeConfig("max-request-bytes: 2000000\n")
+	applied, restartRequired, err = e.ReloadConfig()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"log-level", "warning-apply-duration", "quota-backend-bytes", "auto-compaction-mode", "auto-compaction-retention", "cors"}, applied)
+	assert.Equal(t, []string{"max-request-bytes"}, restartRequired)
+	assert.False(t, e.GetLogger().Core().Enabled(zap.DebugLevel))
+	assert.Equal(t, uint(500000), e.Server.MaxRequestBytes())
+	assert.True(t, e.Server.AccessController.OriginAllowed("http://example.org"))
+
+	writeConfig("auto-compaction-retention: invalid\n")
+	_, _, err = e.ReloadConfig()
+	require.Error(t, err)
+}
+
+func TestReloadConfigNotSupported(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Dir = t.TempDir()
+	e, err := StartEtcd(cfg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	_, _, err = e.ReloadConfig()
+	require.ErrorIs(t, err, errors.ErrConfigReloadNotSupported)
+}
@@ -15,6 +15,7 @@
 package embed
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"flag"
@@ -37,6 +38,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/featuregate"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/features"
 )
@@ -796,6 +798,99 @@ func TestLeaseCheckpointValidate(t *testing.T) {
 	}
 }
 
+func TestReadIndexModeValidate(t *testing.T) {
+	tcs := []struct {
+		name          string
+		readIndexMode string
+		maxClockDrift time.Duration
+		expectError   bool
+	}{
+		{
+			name:          "Safe mode should pass",
+			readIndexMode: ReadIndexModeSafe,
+		},
+		{
+			name:          "Lease mode should pass",
+			readIndexMode: ReadIndexModeLease,
+			maxClockDrift: DefaultLeaseReadMaxClockDrift,
+		},
+		{
+			name:          "Lease mode with a clock drift longer than the election timeout should fail",
+			readIndexMode: ReadIndexModeLease,
+			maxClockDrift: time.Second,
+			expectError:   true,
+		},
+		{
+			name:          "Lease mode with a negative clock drift should fail",
+			readIndexMode: ReadIndexModeLease,
+			maxClockDrift: -time.Millisecond,
+			expectError:   true,
+		},
+		{
+			name:          "Unknown mode should fail",
+			readIndexMode: "unknown",
+			expectError:   true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *NewConfig()
+			cfg.ReadIndexMode = tc.readIndexMode
+			cfg.LeaseReadMaxClockDrift = tc.maxClockDrift
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestHealthChecksValidate(t *testing.T) {
+	check := func(context.Context) error { return nil }
+	tcs := []struct {
+		name         string
+		readyzChecks map[string]etcdhttp.HealthCheck
+		maxFsync     time.Duration
+		expectError  bool
+	}{
+		{
+			name:         "Named check should pass",
+			readyzChecks: map[string]etcdhttp.HealthCheck{"traffic": check},
+		},
+		{
+			name:         "Check with an empty name should fail",
+			readyzChecks: map[string]etcdhttp.HealthCheck{"": check},
+			expectError:  true,
+		},
+		{
+			name:         "Check with a sub path name should fail",
+			readyzChecks: map[string]etcdhttp.HealthCheck{"traffic/routed": check},
+			expectError:  true,
+		},
+		{
+			name:         "Nil check should fail",
+			readyzChecks: map[string]etcdhttp.HealthCheck{"traffic": nil},
+			expectError:  true,
+		},
+		{
+			name:        "Negative WAL fsync duration should fail",
+			maxFsync:    -time.Millisecond,
+			expectError: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *NewConfig()
+			cfg.ReadyzChecks = tc.readyzChecks
+			cfg.HealthMaxWALFsyncDuration = tc.maxFsync
+			err := cfg.Validate()
+			if (err != nil) != tc.expectError {
+				t.Errorf("config.Validate() = %q, expected error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
 func TestLogRotation(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -43,6 +43,7 @@ import (
 	"go.etcd.io/etcd/client/v3/credentials"
 	"go.etcd.io/etcd/pkg/v3/debugutil"
 	runtimeutil "go.etcd.io/etcd/pkg/v3/runtime"
+	"go.etcd.io/etcd/server/v3/audit"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
@@ -76,10 +77,19 @@ type Etcd struct {
 
 	tracingExporterShutdown func()
 
+	// auditFile is the audit log file, closed after the servers.
+	auditFile *audit.FileWriter
+
 	Server *etcdserver.EtcdServer
 
 	cfg Config
 
+	// reloadMu serializes the configuration reloads.
+	reloadMu sync.Mutex
+	// loadedCfg is the configuration last loaded by ConfigLoader, with the
+	// changes not applied by the reloads reverted.
+	loadedCfg *Config
+
 	// closeOnce is to ensure `stopc` is closed only once, no matter
 	// how many times the Close() method is called.
 	closeOnce sync.Once
@@ -154,6 +164,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	for _, sctx := range e.sctxs {
 		e.Clients = append(e.Clients, sctx.l)
 	}
+	e.watchTLS()
 
 	var (
 		urlsmap types.URLsMap
@@ -179,6 +190,35 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 
 	backendFreelistType := parseBackendFreelistType(cfg.BackendFreelistType)
 
+	walArchiveSink, err := cfg.walArchiveSink()
+	if err != nil {
+		return e, err
+	}
+
+	encryptionKMS, err := cfg.encryptionKMS()
+	if err != nil {
+		return e, err
+	}
+
+	var auditor *audit.Auditor
+	if cfg.AuditWriter != nil || cfg.AuditLogPath != "" {
+		policy, perr := cfg.auditPolicy()
+		if perr != nil {
+			return e, perr
+		}
+		w := cfg.AuditWriter
+		if w == nil {
+			e.auditFile = audit.NewFileWriter(audit.FileConfig{
+				Path:       cfg.AuditLogPath,
+				MaxSize:    cfg.AuditLogMaxSize,
+				MaxBackups: cfg.AuditLogMaxBackups,
+				MaxAge:     cfg.AuditLogMaxAge,
+			})
+			w = e.auditFile
+		}
+		auditor = audit.New(cfg.GetLogger(), policy, w)
+	}
+
 	srvcfg := config.ServerConfig{
 		Name:                              cfg.Name,
 		ClientURLs:                        cfg.AdvertiseClientUrls,
@@ -189,6 +229,8 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		SnapshotCatchUpEntries:            cfg.SnapshotCatchUpEntries,
 		MaxSnapFiles:                      cfg.MaxSnapFiles,
 		MaxWALFiles:                       cfg.MaxWalFiles,
+		WALArchiveSink:                    walArchiveSink,
+		EncryptionKMS:                     encryptionKMS,
 		InitialPeerURLsMap:                urlsmap,
 		InitialClusterToken:               token,
 		DiscoveryURL:                      cfg.Durl,
@@ -201,6 +243,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		InitialElectionTickAdvance:        cfg.InitialElectionTickAdvance,
 		AutoCompactionRetention:           autoCompactionRetention,
 		AutoCompactionMode:                cfg.AutoCompactionMode,
+		AutoCompactionTargetBackendBytes:  cfg.AutoCompactionTargetBackendBytes,
 		QuotaBackendBytes:                 cfg.QuotaBackendBytes,
 		BackendBatchLimit:                 cfg.BackendBatchLimit,
 		BackendFreelistType:               backendFreelistType,
@@ -234,6 +277,15 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		MemoryMlock:                       cfg.MemoryMlock,
 		BootstrapDefragThresholdMegabytes: cfg.BootstrapDefragThresholdMegabytes,
 		MaxLearners:                       cfg.MaxLearners,
+		LearnerAutoPromoteMaxLag:          cfg.LearnerAutoPromoteMaxLag,
+		LearnerAutoPromoteDuration:        cfg.LearnerAutoPromoteDuration,
+		LeaseRead:                         cfg.ReadIndexMode == ReadIndexModeLease,
+		LeaseReadMaxClockDrift:            cfg.LeaseReadMaxClockDrift,
+		HealthMaxApplyLag:                 cfg.HealthMaxApplyLag,
+		HealthMaxWALFsyncDuration:         cfg.HealthMaxWALFsyncDuration,
+		GRPCRateLimit:                     cfg.GRPCRateLimit,
+		GRPCRateLimitBurst:                cfg.GRPCRateLimitBurst,
+		Auditor:                           auditor,
 		V2Deprecation:                     cfg.V2DeprecationEffective(),
 		ExperimentalLocalAddress:          cfg.InferLocalAddr(),
 		ServerFeatureGate:                 cfg.ServerFeatureGate,
@@ -263,6 +315,12 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
 		return e, err
 	}
+	if cfg.ConfigLoader != nil {
+		if e.loadedCfg, err = cfg.ConfigLoader(); err != nil {
+			return e, err
+		}
+		e.Server.SetConfigReloader(e.ReloadConfig)
+	}
 
 	// buffer channel so goroutines on closed connections won't wait forever
 	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+2*len(e.sctxs))
@@ -342,6 +400,8 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.Bool("initial-election-tick-advance", sc.InitialElectionTickAdvance),
 		zap.Uint64("snapshot-count", sc.SnapshotCount),
 		zap.Uint("max-wals", sc.MaxWALFiles),
+		zap.Bool("wal-archive", sc.WALArchiveSink != nil),
+		zap.Bool("encryption", sc.EncryptionKMS != nil),
 		zap.Uint("max-snapshots", sc.MaxSnapFiles),
 		zap.Uint64("snapshot-catchup-entries", sc.SnapshotCatchUpEntries),
 		zap.Strings("initial-advertise-peer-urls", ec.getAdvertisePeerURLs()),
@@ -367,6 +427,7 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
+		zap.Int64("auto-compaction-target-backend-bytes", sc.AutoCompactionTargetBackendBytes),
 		zap.String("discovery-url", sc.DiscoveryURL),
 		zap.String("discovery-proxy", sc.DiscoveryProxy),
 
@@ -385,6 +446,17 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 
 		zap.String("downgrade-check-interval", sc.DowngradeCheckTime.String()),
 		zap.Int("max-learners", sc.MaxLearners),
+		zap.Uint64("learner-auto-promote-max-lag", sc.LearnerAutoPromoteMaxLag),
+		zap.Duration("learner-auto-promote-duration", sc.LearnerAutoPromoteDuration),
+		zap.Bool("lease-read", sc.LeaseRead),
+		zap.Duration("lease-read-max-clock-drift", sc.LeaseReadMaxClockDrift),
+		zap.Uint64("health-max-apply-lag", sc.HealthMaxApplyLag),
+		zap.Duration("health-max-wal-fsync-duration", sc.HealthMaxWALFsyncDuration),
+		zap.Float64("grpc-rate-limit", sc.GRPCRateLimit),
+		zap.Int("grpc-rate-limit-burst", sc.GRPCRateLimitBurst),
+		zap.String("audit-log-path", ec.AuditLogPath),
+		zap.String("audit-log-level", ec.AuditLogLevel),
+		zap.String("audit-log-policy", ec.AuditLogPolicy),
 
 		zap.String("v2-deprecation", string(ec.V2Deprecation)),
 	)
@@ -488,6 +560,10 @@ func (e *Etcd) Close() {
 		e.wg.Wait()
 		close(e.errc)
 	}
+
+	if e.auditFile != nil {
+		e.auditFile.Close()
+	}
 }
 
 func stopServers(ctx context.Context, ss *servers) {
@@ -548,6 +624,9 @@ func configurePeerListeners(cfg *Config) (peers []*peerListener, err error) {
 			zap.Strings("cipher-suites", cfg.CipherSuites),
 		)
 	}
+	if err = setTLSReloader(cfg.logger, &cfg.PeerTLSInfo, "peer"); err != nil {
+		return nil, err
+	}
 
 	peers = make([]*peerListener, len(cfg.ListenPeerUrls))
 	defer func() {
@@ -647,6 +726,49 @@ func (e *Etcd) servePeers() {
 	}
 }
 
+// setTLSReloader sets the reloader of the TLS configuration of the client or
+// peer listeners, which counts the reloads in the metrics.
+func setTLSReloader(lg *zap.Logger, info *transport.TLSInfo, listener string) (err error) {
+	info.Reloader = nil
+	if info.Empty() {
+		return nil
+	}
+	info.Reloader, err = transport.NewTLSReloader(lg.With(zap.String("listener", listener)), *info, func(err error) {
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		tlsReloadsTotal.WithLabelValues(listener, result).Inc()
+	})
+	return err
+}
+
+// watchTLS reloads the TLS configurations when their files change, until
+// the server is closed.
+func (e *Etcd) watchTLS() {
+	if e.cfg.TLSReloadInterval == 0 {
+		return
+	}
+	for _, r := range []*transport.TLSReloader{e.cfg.PeerTLSInfo.Reloader, e.cfg.ClientTLSInfo.Reloader} {
+		if r != nil {
+			go r.Watch(e.stopc, e.cfg.TLSReloadInterval)
+		}
+	}
+}
+
+// ReloadTLS reloads the client and peer TLS configurations from their files,
+// including the trusted CA and CRL files. The new connections use the new
+// configurations. A configuration is left unchanged if its files are invalid.
+func (e *Etcd) ReloadTLS() error {
+	var errs []error
+	for _, r := range []*transport.TLSReloader{e.cfg.PeerTLSInfo.Reloader, e.cfg.ClientTLSInfo.Reloader} {
+		if r != nil {
+			errs = append(errs, r.Reload())
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err error) {
 	if err = updateCipherSuites(&cfg.ClientTLSInfo, cfg.CipherSuites); err != nil {
 		return nil, err
@@ -655,6 +777,9 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 		cfg.logger.Fatal("failed to get client self-signed certs", zap.Error(err))
 	}
 	updateMinMaxVersions(&cfg.ClientTLSInfo, cfg.TlsMinVersion, cfg.TlsMaxVersion)
+	if err = setTLSReloader(cfg.logger, &cfg.ClientTLSInfo, "client"); err != nil {
+		return nil, err
+	}
 	if cfg.EnablePprof {
 		cfg.logger.Info("pprof is enabled", zap.String("path", debugutil.HTTPPrefixPProf))
 	}
@@ -776,7 +901,7 @@ func (e *Etcd) serveClients() {
 	etcdhttp.HandleDebug(mux)
 	etcdhttp.HandleVersion(mux, e.Server)
 	etcdhttp.HandleMetrics(mux)
-	etcdhttp.HandleHealth(e.cfg.logger, mux, e.Server)
+	etcdhttp.HandleHealthWithChecks(e.cfg.logger, mux, e.Server, e.cfg.healthChecks())
 
 	var gopts []grpc.ServerOption
 	if e.cfg.GRPCKeepAliveMinTime > time.Duration(0) {
@@ -877,7 +1002,7 @@ func (e *Etcd) serveMetrics() (err error) {
 	if len(e.cfg.ListenMetricsUrls) > 0 {
 		metricsMux := http.NewServeMux()
 		etcdhttp.HandleMetrics(metricsMux)
-		etcdhttp.HandleHealth(e.cfg.logger, metricsMux, e.Server)
+		etcdhttp.HandleHealthWithChecks(e.cfg.logger, metricsMux, e.Server, e.cfg.healthChecks())
 
 		for _, murl := range e.cfg.ListenMetricsUrls {
 			u := murl
@@ -937,7 +1062,7 @@ func parseCompactionRetention(mode, retention string) (ret time.Duration, err er
 		switch mode {
 		case CompactorModeRevision:
 			ret = time.Duration(int64(h))
-		case CompactorModePeriodic:
+		case CompactorModePeriodic, CompactorModeAdaptive:
 			ret = time.Duration(int64(h)) * time.Hour
 		case "":
 			return 0, errors.New("--auto-compaction-mode is undefined")
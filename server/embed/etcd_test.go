@@ -16,9 +16,12 @@ package embed
 
 import (
 	"net/url"
+	"os"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 )
@@ -37,3 +40,23 @@ func TestEmptyClientTLSInfo_createMetricsListener(t *testing.T) {
 	_, err := e.createMetricsListener(murl)
 	require.ErrorIsf(t, err, ErrMissingClientTLSInfoForMetricsURL, "expected error %v, got %v", ErrMissingClientTLSInfoForMetricsURL, err)
 }
+
+func TestReloadTLS(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	info, err := transport.SelfCert(lg, t.TempDir(), []string{"127.0.0.1:0"}, 1)
+	require.NoError(t, err)
+	e := &Etcd{cfg: Config{ClientTLSInfo: info}}
+	require.NoError(t, setTLSReloader(lg, &e.cfg.ClientTLSInfo, "client"))
+	require.NotNil(t, e.cfg.ClientTLSInfo.Reloader)
+	require.NoError(t, setTLSReloader(lg, &e.cfg.PeerTLSInfo, "peer"))
+	require.Nil(t, e.cfg.PeerTLSInfo.Reloader)
+
+	success, failure := tlsReloadsTotal.WithLabelValues("client", "success"), tlsReloadsTotal.WithLabelValues("client", "failure")
+	successes, failures := testutil.ToFloat64(success), testutil.ToFloat64(failure)
+	require.NoError(t, e.ReloadTLS())
+	require.Equal(t, successes+1, testutil.ToFloat64(success))
+
+	require.NoError(t, os.WriteFile(info.KeyFile, []byte("invalid"), 0o600))
+	require.Error(t, e.ReloadTLS())
+	require.Equal(t, failures+1, testutil.ToFloat64(failure))
+}
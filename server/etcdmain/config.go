@@ -182,6 +182,33 @@ func (cfg *config) parse(arguments []string) error {
 		err = cfg.configFromCmdLine()
 	}
 
+	if perr = cfg.applyExperimentalFlags(); perr != nil {
+		return perr
+	}
+
+	// Check for deprecated options from both command line and config file
+	var warningsForDeprecatedOpts []string
+	for flagName := range cfg.ec.FlagsExplicitlySet {
+		if msg, ok := deprecatedFlags[flagName]; ok {
+			warningsForDeprecatedOpts = append(warningsForDeprecatedOpts, msg)
+		}
+	}
+
+	// Log warnings if any deprecated options were found
+	if len(warningsForDeprecatedOpts) > 0 {
+		if lg := cfg.ec.GetLogger(); lg != nil {
+			for _, msg := range warningsForDeprecatedOpts {
+				lg.Warn(msg)
+			}
+		}
+	}
+
+	return err
+}
+
+// applyExperimentalFlags sets the options from their deprecated experimental
+// flags, if set.
+func (cfg *config) applyExperimentalFlags() error {
 	// params related to experimental flag deprecation
 	// TODO: delete in v3.7
 	if cfg.ec.FlagsExplicitlySet["experimental-compact-hash-check-time"] {
@@ -250,28 +277,8 @@ func (cfg *config) parse(arguments []string) error {
 	// `V2Deprecation` (--v2-deprecation) is deprecated and scheduled for removal in v3.8. The default value is enforced, ignoring user input.
 	cfg.ec.V2Deprecation = cconfig.V2DeprDefault
 
-	cfg.ec.WarningUnaryRequestDuration, perr = cfg.parseWarningUnaryRequestDuration()
-	if perr != nil {
-		return perr
-	}
-
-	// Check for deprecated options from both command line and config file
-	var warningsForDeprecatedOpts []string
-	for flagName := range cfg.ec.FlagsExplicitlySet {
-		if msg, ok := deprecatedFlags[flagName]; ok {
-			warningsForDeprecatedOpts = append(warningsForDeprecatedOpts, msg)
-		}
-	}
-
-	// Log warnings if any deprecated options were found
-	if len(warningsForDeprecatedOpts) > 0 {
-		if lg := cfg.ec.GetLogger(); lg != nil {
-			for _, msg := range warningsForDeprecatedOpts {
-				lg.Warn(msg)
-			}
-		}
-	}
-
+	var err error
+	cfg.ec.WarningUnaryRequestDuration, err = cfg.parseWarningUnaryRequestDuration()
 	return err
 }
 
@@ -376,6 +383,23 @@ func (cfg *config) configFromFile(path string) error {
 	return nil
 }
 
+// configLoader returns a loader of the configuration file of the server,
+// logging with lg.
+func (cfg *config) configLoader(lg *zap.Logger) func() (*embed.Config, error) {
+	path := cfg.configFile
+	return func() (*embed.Config, error) {
+		eCfg, err := embed.ConfigFromFileWithLogger(path, lg)
+		if err != nil {
+			return nil, err
+		}
+		loaded := &config{ec: *eCfg}
+		if err = loaded.applyExperimentalFlags(); err != nil {
+			return nil, err
+		}
+		return &loaded.ec, nil
+	}
+}
+
 func (cfg *config) validate() error {
 	if cfg.cf.fallback.String() == fallbackFlagProxy {
 		return fmt.Errorf("v2 proxy is deprecated, and --discovery-fallback can't be configured as %q", fallbackFlagProxy)
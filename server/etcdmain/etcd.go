@@ -99,6 +99,10 @@ func startEtcdOrProxyV2(args []string) {
 		)
 	}
 
+	if cfg.configFile != "" {
+		cfg.ec.ConfigLoader = cfg.configLoader(lg)
+	}
+
 	var stopped <-chan struct{}
 	var errc <-chan error
 
@@ -184,6 +188,7 @@ func startEtcdOrProxyV2(args []string) {
 	}
 
 	osutil.HandleInterrupts(lg)
+	osutil.HandleReloads(lg)
 
 	// At this point, the initialization of etcd is done.
 	// The listeners are listening on the TCP ports and ready
@@ -209,6 +214,12 @@ func startEtcd(cfg *embed.Config) (<-chan struct{}, <-chan error, error) {
 		return nil, nil, err
 	}
 	osutil.RegisterInterruptHandler(e.Close)
+	// the reload errors are logged by the TLS reloaders.
+	osutil.RegisterReloadHandler(func() { e.ReloadTLS() })
+	if cfg.ConfigLoader != nil {
+		// the reload errors are logged by ReloadConfig.
+		osutil.RegisterReloadHandler(func() { e.ReloadConfig() })
+	}
 	select {
 	case <-e.Server.ReadyNotify(): // wait for e.Server to join the cluster
 	case <-e.Server.StopNotify(): // publish aborted from 'ErrStopped'
@@ -41,7 +41,7 @@ var (
     Show the help information about etcd.
 
   etcd --config-file
-    Path to the server configuration file. Note that if a configuration file is provided, other command line flags and environment variables will be ignored.
+    Path to the server configuration file. Note that if a configuration file is provided, other command line flags and environment variables will be ignored. The log level, max request bytes, warning apply duration, backend quota, auto compaction and CORS and host whitelist settings are reloaded from it on SIGHUP.
 
   etcd gateway
     Run the stateless pass-through etcd TCP connection forwarding proxy.
@@ -75,6 +75,12 @@ Member:
     Maximum number of snapshot files to retain (0 is unlimited). Deprecated in v3.6 and will be decommissioned in v3.7.
   --max-wals '` + strconv.Itoa(embed.DefaultMaxWALs) + `'
     Maximum number of wal files to retain (0 is unlimited).
+  --wal-archive-url ''
+    Directory or http(s) URL to copy finished WAL segments and snapshot files to. WAL and snapshot files are not purged until they are archived.
+  --wal-archive-timeout '` + embed.DefaultWALArchiveTimeout.String() + `'
+    Timeout of a request to an http(s) --wal-archive-url, including the upload of a WAL segment.
+  --encryption-key-file ''
+    Path to the key encryption keys wrapping the data encryption keys of the stored values. The first key wraps the new data encryption keys. Values are stored in plaintext if empty. WAL entries are not encrypted.
   --memory-mlock
     Enable to enforce etcd pages (in particular bbolt) to stay in RAM.
   --quota-backend-bytes '0'
@@ -167,7 +173,9 @@ Clustering:
   --auto-compaction-retention '0'
     Auto compaction retention length. 0 means disable auto compaction.
   --auto-compaction-mode 'periodic'
-    Interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.
+    Interpret 'auto-compaction-retention' one of: periodic|revision|adaptive. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention. 'adaptive' for retention fitting 'auto-compaction-target-backend-bytes', with a duration based minimum retention.
+  --auto-compaction-target-backend-bytes '0'
+    Backend size (in bytes) targeted by the 'adaptive' auto compaction mode. Set to 0 to target 80% of the backend quota.
   --v2-deprecation '` + string(cconfig.V2DeprDefault) + `'
     Phase of v2store deprecation. Deprecated and scheduled for removal in v3.8. The default value is enforced, ignoring user input.
     Supported values:
@@ -227,10 +235,13 @@ Security:
     Minimum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3.
   --tls-max-version ''
     Maximum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3 (empty will be auto-populated by Go).
+  --tls-reload-interval '10s'
+    Interval at which the client and peer TLS files, including the trusted CA and CRL files, are checked and reloaded if changed (0 to only reload on SIGHUP).
 
 Auth:
   --auth-token 'simple'
-    Specify a v3 authentication token type and its options ('simple' or 'jwt').
+    Specify a v3 authentication token type and its options ('simple', 'jwt' or 'oidc').
+    'oidc' verifies externally issued JWTs with a JWKS document, e.g. 'oidc,jwks-url=https://issuer/keys,audience=etcd,roles-claim=groups'. Its 'audience' option is required. Writes with 'oidc' tokens require all members to be v3.7.
   --bcrypt-cost ` + fmt.Sprintf("%d", bcrypt.DefaultCost) + `
     Specify the cost / strength of the bcrypt algorithm for hashing auth passwords. Valid values are between ` + fmt.Sprintf("%d", bcrypt.MinCost) + ` and ` + fmt.Sprintf("%d", bcrypt.MaxCost) + `.
   --auth-token-ttl 300
@@ -323,6 +334,34 @@ Experimental feature:
     Set time duration after which a warning is generated if a unary request takes more than this duration. Deprecated in v3.6 and will be decommissioned in v3.7. Use '--warning-unary-request-duration' instead.
   --max-learners '1'
     Set the max number of learner members allowed in the cluster membership.
+  --learner-auto-promote-max-lag '` + strconv.Itoa(embed.DefaultLearnerAutoPromoteMaxLag) + `'
+    Maximum number of entries a learner can be behind the leader to be considered caught up for auto-promotion. Requires the LearnerAutoPromotion feature gate.
+  --learner-auto-promote-duration '` + embed.DefaultLearnerAutoPromoteDuration.String() + `'
+    Duration a learner must stay caught up with the leader before it is promoted automatically. Requires the LearnerAutoPromotion feature gate.
+  --read-index-mode '` + embed.DefaultReadIndexMode + `'
+    How linearizable reads are confirmed: 'safe' (a quorum round per read) or 'lease' (the leader lease, without a quorum round; followers still get the read index from the leader).
+  --lease-read-max-clock-drift '` + embed.DefaultLeaseReadMaxClockDrift.String() + `'
+    Maximum delay of a tick of the leader tolerated by the 'lease' read index mode. A leader whose tick is delayed more does not confirm reads with its lease for an election timeout.
+  --health-max-apply-lag '0'
+    Maximum number of committed entries not applied yet for /readyz to succeed. 0 disables the check.
+  --health-max-wal-fsync-duration '0s'
+    Maximum 99th percentile of the WAL fsync durations of the last minute, and duration of an fsync in progress, for /readyz to succeed. 0 disables the check.
+  --grpc-rate-limit '0'
+    Number of gRPC requests per second admitted per user, or per client certificate or address if unauthenticated. Lease, auth and status requests have a separate bucket of 4 times the rate and burst, bulk ranges only use the burst above half of it. 0 disables the rate limit.
+  --grpc-rate-limit-burst '` + strconv.Itoa(embed.DefaultGRPCRateLimitBurst) + `'
+    Number of gRPC requests a client can send at once under --grpc-rate-limit.
+  --audit-log-path ''
+    File the audit events of the mutating, auth, cluster and maintenance requests are written to, as JSON lines. Auditing is disabled if empty.
+  --audit-log-level '` + embed.DefaultAuditLogLevel + `'
+    Level of the audited requests: 'none', 'metadata' or 'request', which also records the values.
+  --audit-log-policy ''
+    Comma separated list of <prefix>=<level> overriding --audit-log-level for the keys with the longest matching prefix, e.g. '/secrets/=none,/config/=request'. A key range is audited at the most detailed level of the keys in it.
+  --audit-log-max-size '` + strconv.Itoa(embed.DefaultAuditLogMaxSize) + `'
+    Size in megabytes at which the audit log file is rotated.
+  --audit-log-max-backups '0'
+    Number of rotated audit log files to keep. 0 keeps all.
+  --audit-log-max-age '0'
+    Number of days to keep the rotated audit log files. 0 keeps them regardless of their age.
   --experimental-compaction-sleep-interval
     Sets the sleep interval between each compaction batch. Deprecated in v3.6 and will be decommissioned in v3.7. Use '--compaction-sleep-interval' instead.
   --compaction-sleep-interval
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -53,11 +54,28 @@ type ServerHealth interface {
 	Config() config.ServerConfig
 	AuthStore() auth.AuthStore
 	IsLearner() bool
+	IsDefragActive() bool
+	AppliedIndex() uint64
+	CommittedIndex() uint64
+	RecentWALFsyncDuration(quantile float64) time.Duration
+}
+
+// HealthChecks are the checks installed on /livez and /readyz in addition to
+// the built-in ones, by name.
+type HealthChecks struct {
+	Livez  map[string]HealthCheck
+	Readyz map[string]HealthCheck
 }
 
 // HandleHealth registers metrics and health handlers. it checks health by using v3 range request
 // and its corresponding timeout.
 func HandleHealth(lg *zap.Logger, mux *http.ServeMux, srv ServerHealth) {
+	HandleHealthWithChecks(lg, mux, srv, HealthChecks{})
+}
+
+// HandleHealthWithChecks registers metrics and health handlers like HandleHealth,
+// and installs the given checks on /livez and /readyz.
+func HandleHealthWithChecks(lg *zap.Logger, mux *http.ServeMux, srv ServerHealth, checks HealthChecks) {
 	mux.Handle(PathHealth, NewHealthHandler(lg, func(ctx context.Context, excludedAlarms StringSet, serializable bool) Health {
 		if h := checkAlarms(lg, srv, excludedAlarms); h.Health != "true" {
 			return h
@@ -68,8 +86,8 @@ func HandleHealth(lg *zap.Logger, mux *http.ServeMux, srv ServerHealth) {
 		return checkAPI(ctx, lg, srv, serializable)
 	}))
 
-	installLivezEndpoints(lg, mux, srv)
-	installReadyzEndpoints(lg, mux, srv)
+	installLivezEndpoints(lg, mux, srv, checks.Livez)
+	installReadyzEndpoints(lg, mux, srv, checks.Readyz)
 }
 
 // NewHealthHandler handles '/health' requests.
@@ -240,13 +258,14 @@ type CheckRegistry struct {
 	checks    map[string]HealthCheck
 }
 
-func installLivezEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHealth) {
+func installLivezEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHealth, custom map[string]HealthCheck) {
 	reg := CheckRegistry{checkType: checkTypeLivez, checks: make(map[string]HealthCheck)}
 	reg.Register("serializable_read", readCheck(server, true /* serializable */))
+	reg.registerCustom(lg, custom)
 	reg.InstallHTTPEndpoints(lg, mux)
 }
 
-func installReadyzEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHealth) {
+func installReadyzEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHealth, custom map[string]HealthCheck) {
 	reg := CheckRegistry{checkType: checkTypeReadyz, checks: make(map[string]HealthCheck)}
 	reg.Register("data_corruption", activeAlarmCheck(server, pb.AlarmType_CORRUPT))
 	// serializable_read checks if local read is ok.
@@ -257,6 +276,16 @@ func installReadyzEndpoints(lg *zap.Logger, mux *http.ServeMux, server ServerHea
 	reg.Register("linearizable_read", readCheck(server, false))
 	// check if local is learner
 	reg.Register("non_learner", learnerCheck(server))
+	// check if the backend is not being defragmented, as requests are blocked meanwhile.
+	reg.Register("defrag", defragCheck(server))
+	cfg := server.Config()
+	if cfg.HealthMaxApplyLag > 0 {
+		reg.Register("apply_lag", applyLagCheck(server, cfg.HealthMaxApplyLag))
+	}
+	if cfg.HealthMaxWALFsyncDuration > 0 {
+		reg.Register("wal_fsync", walFsyncCheck(server, cfg.HealthMaxWALFsyncDuration))
+	}
+	reg.registerCustom(lg, custom)
 	reg.InstallHTTPEndpoints(lg, mux)
 }
 
@@ -264,6 +293,18 @@ func (reg *CheckRegistry) Register(name string, check HealthCheck) {
 	reg.checks[name] = check
 }
 
+// registerCustom registers the given checks, skipping the ones named like a
+// built-in check.
+func (reg *CheckRegistry) registerCustom(lg *zap.Logger, checks map[string]HealthCheck) {
+	for name, check := range checks {
+		if _, found := reg.checks[name]; found {
+			lg.Warn("skipping health check named like a built-in check", zap.String("type", reg.checkType), zap.String("name", name))
+			continue
+		}
+		reg.Register(name, check)
+	}
+}
+
 func (reg *CheckRegistry) RootPath() string {
 	return "/" + reg.checkType
 }
@@ -445,3 +486,31 @@ func learnerCheck(srv ServerHealth) func(ctx context.Context) error {
 		return nil
 	}
 }
+
+func defragCheck(srv ServerHealth) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if srv.IsDefragActive() {
+			return fmt.Errorf("defragmentation is active")
+		}
+		return nil
+	}
+}
+
+func applyLagCheck(srv ServerHealth, maxLag uint64) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		ai, ci := srv.AppliedIndex(), srv.CommittedIndex()
+		if ci > ai+maxLag {
+			return fmt.Errorf("applied index %d lags committed index %d by more than %d", ai, ci, maxLag)
+		}
+		return nil
+	}
+}
+
+func walFsyncCheck(srv ServerHealth, maxDuration time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if took := srv.RecentWALFsyncDuration(0.99); took > maxDuration {
+			return fmt.Errorf("p99 or in-progress WAL fsync duration %v is above %v", took, maxDuration)
+		}
+		return nil
+	}
+}
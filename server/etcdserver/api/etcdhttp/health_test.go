@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap/zaptest"
@@ -44,6 +45,11 @@ type fakeHealthServer struct {
 	missingLeader         bool
 	authStore             auth.AuthStore
 	isLearner             bool
+	cfg                   config.ServerConfig
+	defragActive          bool
+	appliedIndex          uint64
+	committedIndex        uint64
+	walFsyncDuration      time.Duration
 }
 
 func (s *fakeHealthServer) Range(_ context.Context, req *pb.RangeRequest) (*pb.RangeResponse, error) {
@@ -58,9 +64,17 @@ func (s *fakeHealthServer) IsLearner() bool {
 }
 
 func (s *fakeHealthServer) Config() config.ServerConfig {
-	return config.ServerConfig{}
+	return s.cfg
 }
 
+func (s *fakeHealthServer) IsDefragActive() bool { return s.defragActive }
+
+func (s *fakeHealthServer) AppliedIndex() uint64 { return s.appliedIndex }
+
+func (s *fakeHealthServer) CommittedIndex() uint64 { return s.committedIndex }
+
+func (s *fakeHealthServer) RecentWALFsyncDuration(float64) time.Duration { return s.walFsyncDuration }
+
 func (s *fakeHealthServer) Leader() types.ID {
 	if !s.missingLeader {
 		return 1
@@ -391,6 +405,132 @@ func TestLearnerReadyCheck(t *testing.T) {
 	}
 }
 
+func TestDefragReadyCheck(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	logger := zaptest.NewLogger(t)
+	s := &fakeHealthServer{
+		authStore: auth.NewAuthStore(logger, schema.NewAuthBackend(logger, be), nil, 0),
+	}
+	mux := http.NewServeMux()
+	HandleHealth(logger, mux, s)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	checkHTTPResponse(t, ts, "/readyz?verbose", http.StatusOK, []string{"[+]defrag ok"}, nil)
+	s.defragActive = true
+	checkHTTPResponse(t, ts, "/readyz", http.StatusServiceUnavailable, []string{"[-]defrag failed: defragmentation is active"}, nil)
+	checkMetrics(t, "/readyz", "defrag", http.StatusServiceUnavailable)
+	checkHTTPResponse(t, ts, "/livez", http.StatusOK, nil, nil)
+	checkHTTPResponse(t, ts, "/readyz?exclude=defrag", http.StatusOK, nil, nil)
+}
+
+func TestApplyLagReadyCheck(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	tests := []struct {
+		name             string
+		maxLag           uint64
+		appliedIndex     uint64
+		committedIndex   uint64
+		expectStatusCode int
+		inResult         []string
+		notInResult      []string
+	}{
+		{
+			name:             "disabled",
+			appliedIndex:     10,
+			committedIndex:   1000,
+			expectStatusCode: http.StatusOK,
+			notInResult:      []string{"apply_lag"},
+		},
+		{
+			name:             "ready within the lag",
+			maxLag:           100,
+			appliedIndex:     900,
+			committedIndex:   1000,
+			expectStatusCode: http.StatusOK,
+			inResult:         []string{"[+]apply_lag ok"},
+		},
+		{
+			name:             "not ready above the lag",
+			maxLag:           100,
+			appliedIndex:     899,
+			committedIndex:   1000,
+			expectStatusCode: http.StatusServiceUnavailable,
+			inResult:         []string{"[-]apply_lag failed: applied index 899 lags committed index 1000 by more than 100"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			logger := zaptest.NewLogger(t)
+			s := &fakeHealthServer{
+				authStore:      auth.NewAuthStore(logger, schema.NewAuthBackend(logger, be), nil, 0),
+				cfg:            config.ServerConfig{HealthMaxApplyLag: tt.maxLag},
+				appliedIndex:   tt.appliedIndex,
+				committedIndex: tt.committedIndex,
+			}
+			HandleHealth(logger, mux, s)
+			ts := httptest.NewServer(mux)
+			defer ts.Close()
+			checkHTTPResponse(t, ts, "/readyz?verbose", tt.expectStatusCode, tt.inResult, tt.notInResult)
+		})
+	}
+}
+
+func TestWALFsyncReadyCheck(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	logger := zaptest.NewLogger(t)
+	s := &fakeHealthServer{
+		authStore:        auth.NewAuthStore(logger, schema.NewAuthBackend(logger, be), nil, 0),
+		cfg:              config.ServerConfig{HealthMaxWALFsyncDuration: 100 * time.Millisecond},
+		walFsyncDuration: 10 * time.Millisecond,
+	}
+	mux := http.NewServeMux()
+	HandleHealth(logger, mux, s)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	checkHTTPResponse(t, ts, "/readyz/wal_fsync", http.StatusOK, nil, nil)
+	s.walFsyncDuration = 200 * time.Millisecond
+	checkHTTPResponse(t, ts, "/readyz", http.StatusServiceUnavailable, []string{"[-]wal_fsync failed: p99 or in-progress WAL fsync duration 200ms is above 100ms"}, nil)
+	checkHTTPResponse(t, ts, "/livez", http.StatusOK, nil, nil)
+}
+
+func TestCustomHealthChecks(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	logger := zaptest.NewLogger(t)
+	s := &fakeHealthServer{
+		authStore: auth.NewAuthStore(logger, schema.NewAuthBackend(logger, be), nil, 0),
+	}
+	var degraded error
+	checks := HealthChecks{
+		Livez: map[string]HealthCheck{
+			"process": func(context.Context) error { return nil },
+		},
+		Readyz: map[string]HealthCheck{
+			"traffic": func(context.Context) error { return degraded },
+			// named like a built-in check, so it is skipped.
+			"defrag": func(context.Context) error { return fmt.Errorf("not skipped") },
+		},
+	}
+	mux := http.NewServeMux()
+	HandleHealthWithChecks(logger, mux, s, checks)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	checkHTTPResponse(t, ts, "/livez?verbose", http.StatusOK, []string{"[+]process ok"}, nil)
+	checkHTTPResponse(t, ts, "/readyz?verbose", http.StatusOK, []string{"[+]traffic ok", "[+]defrag ok"}, nil)
+	degraded = fmt.Errorf("member is degraded")
+	checkHTTPResponse(t, ts, "/readyz", http.StatusServiceUnavailable, []string{"[-]traffic failed: member is degraded"}, nil)
+	checkHTTPResponse(t, ts, "/readyz/traffic", http.StatusServiceUnavailable, []string{"[-]traffic failed: member is degraded"}, nil)
+	checkHTTPResponse(t, ts, "/readyz?exclude=traffic", http.StatusOK, nil, nil)
+	checkHTTPResponse(t, ts, "/livez", http.StatusOK, nil, []string{"traffic"})
+}
+
 func checkHTTPResponse(t *testing.T, ts *httptest.Server, url string, expectStatusCode int, inResult []string, notInResult []string) {
 	res, err := ts.Client().Do(&http.Request{Method: http.MethodGet, URL: testutil.MustNewURL(t, ts.URL+url)})
 	if err != nil {
@@ -0,0 +1,297 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// RevRangeGetter returns the range of the revisions kept by the KV.
+type RevRangeGetter interface {
+	RevGetter
+	// FirstRev returns the last compaction revision, or -1 if the KV was
+	// never compacted.
+	FirstRev() int64
+}
+
+// SizeGetter returns the size of the backend logically in use.
+type SizeGetter interface {
+	SizeInUse() int64
+}
+
+const (
+	// adaptiveInterval is the maximum interval between two compaction
+	// decisions of the adaptive compactor.
+	adaptiveInterval = time.Minute
+	// adaptiveMinInterval is the minimum interval between two compaction
+	// decisions of the adaptive compactor.
+	adaptiveMinInterval = time.Second
+	// adaptiveRateSamples is the number of decisions over which the
+	// revision rate is observed.
+	adaptiveRateSamples = 10
+)
+
+// revSample is a revision observed at a given time.
+type revSample struct {
+	t   time.Time
+	rev int64
+}
+
+// adaptiveDecision is a compaction decision of the adaptive compactor.
+type adaptiveDecision struct {
+	// revision is the compaction revision, or 0 if nothing is compacted.
+	revision int64
+	// floorRevision is the revision observed a retention floor ago, or 0
+	// if the compactor has not run for the retention floor yet.
+	floorRevision int64
+	// retained is the number of revisions fitting the target backend size.
+	retained int64
+	// rate is the observed number of revisions per second.
+	rate float64
+	// limitedByFloor is set if the target backend size needs a compaction
+	// beyond the retention floor.
+	limitedByFloor bool
+}
+
+// Adaptive compacts the log by purging the revisions which do not fit the
+// target backend size, estimated from the backend size in use and the
+// observed revision rate. It never purges the revisions newer than the
+// retention floor.
+type Adaptive struct {
+	lg    *zap.Logger
+	clock clockwork.Clock
+	floor time.Duration
+
+	targetBackendBytes int64
+
+	rg RevRangeGetter
+	sg SizeGetter
+	c  Compactable
+
+	// samples are the observed revisions, oldest first. They cover the
+	// retention floor and the last adaptiveRateSamples decisions.
+	samples []revSample
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	mu     sync.Mutex
+	paused bool
+}
+
+// NewAdaptive returns a new Compactor that purges the revisions not fitting
+// targetBackendBytes, and keeps at least the revisions of the last floor.
+func NewAdaptive(
+	lg *zap.Logger,
+	floor time.Duration,
+	targetBackendBytes int64,
+	rg RevRangeGetter,
+	sg SizeGetter,
+	c Compactable,
+) Compactor {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return newAdaptive(lg, clockwork.NewRealClock(), floor, targetBackendBytes, rg, sg, c)
+}
+
+func newAdaptive(lg *zap.Logger, clock clockwork.Clock, floor time.Duration, targetBackendBytes int64, rg RevRangeGetter, sg SizeGetter, c Compactable) *Adaptive {
+	ac := &Adaptive{
+		lg:                 lg,
+		clock:              clock,
+		floor:              floor,
+		targetBackendBytes: targetBackendBytes,
+		rg:                 rg,
+		sg:                 sg,
+		c:                  c,
+	}
+	ac.ctx, ac.cancel = context.WithCancel(context.Background())
+	return ac
+}
+
+// Run runs adaptive compactor.
+func (ac *Adaptive) Run() {
+	interval := ac.getInterval()
+	go func() {
+		lastRevision := int64(0)
+		for {
+			select {
+			case <-ac.ctx.Done():
+				return
+			case <-ac.clock.After(interval):
+			}
+			// the revisions are observed while paused, so that the leader
+			// knows the retention floor.
+			now, rev := ac.clock.Now(), ac.rg.Rev()
+			ac.observe(now, rev)
+			ac.mu.Lock()
+			p := ac.paused
+			ac.mu.Unlock()
+			if p {
+				continue
+			}
+
+			firstRev, sizeInUse := ac.rg.FirstRev(), ac.sg.SizeInUse()
+			d := ac.decide(now, rev, firstRev, sizeInUse, interval)
+			adaptiveRevisionRate.Set(d.rate)
+			adaptiveRetainedRevisions.Set(float64(d.retained))
+			if d.limitedByFloor {
+				adaptiveFloorLimitedTotal.Inc()
+			}
+			if d.revision == 0 || d.revision == lastRevision {
+				ac.lg.Debug(
+					"skipped auto adaptive compaction",
+					zap.Int64("current-revision", rev),
+					zap.Int64("compact-revision", firstRev),
+					zap.Int64("floor-revision", d.floorRevision),
+					zap.Int64("retained-revisions", d.retained),
+					zap.Int64("backend-size-in-use-bytes", sizeInUse),
+					zap.Int64("target-backend-bytes", ac.targetBackendBytes),
+				)
+				continue
+			}
+
+			ac.lg.Info(
+				"starting auto adaptive compaction",
+				zap.Int64("revision", d.revision),
+				zap.Int64("current-revision", rev),
+				zap.Int64("floor-revision", d.floorRevision),
+				zap.Int64("retained-revisions", d.retained),
+				zap.Float64("revision-rate", d.rate),
+				zap.Int64("backend-size-in-use-bytes", sizeInUse),
+				zap.Int64("target-backend-bytes", ac.targetBackendBytes),
+				zap.Duration("retention-floor", ac.floor),
+				zap.Bool("limited-by-retention-floor", d.limitedByFloor),
+			)
+			startTime := ac.clock.Now()
+			_, err := ac.c.Compact(ac.ctx, &pb.CompactionRequest{Revision: d.revision})
+			if err == nil || errors.Is(err, mvcc.ErrCompacted) {
+				lastRevision = d.revision
+				adaptiveCompactionRevision.Set(float64(d.revision))
+				ac.lg.Info(
+					"completed auto adaptive compaction",
+					zap.Int64("revision", d.revision),
+					zap.Duration("retention-floor", ac.floor),
+					zap.Duration("took", ac.clock.Now().Sub(startTime)),
+				)
+			} else {
+				ac.lg.Warn(
+					"failed auto adaptive compaction",
+					zap.Int64("revision", d.revision),
+					zap.Duration("retention-floor", ac.floor),
+					zap.Duration("retry-interval", interval),
+					zap.Error(err),
+				)
+			}
+		}
+	}()
+}
+
+// observe records the revision rev observed at now, and drops the samples
+// not needed for the retention floor and the revision rate anymore.
+func (ac *Adaptive) observe(now time.Time, rev int64) {
+	ac.samples = append(ac.samples, revSample{t: now, rev: rev})
+	floorTime := now.Add(-ac.floor)
+	for len(ac.samples) > adaptiveRateSamples+1 && !ac.samples[1].t.After(floorTime) {
+		ac.samples = ac.samples[1:]
+	}
+}
+
+// decide chooses the compaction revision from the revisions kept by the KV,
+// the backend size in use and the observed revisions. The revisions written
+// during the next interval at the observed rate are kept out of the target
+// backend size, so that write bursts do not outgrow it before the next
+// decision.
+func (ac *Adaptive) decide(now time.Time, rev, firstRev, sizeInUse int64, interval time.Duration) adaptiveDecision {
+	var d adaptiveDecision
+	floorTime := now.Add(-ac.floor)
+	for i := len(ac.samples) - 1; i >= 0; i-- {
+		if !ac.samples[i].t.After(floorTime) {
+			d.floorRevision = ac.samples[i].rev
+			break
+		}
+	}
+	if n := len(ac.samples); n > 1 {
+		old := ac.samples[max(0, n-1-adaptiveRateSamples)]
+		if elapsed := now.Sub(old.t).Seconds(); elapsed > 0 {
+			d.rate = float64(rev-old.rev) / elapsed
+		}
+	}
+
+	firstRev = max(firstRev, 0)
+	kept := rev - firstRev
+	if kept <= 0 || sizeInUse <= 0 {
+		return d
+	}
+	// the size of the revisions is estimated from the size of the revisions
+	// kept by the KV, which includes the latest revisions of the keys.
+	bytesPerRev := float64(sizeInUse) / float64(kept)
+	d.retained = max(int64(float64(ac.targetBackendBytes)/bytesPerRev-d.rate*interval.Seconds()), 0)
+	if d.retained >= kept {
+		d.retained = kept
+		return d
+	}
+
+	d.revision = rev - d.retained
+	if d.revision > d.floorRevision {
+		d.revision = d.floorRevision
+		d.limitedByFloor = true
+	}
+	if d.revision <= firstRev {
+		d.revision = 0
+	}
+	return d
+}
+
+// getInterval returns the interval between two compaction decisions. It is
+// 1/10 of the retention floor, between adaptiveMinInterval and
+// adaptiveInterval.
+func (ac *Adaptive) getInterval() time.Duration {
+	itv := ac.floor / retryDivisor
+	if itv > adaptiveInterval {
+		itv = adaptiveInterval
+	}
+	if itv < adaptiveMinInterval {
+		itv = adaptiveMinInterval
+	}
+	return itv
+}
+
+// Stop stops adaptive compactor.
+func (ac *Adaptive) Stop() {
+	ac.cancel()
+}
+
+// Pause pauses adaptive compactor.
+func (ac *Adaptive) Pause() {
+	ac.mu.Lock()
+	ac.paused = true
+	ac.mu.Unlock()
+}
+
+// Resume resumes adaptive compactor.
+func (ac *Adaptive) Resume() {
+	ac.mu.Lock()
+	ac.paused = false
+	ac.mu.Unlock()
+}
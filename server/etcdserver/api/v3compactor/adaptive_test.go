@@ -0,0 +1,209 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+)
+
+type fakeRevRangeGetter struct {
+	testutil.Recorder
+	rev      int64
+	firstRev int64
+}
+
+func (fr *fakeRevRangeGetter) Rev() int64 {
+	fr.Record(testutil.Action{Name: "g"})
+	return atomic.LoadInt64(&fr.rev)
+}
+
+func (fr *fakeRevRangeGetter) FirstRev() int64 {
+	return atomic.LoadInt64(&fr.firstRev)
+}
+
+type fakeSizeGetter struct {
+	size int64
+}
+
+func (fs *fakeSizeGetter) SizeInUse() int64 {
+	return atomic.LoadInt64(&fs.size)
+}
+
+func TestAdaptiveDecide(t *testing.T) {
+	start := time.Unix(0, 0)
+	tests := []struct {
+		name      string
+		floor     time.Duration
+		firstRev  int64
+		sizeInUse int64
+
+		wdecision adaptiveDecision
+	}{
+		{
+			name:      "fits the target backend size",
+			floor:     time.Minute,
+			firstRev:  -1,
+			sizeInUse: 500,
+			wdecision: adaptiveDecision{floorRevision: 540, retained: 600, rate: 1},
+		},
+		{
+			name:      "outgrows the target backend size",
+			floor:     time.Minute,
+			firstRev:  -1,
+			sizeInUse: 1200,
+			// 2 bytes per revision, 1 revision per second.
+			wdecision: adaptiveDecision{revision: 140, floorRevision: 540, retained: 460, rate: 1},
+		},
+		{
+			name:      "limited by the retention floor",
+			floor:     10 * time.Minute,
+			firstRev:  -1,
+			sizeInUse: 1200,
+			wdecision: adaptiveDecision{revision: 0, floorRevision: 0, retained: 460, rate: 1, limitedByFloor: true},
+		},
+		{
+			name:      "limited by the retention floor after a compaction",
+			floor:     5 * time.Minute,
+			firstRev:  100,
+			sizeInUse: 2500,
+			// 5 bytes per revision, 1 revision per second.
+			wdecision: adaptiveDecision{revision: 300, floorRevision: 300, retained: 160, rate: 1, limitedByFloor: true},
+		},
+		{
+			name:      "compacted",
+			floor:     time.Minute,
+			firstRev:  140,
+			sizeInUse: 920,
+			wdecision: adaptiveDecision{floorRevision: 540, retained: 460, rate: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ac := newAdaptive(zaptest.NewLogger(t), clockwork.NewFakeClock(), tt.floor, 1000, nil, nil, nil)
+			// one revision per second for 10 minutes.
+			for i := 0; i <= 10; i++ {
+				ac.observe(start.Add(time.Duration(i)*time.Minute), int64(i*60))
+			}
+			now := start.Add(10 * time.Minute)
+			d := ac.decide(now, 600, tt.firstRev, tt.sizeInUse, 40*time.Second)
+			if !reflect.DeepEqual(d, tt.wdecision) {
+				t.Errorf("decision = %+v, want %+v", d, tt.wdecision)
+			}
+		})
+	}
+}
+
+func TestAdaptiveInterval(t *testing.T) {
+	tests := []struct {
+		floor time.Duration
+		witv  time.Duration
+	}{
+		{floor: time.Second, witv: adaptiveMinInterval},
+		{floor: time.Minute, witv: 6 * time.Second},
+		{floor: time.Hour, witv: adaptiveInterval},
+	}
+	for _, tt := range tests {
+		ac := newAdaptive(zaptest.NewLogger(t), clockwork.NewFakeClock(), tt.floor, 1000, nil, nil, nil)
+		if itv := ac.getInterval(); itv != tt.witv {
+			t.Errorf("floor %v: interval = %v, want %v", tt.floor, itv, tt.witv)
+		}
+	}
+}
+
+func TestAdaptive(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fakeRevRangeGetter{Recorder: testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond), firstRev: -1}
+	compactable := &fakeCompactable{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond)}
+	// the target backend size is always outgrown.
+	tb := newAdaptive(zaptest.NewLogger(t), fc, 10*time.Second, 1, rg, &fakeSizeGetter{size: 1 << 20}, compactable)
+
+	tb.Run()
+	defer tb.Stop()
+
+	// no compaction until the retention floor elapses.
+	for i := 1; i <= 10; i++ {
+		atomic.StoreInt64(&rg.rev, int64(i*10))
+		fc.BlockUntil(1)
+		fc.Advance(tb.getInterval())
+		waitOneAction(t, rg)
+	}
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// compacts up to the revision observed a retention floor ago.
+	atomic.StoreInt64(&rg.rev, 110)
+	fc.BlockUntil(1)
+	fc.Advance(tb.getInterval())
+	waitOneAction(t, rg)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.CompactionRequest{Revision: 10}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+}
+
+func TestAdaptivePause(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fakeRevRangeGetter{Recorder: testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond), firstRev: -1}
+	compactable := &fakeCompactable{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond)}
+	tb := newAdaptive(zaptest.NewLogger(t), fc, 10*time.Second, 1, rg, &fakeSizeGetter{size: 1 << 20}, compactable)
+
+	tb.Run()
+	tb.Pause()
+	defer tb.Stop()
+
+	// tb observes the revisions but does not compact since paused.
+	for i := 1; i <= 20; i++ {
+		atomic.StoreInt64(&rg.rev, int64(i*10))
+		fc.BlockUntil(1)
+		fc.Advance(tb.getInterval())
+		waitOneAction(t, rg)
+	}
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	tb.Resume()
+
+	atomic.StoreInt64(&rg.rev, 210)
+	fc.BlockUntil(1)
+	fc.Advance(tb.getInterval())
+	waitOneAction(t, rg)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.CompactionRequest{Revision: 110}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+}
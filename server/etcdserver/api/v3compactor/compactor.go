@@ -28,6 +28,7 @@ import (
 const (
 	ModePeriodic = "periodic"
 	ModeRevision = "revision"
+	ModeAdaptive = "adaptive"
 )
 
 // Compactor purges old log from the storage periodically.
@@ -51,7 +52,8 @@ type RevGetter interface {
 	Rev() int64
 }
 
-// New returns a new Compactor based on given "mode".
+// New returns a new Compactor based on given "mode". The adaptive
+// compactors are created by NewAdaptive.
 func New(
 	lg *zap.Logger,
 	mode string,
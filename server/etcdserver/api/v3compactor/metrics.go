@@ -0,0 +1,53 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	adaptiveRevisionRate = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "auto_compaction_adaptive_revision_rate",
+		Help:      "Revisions per second observed by the adaptive auto compactor.",
+	})
+	adaptiveRetainedRevisions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "auto_compaction_adaptive_retained_revisions",
+		Help:      "Number of revisions fitting the target backend size estimated by the adaptive auto compactor.",
+	})
+	adaptiveCompactionRevision = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "auto_compaction_adaptive_compaction_revision",
+		Help:      "The last revision compacted by the adaptive auto compactor.",
+	})
+	adaptiveFloorLimitedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "auto_compaction_adaptive_floor_limited_total",
+		Help:      "Total number of adaptive auto compaction decisions limited by the retention floor.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(adaptiveRevisionRate)
+	prometheus.MustRegister(adaptiveRetainedRevisions)
+	prometheus.MustRegister(adaptiveCompactionRevision)
+	prometheus.MustRegister(adaptiveFloorLimitedTotal)
+}
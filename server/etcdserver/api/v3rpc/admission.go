@@ -0,0 +1,238 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/auth"
+)
+
+const (
+	leaseKeepAliveMethod = "/etcdserverpb.Lease/LeaseKeepAlive"
+
+	// bulkRangeLimit is the limit above which a range over a key range is a
+	// bulk range.
+	bulkRangeLimit = 1000
+	// lowPriorityReserve is the fraction of the burst of a client that low
+	// priority requests cannot use, so they never starve its other requests.
+	// It is clamped to leave at least one token to low priority requests.
+	lowPriorityReserve = 0.5
+	// highPriorityShare is the multiple of the rate and burst of a client
+	// given to its high priority requests, in a bucket of their own.
+	highPriorityShare = 4
+)
+
+// requestPriority is the priority level of a request admitted by the
+// admission controller.
+type requestPriority int
+
+const (
+	// priorityLow requests are the bulk requests, e.g. ranges over many keys
+	// and snapshots, admitted only while a client has a reserve of tokens.
+	priorityLow requestPriority = iota
+	// priorityNormal requests take a token of the bucket of the client.
+	priorityNormal
+	// priorityHigh requests take a token of a separate, larger bucket of the
+	// client, as rejecting e.g. lease keepalives would expire the leases of a
+	// busy client.
+	priorityHigh
+)
+
+func (p requestPriority) String() string {
+	switch p {
+	case priorityLow:
+		return "low"
+	case priorityHigh:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
+// requestPriorityOf returns the priority of a unary request.
+func requestPriorityOf(req any) requestPriority {
+	switch r := req.(type) {
+	case *pb.LeaseGrantRequest, *pb.LeaseRevokeRequest, *pb.LeaseTimeToLiveRequest, *pb.LeaseLeasesRequest,
+		*pb.AuthenticateRequest, *pb.StatusRequest, *pb.AlarmRequest:
+		return priorityHigh
+	case *pb.RangeRequest:
+		if isBulkRange(r) {
+			return priorityLow
+		}
+	case *pb.TxnRequest:
+		for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+			for _, op := range ops {
+				if rr := op.GetRequestRange(); rr != nil && isBulkRange(rr) {
+					return priorityLow
+				}
+			}
+		}
+	case *pb.HashRequest, *pb.HashKVRequest:
+		return priorityLow
+	}
+	return priorityNormal
+}
+
+// streamPriorityOf returns the priority of a stream of the given method.
+func streamPriorityOf(method string) requestPriority {
+	switch method {
+	case leaseKeepAliveMethod:
+		return priorityHigh
+	case snapshotMethod, snapshotDeltaMethod:
+		return priorityLow
+	default:
+		return priorityNormal
+	}
+}
+
+func isBulkRange(r *pb.RangeRequest) bool {
+	return len(r.RangeEnd) != 0 && !r.CountOnly && (r.Limit == 0 || r.Limit > bulkRangeLimit)
+}
+
+// admissionController rejects the requests of the clients exceeding their
+// rate, tracked by a token bucket per user, or per client certificate or
+// address for the unauthenticated clients.
+type admissionController struct {
+	as    auth.AuthStore
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*clientLimiter
+	// sweepAt is the number of limiters above which the idle ones are removed.
+	sweepAt int
+}
+
+// clientLimiter holds the token buckets of a client.
+type clientLimiter struct {
+	lim  *rate.Limiter
+	high *rate.Limiter
+}
+
+func newAdmissionController(as auth.AuthStore, limit float64, burst int) *admissionController {
+	return &admissionController{
+		as:       as,
+		limit:    rate.Limit(limit),
+		burst:    burst,
+		limiters: make(map[string]*clientLimiter),
+		sweepAt:  1024,
+	}
+}
+
+// lowPriorityMinTokens returns the number of tokens a client needs to have for
+// a low priority request to be admitted.
+func (ac *admissionController) lowPriorityMinTokens() float64 {
+	return min(float64(ac.burst)*lowPriorityReserve, float64(ac.burst-1)) + 1
+}
+
+// admit returns true if a request of the given priority from the client of
+// ctx is admitted, and records the rejection otherwise.
+func (ac *admissionController) admit(ctx context.Context, p requestPriority) bool {
+	key := ac.clientKey(ctx)
+	now := time.Now()
+
+	ac.mu.Lock()
+	cl, ok := ac.limiters[key]
+	if !ok {
+		ac.sweep(now)
+		cl = &clientLimiter{
+			lim:  rate.NewLimiter(ac.limit, ac.burst),
+			high: rate.NewLimiter(ac.limit*highPriorityShare, ac.burst*highPriorityShare),
+		}
+		ac.limiters[key] = cl
+	}
+	var admitted bool
+	switch p {
+	case priorityHigh:
+		admitted = cl.high.AllowN(now, 1)
+	case priorityLow:
+		admitted = cl.lim.TokensAt(now) >= ac.lowPriorityMinTokens() && cl.lim.AllowN(now, 1)
+	default:
+		admitted = cl.lim.AllowN(now, 1)
+	}
+	ac.mu.Unlock()
+
+	if !admitted {
+		rateLimitedRequests.WithLabelValues(p.String()).Inc()
+	}
+	return admitted
+}
+
+// sweep removes the limiters with full buckets, as they are the same as a
+// new limiter, once there are more than sweepAt limiters.
+func (ac *admissionController) sweep(now time.Time) {
+	if len(ac.limiters) < ac.sweepAt {
+		return
+	}
+	for key, cl := range ac.limiters {
+		if cl.lim.TokensAt(now) >= float64(ac.burst) && cl.high.TokensAt(now) >= float64(ac.burst*highPriorityShare) {
+			delete(ac.limiters, key)
+		}
+	}
+	ac.sweepAt = max(1024, 2*len(ac.limiters))
+}
+
+// clientKey returns the key of the bucket of the client of ctx: its user if
+// authenticated, or else the common name of its certificate, or else its
+// address.
+func (ac *admissionController) clientKey(ctx context.Context) string {
+	if ai, err := ac.as.AuthInfoFromCtx(ctx); err == nil && ai != nil {
+		return "user:" + ai.Username
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p == nil {
+		return ""
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		return "cert:" + tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+	if p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return "addr:" + addr
+}
+
+func newAdmissionUnaryInterceptor(ac *admissionController) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !ac.admit(ctx, requestPriorityOf(req)) {
+			return nil, rpctypes.ErrGRPCRateLimited
+		}
+		return handler(ctx, req)
+	}
+}
+
+func newAdmissionStreamInterceptor(ac *admissionController) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !ac.admit(ss.Context(), streamPriorityOf(info.FullMethod)) {
+			return rpctypes.ErrGRPCRateLimited
+		}
+		return handler(srv, ss)
+	}
+}
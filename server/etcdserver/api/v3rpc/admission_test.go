@@ -0,0 +1,95 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/peer"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/auth"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestRequestPriority(t *testing.T) {
+	bulk := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}
+	tests := []struct {
+		name string
+		req  any
+		want requestPriority
+	}{
+		{name: "lease grant", req: &pb.LeaseGrantRequest{TTL: 10}, want: priorityHigh},
+		{name: "status", req: &pb.StatusRequest{}, want: priorityHigh},
+		{name: "put", req: &pb.PutRequest{Key: []byte("a")}, want: priorityNormal},
+		{name: "point range", req: &pb.RangeRequest{Key: []byte("a")}, want: priorityNormal},
+		{name: "limited range", req: &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b"), Limit: 10}, want: priorityNormal},
+		{name: "count range", req: &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b"), CountOnly: true}, want: priorityNormal},
+		{name: "bulk range", req: bulk, want: priorityLow},
+		{name: "txn with bulk range", req: &pb.TxnRequest{Failure: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: bulk}}}}, want: priorityLow},
+		{name: "hash kv", req: &pb.HashKVRequest{}, want: priorityLow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requestPriorityOf(tt.req))
+		})
+	}
+	assert.Equal(t, priorityHigh, streamPriorityOf(leaseKeepAliveMethod))
+	assert.Equal(t, priorityLow, streamPriorityOf(snapshotMethod))
+	assert.Equal(t, priorityNormal, streamPriorityOf("/etcdserverpb.Watch/Watch"))
+}
+
+func TestAdmissionController(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	as := auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), nil, 0)
+	defer as.Close()
+
+	client := func(addr string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 2379}})
+	}
+	a, b := client("10.0.0.1"), client("10.0.0.2")
+	// a rate of one request per thousand seconds does not refill the bucket during the test.
+	ac := newAdmissionController(as, 0.001, 4)
+
+	// low priority requests only use half the burst.
+	assert.True(t, ac.admit(a, priorityLow))
+	assert.True(t, ac.admit(a, priorityLow))
+	assert.False(t, ac.admit(a, priorityLow))
+	assert.True(t, ac.admit(a, priorityNormal))
+	assert.True(t, ac.admit(a, priorityNormal))
+	assert.False(t, ac.admit(a, priorityNormal))
+
+	// high priority requests have a bucket of their own, with a larger burst.
+	for i := 0; i < 4*highPriorityShare; i++ {
+		assert.True(t, ac.admit(a, priorityHigh))
+	}
+	assert.False(t, ac.admit(a, priorityHigh))
+
+	// the clients have a bucket each.
+	assert.True(t, ac.admit(b, priorityNormal))
+	assert.Len(t, ac.limiters, 2)
+
+	// with a burst of one, low priority requests can still be admitted.
+	ac = newAdmissionController(as, 0.001, 1)
+	assert.True(t, ac.admit(a, priorityLow))
+	assert.False(t, ac.admit(a, priorityLow))
+}
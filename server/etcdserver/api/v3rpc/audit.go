@@ -0,0 +1,235 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/audit"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/txn"
+)
+
+func newAuditUnaryInterceptor(s *etcdserver.EtcdServer, a *audit.Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ev := newAuditEvent(a.Policy(), info.FullMethod, req)
+		if ev == nil {
+			return handler(ctx, req)
+		}
+		// the user is resolved before the request, which may delete it.
+		if ai, err := s.AuthInfoFromCtx(ctx); err == nil && ai != nil {
+			ev.User = ai.Username
+		}
+		if p, ok := peer.FromContext(ctx); ok && p != nil {
+			if p.Addr != nil {
+				ev.RemoteAddr = p.Addr.String()
+			}
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+				ev.CommonName = tlsInfo.State.PeerCertificates[0].Subject.CommonName
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		auditResponse(ev, resp, err)
+		a.Log(ev)
+		return resp, err
+	}
+}
+
+// newAuditEvent returns the event of an audited request, or nil if the request
+// is not audited: reads, except of the auth store, are not audited.
+func newAuditEvent(p *audit.Policy, method string, req any) *audit.Event {
+	ev := &audit.Event{Time: time.Now(), Level: p.Default, Method: method}
+	switch r := req.(type) {
+	case *pb.PutRequest:
+		ev.Level = p.Level(r.Key)
+		ev.Key = string(r.Key)
+		ev.LeaseID = r.Lease
+		if ev.Level == audit.LevelRequest {
+			ev.Value = r.Value
+		}
+	case *pb.DeleteRangeRequest:
+		ev.Level = p.RangeLevel(r.Key, r.RangeEnd)
+		ev.Key, ev.RangeEnd = string(r.Key), string(r.RangeEnd)
+	case *pb.TxnRequest:
+		if txn.IsTxnReadonly(r) {
+			return nil
+		}
+		ev.Level = auditTxnLevel(p, r)
+		ev.Txn = auditTxn(r, ev.Level)
+	case *pb.CompactionRequest:
+
+	case *pb.LeaseGrantRequest:
+		ev.LeaseID = r.ID
+	case *pb.LeaseRevokeRequest:
+		ev.LeaseID = r.ID
+
+	case *pb.AuthenticateRequest:
+		ev.User, ev.TargetUser = r.Name, r.Name
+	case *pb.AuthEnableRequest, *pb.AuthDisableRequest, *pb.AuthStatusRequest,
+		*pb.AuthUserListRequest, *pb.AuthRoleListRequest:
+	case *pb.AuthUserAddRequest:
+		ev.TargetUser = r.Name
+	case *pb.AuthUserGetRequest:
+		ev.TargetUser = r.Name
+	case *pb.AuthUserDeleteRequest:
+		ev.TargetUser = r.Name
+	case *pb.AuthUserChangePasswordRequest:
+		ev.TargetUser = r.Name
+	case *pb.AuthUserGrantRoleRequest:
+		ev.TargetUser, ev.TargetRole = r.User, r.Role
+	case *pb.AuthUserRevokeRoleRequest:
+		ev.TargetUser, ev.TargetRole = r.Name, r.Role
+	case *pb.AuthRoleAddRequest:
+		ev.TargetRole = r.Name
+	case *pb.AuthRoleGetRequest:
+		ev.TargetRole = r.Role
+	case *pb.AuthRoleDeleteRequest:
+		ev.TargetRole = r.Role
+	case *pb.AuthRoleGrantPermissionRequest:
+		ev.TargetRole = r.Name
+		if r.Perm != nil {
+			ev.Key, ev.RangeEnd = string(r.Perm.Key), string(r.Perm.RangeEnd)
+			ev.Permission = r.Perm.PermType.String()
+			if r.Perm.Deny {
+				ev.Permission = "deny " + ev.Permission
+			}
+		}
+	case *pb.AuthRoleRevokePermissionRequest:
+		ev.TargetRole = r.Role
+		ev.Key, ev.RangeEnd = string(r.Key), string(r.RangeEnd)
+
+	case *pb.MemberAddRequest:
+	case *pb.MemberRemoveRequest:
+		ev.MemberID = types.ID(r.ID).String()
+	case *pb.MemberUpdateRequest:
+		ev.MemberID = types.ID(r.ID).String()
+	case *pb.MemberPromoteRequest:
+		ev.MemberID = types.ID(r.ID).String()
+
+	case *pb.AlarmRequest:
+		if r.Action == pb.AlarmRequest_GET {
+			return nil
+		}
+		if r.MemberID != 0 {
+			ev.MemberID = types.ID(r.MemberID).String()
+		}
+	case *pb.DefragmentRequest, *pb.DowngradeRequest, *pb.ReloadConfigRequest:
+	case *pb.MoveLeaderRequest:
+		ev.MemberID = types.ID(r.TargetID).String()
+
+	default:
+		return nil
+	}
+	if ev.Level == audit.LevelNone {
+		return nil
+	}
+	return ev
+}
+
+// auditResponse records the outcome of the request of ev.
+func auditResponse(ev *audit.Event, resp any, err error) {
+	if err != nil {
+		ev.Outcome, ev.Error = audit.OutcomeFailure, err.Error()
+		return
+	}
+	ev.Outcome = audit.OutcomeSuccess
+	if r, ok := resp.(interface{ GetHeader() *pb.ResponseHeader }); ok && r.GetHeader() != nil {
+		ev.Revision = r.GetHeader().Revision
+	}
+	switch r := resp.(type) {
+	case *pb.TxnResponse:
+		if ev.Txn != nil && r != nil {
+			succeeded := r.Succeeded
+			ev.Txn.Succeeded = &succeeded
+		}
+	case *pb.LeaseGrantResponse:
+		if r != nil {
+			ev.LeaseID = r.ID
+		}
+	case *pb.MemberAddResponse:
+		if r != nil && r.Member != nil {
+			ev.MemberID = types.ID(r.Member.ID).String()
+		}
+	}
+}
+
+// auditTxnLevel returns the most detailed level of the keys and key ranges of
+// a transaction.
+func auditTxnLevel(p *audit.Policy, r *pb.TxnRequest) audit.Level {
+	l := audit.LevelNone
+	for _, c := range r.Compare {
+		l = l.Max(p.RangeLevel(c.Key, c.RangeEnd))
+	}
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				l = l.Max(p.RangeLevel(tv.RequestRange.Key, tv.RequestRange.RangeEnd))
+			case *pb.RequestOp_RequestPut:
+				l = l.Max(p.Level(tv.RequestPut.Key))
+			case *pb.RequestOp_RequestDeleteRange:
+				l = l.Max(p.RangeLevel(tv.RequestDeleteRange.Key, tv.RequestDeleteRange.RangeEnd))
+			case *pb.RequestOp_RequestTxn:
+				l = l.Max(auditTxnLevel(p, tv.RequestTxn))
+			}
+		}
+	}
+	return l
+}
+
+func auditTxn(r *pb.TxnRequest, l audit.Level) *audit.TxnSummary {
+	s := &audit.TxnSummary{
+		Success: auditTxnOps(r.Success, l),
+		Failure: auditTxnOps(r.Failure, l),
+	}
+	for _, c := range r.Compare {
+		op := audit.Op{Type: strings.ToLower(c.Target.String()), Key: string(c.Key), RangeEnd: string(c.RangeEnd)}
+		if l == audit.LevelRequest {
+			op.Value = c.GetValue()
+		}
+		s.Compares = append(s.Compares, op)
+	}
+	return s
+}
+
+func auditTxnOps(ops []*pb.RequestOp, l audit.Level) []audit.Op {
+	var aops []audit.Op
+	for _, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestRange:
+			aops = append(aops, audit.Op{Type: "range", Key: string(tv.RequestRange.Key), RangeEnd: string(tv.RequestRange.RangeEnd)})
+		case *pb.RequestOp_RequestPut:
+			aop := audit.Op{Type: "put", Key: string(tv.RequestPut.Key)}
+			if l == audit.LevelRequest {
+				aop.Value = tv.RequestPut.Value
+			}
+			aops = append(aops, aop)
+		case *pb.RequestOp_RequestDeleteRange:
+			aops = append(aops, audit.Op{Type: "delete-range", Key: string(tv.RequestDeleteRange.Key), RangeEnd: string(tv.RequestDeleteRange.RangeEnd)})
+		case *pb.RequestOp_RequestTxn:
+			aops = append(aops, audit.Op{Type: "txn", Txn: auditTxn(tv.RequestTxn, l)})
+		}
+	}
+	return aops
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/audit"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	policy, err := audit.ParsePolicy("metadata", "/secrets/=none,/config/=request")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		req  any
+		want *audit.Event
+	}{
+		{
+			name: "put",
+			req:  &pb.PutRequest{Key: []byte("/app/k"), Value: []byte("v"), Lease: 5},
+			want: &audit.Event{Level: audit.LevelMetadata, Key: "/app/k", LeaseID: 5},
+		},
+		{
+			name: "put with value",
+			req:  &pb.PutRequest{Key: []byte("/config/k"), Value: []byte("v")},
+			want: &audit.Event{Level: audit.LevelRequest, Key: "/config/k", Value: []byte("v")},
+		},
+		{
+			name: "put not audited",
+			req:  &pb.PutRequest{Key: []byte("/secrets/k"), Value: []byte("v")},
+		},
+		{
+			name: "delete range",
+			req:  &pb.DeleteRangeRequest{Key: []byte("/app/"), RangeEnd: []byte("/app0")},
+			want: &audit.Event{Level: audit.LevelMetadata, Key: "/app/", RangeEnd: "/app0"},
+		},
+		{
+			name: "delete range starting outside of a prefix",
+			req:  &pb.DeleteRangeRequest{Key: []byte("/a"), RangeEnd: []byte("/d")},
+			want: &audit.Event{Level: audit.LevelRequest, Key: "/a", RangeEnd: "/d"},
+		},
+		{
+			name: "range",
+			req:  &pb.RangeRequest{Key: []byte("/app/k")},
+		},
+		{
+			name: "read-only txn",
+			req:  &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: &pb.RangeRequest{Key: []byte("/app/k")}}}}},
+		},
+		{
+			name: "user add",
+			req:  &pb.AuthUserAddRequest{Name: "alice", Password: "secret"},
+			want: &audit.Event{Level: audit.LevelMetadata, TargetUser: "alice"},
+		},
+		{
+			name: "authenticate",
+			req:  &pb.AuthenticateRequest{Name: "alice", Password: "secret"},
+			want: &audit.Event{Level: audit.LevelMetadata, User: "alice", TargetUser: "alice"},
+		},
+		{
+			name: "role deny permission",
+			req: &pb.AuthRoleGrantPermissionRequest{Name: "app", Perm: &authpb.Permission{
+				PermType: authpb.WRITE, Key: []byte("/app/"), RangeEnd: []byte("/app0"), Deny: true,
+			}},
+			want: &audit.Event{Level: audit.LevelMetadata, TargetRole: "app", Key: "/app/", RangeEnd: "/app0", Permission: "deny WRITE"},
+		},
+		{
+			name: "member remove",
+			req:  &pb.MemberRemoveRequest{ID: 0xabc},
+			want: &audit.Event{Level: audit.LevelMetadata, MemberID: "abc"},
+		},
+		{
+			name: "alarm get",
+			req:  &pb.AlarmRequest{Action: pb.AlarmRequest_GET},
+		},
+		{
+			name: "alarm deactivate",
+			req:  &pb.AlarmRequest{Action: pb.AlarmRequest_DEACTIVATE, MemberID: 1, Alarm: pb.AlarmType_NOSPACE},
+			want: &audit.Event{Level: audit.LevelMetadata, MemberID: "1"},
+		},
+		{
+			name: "status",
+			req:  &pb.StatusRequest{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev := newAuditEvent(policy, "method", tt.req)
+			if tt.want == nil {
+				assert.Nil(t, ev)
+				return
+			}
+			require.NotNil(t, ev)
+			assert.False(t, ev.Time.IsZero())
+			ev.Time = tt.want.Time
+			tt.want.Method = "method"
+			assert.Equal(t, tt.want, ev)
+		})
+	}
+}
+
+func TestNewAuditEventTxn(t *testing.T) {
+	policy, err := audit.ParsePolicy("none", "/config/=request,/app/=metadata")
+	require.NoError(t, err)
+
+	put := func(key, val string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key), Value: []byte(val)}}}
+	}
+	del := &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: &pb.DeleteRangeRequest{Key: []byte("/app/"), RangeEnd: []byte("/app0")}}}
+	cmp := &pb.Compare{Target: pb.Compare_VALUE, Key: []byte("/app/k"), TargetUnion: &pb.Compare_Value{Value: []byte("old")}}
+
+	// the most detailed level of the keys of the transaction applies.
+	txn := &pb.TxnRequest{
+		Compare: []*pb.Compare{cmp},
+		Success: []*pb.RequestOp{put("/app/k", "new"), {Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Success: []*pb.RequestOp{put("/config/k", "v")}}}}},
+		Failure: []*pb.RequestOp{del},
+	}
+	ev := newAuditEvent(policy, "method", txn)
+	require.NotNil(t, ev)
+	assert.Equal(t, audit.LevelRequest, ev.Level)
+	assert.Equal(t, &audit.TxnSummary{
+		Compares: []audit.Op{{Type: "value", Key: "/app/k", Value: []byte("old")}},
+		Success: []audit.Op{
+			{Type: "put", Key: "/app/k", Value: []byte("new")},
+			{Type: "txn", Txn: &audit.TxnSummary{Success: []audit.Op{{Type: "put", Key: "/config/k", Value: []byte("v")}}}},
+		},
+		Failure: []audit.Op{{Type: "delete-range", Key: "/app/", RangeEnd: "/app0"}},
+	}, ev.Txn)
+
+	txn = &pb.TxnRequest{Compare: []*pb.Compare{cmp}, Success: []*pb.RequestOp{put("/app/k", "new")}}
+	ev = newAuditEvent(policy, "method", txn)
+	require.NotNil(t, ev)
+	assert.Equal(t, audit.LevelMetadata, ev.Level)
+	assert.Equal(t, &audit.TxnSummary{
+		Compares: []audit.Op{{Type: "value", Key: "/app/k"}},
+		Success:  []audit.Op{{Type: "put", Key: "/app/k"}},
+	}, ev.Txn)
+
+	auditResponse(ev, &pb.TxnResponse{Header: &pb.ResponseHeader{Revision: 7}, Succeeded: true}, nil)
+	assert.Equal(t, audit.OutcomeSuccess, ev.Outcome)
+	assert.Equal(t, int64(7), ev.Revision)
+	require.NotNil(t, ev.Txn.Succeeded)
+	assert.True(t, *ev.Txn.Succeeded)
+
+	// the keys matching no prefix are not audited.
+	assert.Nil(t, newAuditEvent(policy, "method", &pb.TxnRequest{Success: []*pb.RequestOp{put("/other", "v")}}))
+
+	// a range of the transaction is audited at the level of all the prefixes it covers.
+	all := &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: &pb.DeleteRangeRequest{Key: []byte("/"), RangeEnd: []byte{0}}}}
+	ev = newAuditEvent(policy, "method", &pb.TxnRequest{Success: []*pb.RequestOp{all}})
+	require.NotNil(t, ev)
+	assert.Equal(t, audit.LevelRequest, ev.Level)
+}
+
+func TestAuditResponse(t *testing.T) {
+	ev := &audit.Event{}
+	auditResponse(ev, (*pb.PutResponse)(nil), rpctypes.ErrGRPCPermissionDenied)
+	assert.Equal(t, audit.OutcomeFailure, ev.Outcome)
+	assert.Equal(t, rpctypes.ErrGRPCPermissionDenied.Error(), ev.Error)
+
+	ev = &audit.Event{}
+	auditResponse(ev, &pb.LeaseGrantResponse{Header: &pb.ResponseHeader{Revision: 3}, ID: 42}, nil)
+	assert.Equal(t, &audit.Event{Outcome: audit.OutcomeSuccess, Revision: 3, LeaseID: 42}, ev)
+
+	ev = &audit.Event{}
+	auditResponse(ev, &pb.MemberAddResponse{Header: &pb.ResponseHeader{}, Member: &pb.Member{ID: 0x10}}, nil)
+	assert.Equal(t, &audit.Event{Outcome: audit.OutcomeSuccess, MemberID: "10"}, ev)
+}
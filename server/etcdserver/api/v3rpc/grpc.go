@@ -52,8 +52,18 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 
 	chainUnaryInterceptors := []grpc.UnaryServerInterceptor{
 		newLogUnaryInterceptor(s),
+	}
+	if s.Cfg.Auditor != nil {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newAuditUnaryInterceptor(s, s.Cfg.Auditor))
+	}
+	chainUnaryInterceptors = append(chainUnaryInterceptors,
 		newUnaryInterceptor(s),
 		serverMetrics.UnaryServerInterceptor(),
+	)
+	var ac *admissionController
+	if s.Cfg.GRPCRateLimit > 0 {
+		ac = newAdmissionController(s.AuthStore(), s.Cfg.GRPCRateLimit, s.Cfg.GRPCRateLimitBurst)
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newAdmissionUnaryInterceptor(ac))
 	}
 	if interceptor != nil {
 		chainUnaryInterceptors = append(chainUnaryInterceptors, interceptor)
@@ -63,6 +73,9 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 		newStreamInterceptor(s),
 		serverMetrics.StreamServerInterceptor(),
 	}
+	if ac != nil {
+		chainStreamInterceptors = append(chainStreamInterceptors, newAdmissionStreamInterceptor(ac))
+	}
 
 	if s.Cfg.EnableDistributedTracing {
 		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler(s.Cfg.TracerOptions...)))
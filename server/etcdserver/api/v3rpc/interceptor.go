@@ -34,8 +34,9 @@ import (
 )
 
 const (
-	maxNoLeaderCnt = 3
-	snapshotMethod = "/etcdserverpb.Maintenance/Snapshot"
+	maxNoLeaderCnt      = 3
+	snapshotMethod      = "/etcdserverpb.Maintenance/Snapshot"
+	snapshotDeltaMethod = "/etcdserverpb.Maintenance/SnapshotDelta"
 )
 
 type streamsMap struct {
@@ -220,7 +221,7 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotCapable
 		}
 
-		if s.IsMemberExist(s.MemberID()) && s.IsLearner() && info.FullMethod != snapshotMethod { // learner does not support stream RPC except Snapshot
+		if s.IsMemberExist(s.MemberID()) && s.IsLearner() && info.FullMethod != snapshotMethod && info.FullMethod != snapshotDeltaMethod { // learner does not support stream RPC except Snapshot and SnapshotDelta
 			return rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
@@ -22,6 +22,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/pkg/v3/adt"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/lease"
 )
 
 type kvServer struct {
@@ -137,6 +138,12 @@ func checkPutRequest(r *pb.PutRequest) error {
 	if r.IgnoreLease && r.Lease != 0 {
 		return rpctypes.ErrGRPCLeaseProvided
 	}
+	if r.TTL < 0 || r.TTL > lease.MaxLeaseTTL {
+		return rpctypes.ErrGRPCInvalidKeyTTL
+	}
+	if r.TTL > 0 && (r.Lease != 0 || r.IgnoreLease) {
+		return rpctypes.ErrGRPCKeyTTLWithLease
+	}
 	return nil
 }
 
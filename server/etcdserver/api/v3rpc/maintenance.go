@@ -62,6 +62,14 @@ type Downgrader interface {
 	Downgrade(ctx context.Context, dr *pb.DowngradeRequest) (*pb.DowngradeResponse, error)
 }
 
+type PrefixQuotaManager interface {
+	PrefixQuota(ctx context.Context, r *pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error)
+}
+
+type ConfigReloader interface {
+	ReloadConfig(ctx context.Context, r *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error)
+}
+
 type LeaderTransferrer interface {
 	MoveLeader(ctx context.Context, lead, target uint64) error
 }
@@ -72,15 +80,21 @@ type ClusterStatusGetter interface {
 
 type ConfigGetter interface {
 	Config() config.ServerConfig
+	// QuotaBackendBytes returns the backend quota, which can be changed at
+	// runtime.
+	QuotaBackendBytes() int64
 }
 
 type maintenanceServer struct {
 	lg     *zap.Logger
 	rg     apply.RaftStatusGetter
 	hasher mvcc.HashStorage
+	kg     KVGetter
 	bg     BackendGetter
 	defrag Defrager
 	a      Alarmer
+	pq     PrefixQuotaManager
+	cr     ConfigReloader
 	lt     LeaderTransferrer
 	hdr    header
 	cs     ClusterStatusGetter
@@ -96,9 +110,12 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 		lg:             s.Cfg.Logger,
 		rg:             s,
 		hasher:         s.KV().HashStorage(),
+		kg:             s,
 		bg:             s,
 		defrag:         s,
 		a:              s,
+		pq:             s,
+		cr:             s,
 		lt:             s,
 		hdr:            newHeader(s),
 		cs:             s,
@@ -217,6 +234,76 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 	return nil
 }
 
+func (ms *maintenanceServer) SnapshotDelta(sr *pb.SnapshotDeltaRequest, srv pb.Maintenance_SnapshotDeltaServer) error {
+	ver := schema.ReadStorageVersion(ms.bg.Backend().ReadTx())
+	storageVersion := ""
+	if ver != nil {
+		storageVersion = ver.String()
+	}
+	hdr := &pb.ResponseHeader{}
+	ms.hdr.fill(hdr)
+	rev := sr.Revision
+	if rev == 0 {
+		rev = hdr.Revision
+	}
+	if sr.SinceRevision < 0 || sr.SinceRevision > rev {
+		return rpctypes.ErrGRPCFutureRev
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	go func() {
+		pw.CloseWithError(mvcc.WriteDelta(pw, ms.kg.KV(), ms.bg.Backend(), sr.SinceRevision, rev))
+	}()
+
+	// record SHA digest of delta data
+	// used for integrity checks during snapshot restore operation
+	h := sha256.New()
+
+	sent := int64(0)
+	start := time.Now()
+	ms.lg.Info("sending database snapshot delta to client",
+		zap.Int64("since-revision", sr.SinceRevision),
+		zap.Int64("revision", rev),
+		zap.String("storage-version", storageVersion),
+	)
+	for {
+		// NOTE: srv.Send does not wait until the message is received by the client.
+		// Therefore the buffer can not be safely reused between Send operations
+		buf := make([]byte, snapshotSendBufferSize)
+
+		n, err := io.ReadFull(pr, buf)
+		if err != nil && !errorspkg.Is(err, io.EOF) && !errorspkg.Is(err, io.ErrUnexpectedEOF) {
+			return togRPCError(err)
+		}
+		if n > 0 {
+			resp := &pb.SnapshotDeltaResponse{Header: hdr, Blob: buf[:n], Version: storageVersion}
+			if serr := srv.Send(resp); serr != nil {
+				return togRPCError(serr)
+			}
+			h.Write(buf[:n])
+			sent += int64(n)
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	hresp := &pb.SnapshotDeltaResponse{Header: hdr, Blob: h.Sum(nil), Version: storageVersion}
+	if err := srv.Send(hresp); err != nil {
+		return togRPCError(err)
+	}
+
+	ms.lg.Info("successfully sent database snapshot delta to client",
+		zap.Int64("since-revision", sr.SinceRevision),
+		zap.Int64("revision", rev),
+		zap.String("size", humanize.Bytes(uint64(sent))),
+		zap.Duration("took", time.Since(start)),
+	)
+	return nil
+}
+
 func (ms *maintenanceServer) Hash(ctx context.Context, r *pb.HashRequest) (*pb.HashResponse, error) {
 	h, rev, err := ms.hasher.Hash()
 	if err != nil {
@@ -255,6 +342,54 @@ func (ms *maintenanceServer) Alarm(ctx context.Context, ar *pb.AlarmRequest) (*p
 	return resp, nil
 }
 
+func (ms *maintenanceServer) PrefixQuota(ctx context.Context, r *pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error) {
+	if err := checkPrefixQuotaRequest(r); err != nil {
+		return nil, err
+	}
+	resp, err := ms.pq.PrefixQuota(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	if resp.Header == nil {
+		resp.Header = &pb.ResponseHeader{}
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func checkPrefixQuotaRequest(r *pb.PrefixQuotaRequest) error {
+	switch r.Action {
+	case pb.PrefixQuotaRequest_GET:
+		return nil
+	case pb.PrefixQuotaRequest_PUT:
+		if r.Quota == nil || r.Quota.QuotaBytes < 0 || r.Quota.QuotaKeys < 0 {
+			return rpctypes.ErrGRPCInvalidPrefixQuota
+		}
+		if r.Quota.QuotaBytes == 0 && r.Quota.QuotaKeys == 0 {
+			return rpctypes.ErrGRPCInvalidPrefixQuota
+		}
+		return nil
+	case pb.PrefixQuotaRequest_DELETE:
+		if r.Quota == nil {
+			return rpctypes.ErrGRPCInvalidPrefixQuota
+		}
+		return nil
+	default:
+		return rpctypes.ErrGRPCInvalidPrefixQuota
+	}
+}
+
+func (ms *maintenanceServer) ReloadConfig(ctx context.Context, r *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error) {
+	resp, err := ms.cr.ReloadConfig(ctx, r)
+	if err != nil {
+		ms.lg.Warn("failed to reload configuration", zap.Error(err))
+		return nil, togRPCError(err)
+	}
+	resp.Header = &pb.ResponseHeader{}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
 	hdr := &pb.ResponseHeader{}
 	ms.hdr.fill(hdr)
@@ -268,7 +403,7 @@ func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (
 		DbSize:           ms.bg.Backend().Size(),
 		DbSizeInUse:      ms.bg.Backend().SizeInUse(),
 		IsLearner:        ms.cs.IsLearner(),
-		DbSizeQuota:      ms.cg.Config().QuotaBackendBytes,
+		DbSizeQuota:      ms.cg.QuotaBackendBytes(),
 		DowngradeInfo:    &pb.DowngradeInfo{Enabled: false},
 	}
 	if resp.DbSizeQuota == 0 {
@@ -334,6 +469,14 @@ func (ams *authMaintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Mainte
 	return ams.maintenanceServer.Snapshot(sr, srv)
 }
 
+func (ams *authMaintenanceServer) SnapshotDelta(sr *pb.SnapshotDeltaRequest, srv pb.Maintenance_SnapshotDeltaServer) error {
+	if err := ams.isPermitted(srv.Context()); err != nil {
+		return togRPCError(err)
+	}
+
+	return ams.maintenanceServer.SnapshotDelta(sr, srv)
+}
+
 func (ams *authMaintenanceServer) Hash(ctx context.Context, r *pb.HashRequest) (*pb.HashResponse, error) {
 	if err := ams.isPermitted(ctx); err != nil {
 		return nil, togRPCError(err)
@@ -349,6 +492,22 @@ func (ams *authMaintenanceServer) HashKV(ctx context.Context, r *pb.HashKVReques
 	return ams.maintenanceServer.HashKV(ctx, r)
 }
 
+func (ams *authMaintenanceServer) PrefixQuota(ctx context.Context, r *pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.PrefixQuota(ctx, r)
+}
+
+func (ams *authMaintenanceServer) ReloadConfig(ctx context.Context, r *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.ReloadConfig(ctx, r)
+}
+
 func (ams *authMaintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
 	if err := ams.isPermitted(ctx); err != nil {
 		return nil, togRPCError(err)
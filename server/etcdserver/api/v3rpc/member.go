@@ -18,6 +18,8 @@ import (
 	"context"
 	"time"
 
+	"go.uber.org/zap"
+
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -94,6 +96,20 @@ func (cs *ClusterServer) MemberList(ctx context.Context, r *pb.MemberListRequest
 		}
 	}
 	membs := membersToProtoMembers(cs.cluster.Members())
+	promotions, err := cs.server.LeaderLearnerPromotions(ctx)
+	if err != nil {
+		// the leader may not serve the promotion state yet during an upgrade;
+		// the member list is still served without it.
+		cs.server.Logger().Warn("failed to get learner promotions from leader", zap.Error(err))
+	}
+	for _, m := range membs {
+		if lp, ok := promotions[types.ID(m.ID)]; ok {
+			m.LearnerPromotion = &pb.LearnerPromotion{Lag: lp.Lag}
+			if !lp.CaughtUpSince.IsZero() {
+				m.LearnerPromotion.CaughtUpSince = lp.CaughtUpSince.Unix()
+			}
+		}
+	}
 	return &pb.MemberListResponse{Header: cs.header(), Members: membs}, nil
 }
 
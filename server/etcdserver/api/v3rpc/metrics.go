@@ -43,6 +43,23 @@ var (
 		[]string{"Type", "API"},
 	)
 
+	watchCoalescedEvents = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "watch_coalesced_events_total",
+		Help:      "The total number of watch events not sent to coalescing watchers as a later event of the same key replaced them.",
+	})
+
+	rateLimitedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "client_requests_rate_limited_total",
+			Help:      "The total number of client requests rejected by the rate limit, per priority.",
+		},
+		[]string{"priority"},
+	)
+
 	clientRequests = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "etcd",
@@ -58,5 +75,7 @@ func init() {
 	prometheus.MustRegister(sentBytes)
 	prometheus.MustRegister(receivedBytes)
 	prometheus.MustRegister(streamFailures)
+	prometheus.MustRegister(watchCoalescedEvents)
+	prometheus.MustRegister(rateLimitedRequests)
 	prometheus.MustRegister(clientRequests)
 }
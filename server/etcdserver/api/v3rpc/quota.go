@@ -27,6 +27,12 @@ import (
 type quotaKVServer struct {
 	pb.KVServer
 	qa quotaAlarmer
+	pq prefixQuotaGetter
+}
+
+type prefixQuotaGetter interface {
+	KVGetter
+	PrefixQuotas() *storage.PrefixQuotaStore
 }
 
 type quotaAlarmer struct {
@@ -54,10 +60,25 @@ func NewQuotaKVServer(s *etcdserver.EtcdServer) pb.KVServer {
 	return &quotaKVServer{
 		NewKVServer(s),
 		quotaAlarmer{newBackendQuota(s, "kv"), s, s.MemberID()},
+		s,
+	}
+}
+
+// checkPrefixQuota rejects requests which would exceed the quota of a prefix
+// early, without proposing them. The quota is enforced again when the request
+// is applied.
+func (s *quotaKVServer) checkPrefixQuota(r any) error {
+	pqs := s.pq.PrefixQuotas()
+	if pqs == nil || storage.NewPrefixQuota(pqs, s.pq.KV()).Available(r) {
+		return nil
 	}
+	return rpctypes.ErrGRPCPrefixQuotaExceeded
 }
 
 func (s *quotaKVServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := s.checkPrefixQuota(r); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
@@ -65,6 +86,9 @@ func (s *quotaKVServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutRespo
 }
 
 func (s *quotaKVServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if err := s.checkPrefixQuota(r); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
@@ -91,5 +115,5 @@ func NewQuotaLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
 }
 
 func newBackendQuota(s *etcdserver.EtcdServer, name string) storage.Quota {
-	return storage.NewBackendQuota(s.Logger(), s.Cfg.QuotaBackendBytes, s.Backend(), name)
+	return storage.NewReloadableBackendQuota(s.Logger(), s.QuotaBackendBytes, s.Backend(), name)
 }
@@ -48,6 +48,11 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrNoSpace:         rpctypes.ErrGRPCNoSpace,
 	errors.ErrTooManyRequests: rpctypes.ErrTooManyRequests,
 
+	errors.ErrPrefixQuotaExceeded: rpctypes.ErrGRPCPrefixQuotaExceeded,
+	errors.ErrPrefixQuotaNotFound: rpctypes.ErrGRPCPrefixQuotaNotFound,
+
+	errors.ErrConfigReloadNotSupported: rpctypes.ErrGRPCConfigReloadNotSupported,
+
 	errors.ErrNoLeader:                   rpctypes.ErrGRPCNoLeader,
 	errors.ErrNotLeader:                  rpctypes.ErrGRPCNotLeader,
 	errors.ErrLeaderChanged:              rpctypes.ErrGRPCLeaderChanged,
@@ -58,10 +63,13 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrTimeoutWaitAppliedIndex:    rpctypes.ErrGRPCTimeoutWaitAppliedIndex,
 	errors.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
+	errors.ErrInvalidContinueToken:       rpctypes.ErrGRPCInvalidContinueToken,
+	errors.ErrContinueTokenCompacted:     rpctypes.ErrGRPCContinueTokenCompacted,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
 
 	errors.ErrClusterVersionUnavailable:      rpctypes.ErrGRPCClusterVersionUnavailable,
+	errors.ErrClusterVersionTooLow:           rpctypes.ErrGRPCClusterVersionTooLow,
 	errors.ErrWrongDowngradeVersionFormat:    rpctypes.ErrGRPCWrongDowngradeVersionFormat,
 	version.ErrInvalidDowngradeTargetVersion: rpctypes.ErrGRPCInvalidDowngradeTargetVersion,
 	version.ErrDowngradeInProcess:            rpctypes.ErrGRPCDowngradeInProcess,
@@ -141,7 +149,7 @@ func isClientCtxErr(ctxErr error, err error) bool {
 // in v3.4, learner is allowed to serve serializable read and endpoint status
 func isRPCSupportedForLearner(req any) bool {
 	switch r := req.(type) {
-	case *pb.StatusRequest:
+	case *pb.StatusRequest, *pb.ReloadConfigRequest:
 		return true
 	case *pb.RangeRequest:
 		return r.Serializable
@@ -136,7 +136,7 @@ type serverWatchStream struct {
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, coalesce
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -145,6 +145,8 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// records the coalescing options of watch IDs
+	coalesce map[mvcc.WatchID]watchCoalesce
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -174,6 +176,7 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		progress: make(map[mvcc.WatchID]bool),
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
+		coalesce: make(map[mvcc.WatchID]watchCoalesce),
 
 		closec: make(chan struct{}),
 	}
@@ -320,8 +323,16 @@ func (sws *serverWatchStream) recvLoop() error {
 			}
 
 			filters := FiltersFromRequest(creq)
+			valueFilters, err := ValueFiltersFromRequest(creq)
+			var coalesce watchCoalesce
+			if err == nil {
+				coalesce, err = coalesceFromRequest(creq)
+			}
 
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, creq.StartRevision, filters...)
+			var id mvcc.WatchID
+			if err == nil {
+				id, err = sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, creq.StartRevision, append(filters, valueFilters...)...)
+			}
 			if err == nil {
 				sws.mu.Lock()
 				if creq.ProgressNotify {
@@ -333,6 +344,9 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
+				if coalesce.window > 0 {
+					sws.coalesce[id] = coalesce
+				}
 				sws.mu.Unlock()
 			} else {
 				id = clientv3.InvalidWatchID
@@ -373,6 +387,7 @@ func (sws *serverWatchStream) recvLoop() error {
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
+					delete(sws.coalesce, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
@@ -398,11 +413,52 @@ func (sws *serverWatchStream) sendLoop() {
 	// watch responses pending on a watch id creation message
 	pending := make(map[mvcc.WatchID][]*pb.WatchResponse)
 
+	// events held for coalescing watchers
+	held := make(map[mvcc.WatchID]*watchCoalescer)
+
 	interval := GetProgressReportInterval()
 	progressTicker := time.NewTicker(interval)
 
+	// coalesceTimer fires at the earliest deadline of the held events.
+	coalesceTimer := time.NewTimer(time.Hour)
+	coalesceTimer.Stop()
+	var coalescec <-chan time.Time
+	resetCoalesceTimer := func() {
+		coalesceTimer.Stop()
+		coalescec = nil
+		var deadline time.Time
+		for _, c := range held {
+			if deadline.IsZero() || c.deadline.Before(deadline) {
+				deadline = c.deadline
+			}
+		}
+		if !deadline.IsZero() {
+			coalesceTimer.Reset(time.Until(deadline))
+			coalescec = coalesceTimer.C
+		}
+	}
+	// flushHeld sends the events held for the given watch ID, or for all watch
+	// IDs if it is InvalidWatchID, that are due at now if it is not zero.
+	flushHeld := func(id mvcc.WatchID, now time.Time) bool {
+		for hid, c := range held {
+			if id != clientv3.InvalidWatchID && hid != id {
+				continue
+			}
+			if !now.IsZero() && now.Before(c.deadline) {
+				continue
+			}
+			delete(held, hid)
+			if !sws.sendWatchResponse(c.response(), hid) {
+				return false
+			}
+		}
+		resetCoalesceTimer()
+		return true
+	}
+
 	defer func() {
 		progressTicker.Stop()
+		coalesceTimer.Stop()
 		// drain the chan to clean up pending events
 		for ws := range sws.watchStream.Chan() {
 			mvcc.ReportEventReceived(len(ws.Events))
@@ -463,33 +519,35 @@ func (sws *serverWatchStream) sendLoop() {
 			mvcc.ReportEventReceived(len(evs))
 
 			sws.mu.RLock()
-			fragmented, ok := sws.fragment[wresp.WatchID]
+			coalesce, coalescing := sws.coalesce[wresp.WatchID]
 			sws.mu.RUnlock()
-
-			var serr error
-			// gofail: var beforeSendWatchResponse struct{}
-			if !fragmented && !ok {
-				serr = sws.gRPCStream.Send(wr)
-			} else {
-				serr = sendFragments(wr, sws.maxRequestBytes, sws.gRPCStream.Send)
-			}
-
-			if serr != nil {
-				if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
-					sws.lg.Debug("failed to send watch response to gRPC stream", zap.Error(serr))
-				} else {
-					sws.lg.Warn("failed to send watch response to gRPC stream", zap.Error(serr))
-					streamFailures.WithLabelValues("send", "watch").Inc()
+			if coalescing && len(evs) > 0 && !canceled {
+				c, ok := held[wresp.WatchID]
+				if !ok {
+					c = newWatchCoalescer(coalesce, time.Now())
+					held[wresp.WatchID] = c
+				}
+				c.add(wr)
+				if !c.full() {
+					if !ok {
+						resetCoalesceTimer()
+					}
+					sws.elideProgress(wresp.WatchID)
+					continue
+				}
+				delete(held, wresp.WatchID)
+				resetCoalesceTimer()
+				wr = c.response()
+			} else if len(held) > 0 {
+				// progress notifications and cancellations follow the held events.
+				if !flushHeld(wresp.WatchID, time.Time{}) {
+					return
 				}
-				return
 			}
 
-			sws.mu.Lock()
-			if len(evs) > 0 && sws.progress[wresp.WatchID] {
-				// elide next progress update if sent a key update
-				sws.progress[wresp.WatchID] = false
+			if !sws.sendWatchResponse(wr, wresp.WatchID) {
+				return
 			}
-			sws.mu.Unlock()
 
 		case c, ok := <-sws.ctrlStream:
 			if !ok {
@@ -513,6 +571,10 @@ func (sws *serverWatchStream) sendLoop() {
 
 			if c.Canceled && wid != clientv3.InvalidWatchID {
 				delete(ids, wid)
+				if _, ok := held[wid]; ok {
+					delete(held, wid)
+					resetCoalesceTimer()
+				}
 				continue
 			}
 			if c.Created {
@@ -543,12 +605,58 @@ func (sws *serverWatchStream) sendLoop() {
 			}
 			sws.mu.Unlock()
 
+		case now := <-coalescec:
+			if !flushHeld(clientv3.InvalidWatchID, now) {
+				return
+			}
+
 		case <-sws.closec:
 			return
 		}
 	}
 }
 
+// sendWatchResponse sends a watch response of the given watch ID, split in
+// fragments if the watcher accepts them. It returns false if the stream failed.
+func (sws *serverWatchStream) sendWatchResponse(wr *pb.WatchResponse, id mvcc.WatchID) bool {
+	sws.mu.RLock()
+	fragmented, ok := sws.fragment[id]
+	sws.mu.RUnlock()
+
+	var serr error
+	// gofail: var beforeSendWatchResponse struct{}
+	if !fragmented && !ok {
+		serr = sws.gRPCStream.Send(wr)
+	} else {
+		serr = sendFragments(wr, sws.maxRequestBytes, sws.gRPCStream.Send)
+	}
+
+	if serr != nil {
+		if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
+			sws.lg.Debug("failed to send watch response to gRPC stream", zap.Error(serr))
+		} else {
+			sws.lg.Warn("failed to send watch response to gRPC stream", zap.Error(serr))
+			streamFailures.WithLabelValues("send", "watch").Inc()
+		}
+		return false
+	}
+
+	if len(wr.Events) > 0 {
+		sws.elideProgress(id)
+	}
+	return true
+}
+
+// elideProgress elides the next progress update of the given watch ID, as a
+// key update is sent.
+func (sws *serverWatchStream) elideProgress(id mvcc.WatchID) {
+	sws.mu.Lock()
+	if sws.progress[id] {
+		sws.progress[id] = false
+	}
+	sws.mu.Unlock()
+}
+
 func IsCreateEvent(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT && e.Kv.CreateRevision == e.Kv.ModRevision
 }
@@ -0,0 +1,115 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"errors"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+var errInvalidWatchCoalesce = errors.New("etcdserver: invalid watch coalescing options")
+
+// watchCoalesce is the coalescing options of a watcher.
+type watchCoalesce struct {
+	// window is the maximum time events are held for.
+	window time.Duration
+	// revisions is the number of revisions the held events are sent at, if
+	// not zero.
+	revisions int64
+}
+
+// coalesceFromRequest returns the coalescing options of a given watch create
+// request. Coalescing is disabled if the window is zero.
+func coalesceFromRequest(creq *pb.WatchCreateRequest) (watchCoalesce, error) {
+	if creq.CoalesceWindowMs < 0 || creq.CoalesceRevisions < 0 ||
+		(creq.CoalesceRevisions != 0 && creq.CoalesceWindowMs == 0) {
+		return watchCoalesce{}, errInvalidWatchCoalesce
+	}
+	return watchCoalesce{
+		window:    time.Duration(creq.CoalesceWindowMs) * time.Millisecond,
+		revisions: creq.CoalesceRevisions,
+	}, nil
+}
+
+// watchCoalescer holds the events of a coalescing watcher, keeping only the
+// latest event of each key.
+type watchCoalescer struct {
+	opts     watchCoalesce
+	deadline time.Time
+
+	// last is the last response added, whose header the events are sent with.
+	last     *pb.WatchResponse
+	firstRev int64
+	lastRev  int64
+	// events are the held events in revision order, with nil in place of the
+	// events replaced by a later event of the same key.
+	events    []*mvccpb.Event
+	keys      map[string]int
+	coalesced int
+}
+
+func newWatchCoalescer(opts watchCoalesce, now time.Time) *watchCoalescer {
+	return &watchCoalescer{
+		opts:     opts,
+		deadline: now.Add(opts.window),
+		keys:     make(map[string]int),
+	}
+}
+
+// add holds the events of wr. The previous key-value of an event replacing
+// another one is the previous key-value of the replaced event, so it is the
+// key-value before the held events.
+func (c *watchCoalescer) add(wr *pb.WatchResponse) {
+	c.last = wr
+	for _, ev := range wr.Events {
+		if c.firstRev == 0 {
+			c.firstRev = ev.Kv.ModRevision
+		}
+		c.lastRev = ev.Kv.ModRevision
+		k := string(ev.Kv.Key)
+		if i, ok := c.keys[k]; ok {
+			ev.PrevKv = c.events[i].PrevKv
+			c.events[i] = nil
+			c.coalesced++
+		}
+		c.keys[k] = len(c.events)
+		c.events = append(c.events, ev)
+	}
+}
+
+// full returns true if the held events span the revisions of the options.
+func (c *watchCoalescer) full() bool {
+	return c.opts.revisions != 0 && c.lastRev-c.firstRev+1 >= c.opts.revisions
+}
+
+// response returns the response sending the held events.
+func (c *watchCoalescer) response() *pb.WatchResponse {
+	events := make([]*mvccpb.Event, 0, len(c.keys))
+	for _, ev := range c.events {
+		if ev != nil {
+			events = append(events, ev)
+		}
+	}
+	watchCoalescedEvents.Add(float64(c.coalesced))
+	return &pb.WatchResponse{
+		Header:    c.last.Header,
+		WatchId:   c.last.WatchId,
+		Events:    events,
+		Coalesced: c.coalesced != 0,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestCoalesceFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		creq    *pb.WatchCreateRequest
+		want    watchCoalesce
+		wantErr bool
+	}{
+		{
+			name: "disabled",
+			creq: &pb.WatchCreateRequest{},
+		},
+		{
+			name: "window",
+			creq: &pb.WatchCreateRequest{CoalesceWindowMs: 100},
+			want: watchCoalesce{window: 100 * time.Millisecond},
+		},
+		{
+			name: "window and revisions",
+			creq: &pb.WatchCreateRequest{CoalesceWindowMs: 100, CoalesceRevisions: 10},
+			want: watchCoalesce{window: 100 * time.Millisecond, revisions: 10},
+		},
+		{
+			name:    "revisions without window",
+			creq:    &pb.WatchCreateRequest{CoalesceRevisions: 10},
+			wantErr: true,
+		},
+		{
+			name:    "negative window",
+			creq:    &pb.WatchCreateRequest{CoalesceWindowMs: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coalesceFromRequest(tt.creq)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errInvalidWatchCoalesce)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWatchCoalescer(t *testing.T) {
+	kv := func(key, value string, rev int64) *mvccpb.KeyValue {
+		return &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value), CreateRevision: 2, ModRevision: rev}
+	}
+	put := func(key, value string, rev int64, prev *mvccpb.KeyValue) *mvccpb.Event {
+		return &mvccpb.Event{Type: mvccpb.PUT, Kv: kv(key, value, rev), PrevKv: prev}
+	}
+	resp := func(rev int64, evs ...*mvccpb.Event) *pb.WatchResponse {
+		return &pb.WatchResponse{Header: &pb.ResponseHeader{Revision: rev}, WatchId: 1, Events: evs}
+	}
+
+	now := time.Now()
+	c := newWatchCoalescer(watchCoalesce{window: time.Second, revisions: 4}, now)
+	assert.Equal(t, now.Add(time.Second), c.deadline)
+
+	c.add(resp(3, put("a", "1", 3, kv("a", "0", 2))))
+	c.add(resp(4, put("b", "1", 4, nil)))
+	assert.False(t, c.full())
+	c.add(resp(5, put("a", "2", 5, kv("a", "1", 3))))
+	assert.False(t, c.full())
+	c.add(resp(6, &mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("b"), ModRevision: 6}, PrevKv: kv("b", "1", 4)}))
+	assert.True(t, c.full())
+
+	got := c.response()
+	assert.True(t, got.Coalesced)
+	assert.Equal(t, int64(6), got.Header.Revision)
+	assert.Equal(t, int64(1), got.WatchId)
+	require.Len(t, got.Events, 2)
+	// the previous key-value of a coalesced event is the one before the held events.
+	assert.Equal(t, put("a", "2", 5, kv("a", "0", 2)), got.Events[0])
+	assert.Equal(t, mvccpb.DELETE, got.Events[1].Type)
+	assert.Equal(t, []byte("b"), got.Events[1].Kv.Key)
+	assert.Nil(t, got.Events[1].PrevKv)
+
+	c = newWatchCoalescer(watchCoalesce{window: time.Second}, now)
+	c.add(resp(3, put("a", "1", 3, nil), put("b", "1", 3, nil)))
+	got = c.response()
+	assert.False(t, got.Coalesced)
+	assert.Len(t, got.Events, 2)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+var errInvalidWatchValueFilter = errors.New("etcdserver: invalid watch value filter")
+
+// valuePredicate returns true if the value of a put event matches the filter.
+type valuePredicate func(value []byte) bool
+
+// ValueFiltersFromRequest returns "mvcc.FilterFunc" evaluating the value filters
+// of a given watch create request. It returns an error if any of the value filters
+// is malformed.
+func ValueFiltersFromRequest(creq *pb.WatchCreateRequest) ([]mvcc.FilterFunc, error) {
+	if len(creq.ValueFilters) == 0 {
+		return nil, nil
+	}
+	preds := make([]valuePredicate, 0, len(creq.ValueFilters))
+	for _, vf := range creq.ValueFilters {
+		pred, err := newValuePredicate(vf)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+	}
+	filter := func(e mvccpb.Event) bool {
+		if e.Type != mvccpb.PUT {
+			return false
+		}
+		for _, pred := range preds {
+			if !pred(e.Kv.Value) {
+				return true
+			}
+		}
+		return false
+	}
+	return []mvcc.FilterFunc{filter}, nil
+}
+
+func newValuePredicate(vf *pb.WatchValueFilter) (valuePredicate, error) {
+	if vf == nil {
+		return nil, fmt.Errorf("%w: empty filter", errInvalidWatchValueFilter)
+	}
+	switch vf.Type {
+	case pb.WatchValueFilter_PREFIX:
+		prefix := vf.Value
+		return func(value []byte) bool { return bytes.HasPrefix(value, prefix) }, nil
+
+	case pb.WatchValueFilter_RANGE:
+		start, end := vf.Value, vf.RangeEnd
+		if len(end) > 0 && bytes.Compare(start, end) >= 0 {
+			return nil, fmt.Errorf("%w: range_end must be greater than value", errInvalidWatchValueFilter)
+		}
+		return func(value []byte) bool {
+			return bytes.Compare(value, start) >= 0 && (len(end) == 0 || bytes.Compare(value, end) < 0)
+		}, nil
+
+	case pb.WatchValueFilter_JSON_FIELD:
+		if len(vf.Path) == 0 {
+			return nil, fmt.Errorf("%w: json field filter requires a path", errInvalidWatchValueFilter)
+		}
+		var want any
+		if err := json.Unmarshal(vf.Value, &want); err != nil {
+			return nil, fmt.Errorf("%w: json field value is not valid JSON: %v", errInvalidWatchValueFilter, err)
+		}
+		path := strings.Split(vf.Path, ".")
+		return func(value []byte) bool { return matchJSONField(value, path, want) }, nil
+
+	case pb.WatchValueFilter_PROTO_FIELD:
+		if len(vf.FieldPath) == 0 {
+			return nil, fmt.Errorf("%w: proto field filter requires a field path", errInvalidWatchValueFilter)
+		}
+		for _, num := range vf.FieldPath {
+			if !protowire.Number(num).IsValid() {
+				return nil, fmt.Errorf("%w: invalid field number %d", errInvalidWatchValueFilter, num)
+			}
+		}
+		fieldPath, want := vf.FieldPath, vf.Value
+		return func(value []byte) bool { return matchProtoField(value, fieldPath, want) }, nil
+
+	default:
+		return nil, fmt.Errorf("%w: unknown filter type %v", errInvalidWatchValueFilter, vf.Type)
+	}
+}
+
+// matchJSONField returns true if the JSON document holds a field at path
+// that is deeply equal to want. Documents that fail to decode never match.
+func matchJSONField(value []byte, path []string, want any) bool {
+	var doc any
+	if err := json.Unmarshal(value, &doc); err != nil {
+		return false
+	}
+	for _, name := range path {
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return false
+		}
+		if doc, ok = obj[name]; !ok {
+			return false
+		}
+	}
+	return reflect.DeepEqual(doc, want)
+}
+
+// matchProtoField returns true if any occurrence of the field at fieldPath in
+// the wire encoded message has the payload want. Every occurrence of an
+// embedded message along the path is searched, since repeated occurrences of
+// a message field are merged on decoding.
+func matchProtoField(msg []byte, fieldPath []int32, want []byte) bool {
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		if n < 0 {
+			return false
+		}
+		msg = msg[n:]
+		m := protowire.ConsumeFieldValue(num, typ, msg)
+		if m < 0 {
+			return false
+		}
+		payload := msg[:m]
+		msg = msg[m:]
+		if num != protowire.Number(fieldPath[0]) {
+			continue
+		}
+		if typ == protowire.BytesType {
+			payload, _ = protowire.ConsumeBytes(payload)
+		}
+		if len(fieldPath) == 1 {
+			if bytes.Equal(payload, want) {
+				return true
+			}
+			continue
+		}
+		if typ == protowire.BytesType && matchProtoField(payload, fieldPath[1:], want) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestValueFiltersFromRequest(t *testing.T) {
+	// message { 1: "pod-a", 2: { 1: "node-1", 2: 7 }, 2: { 1: "node-2" } }
+	var nested1, nested2, msg []byte
+	nested1 = protowire.AppendTag(nested1, 1, protowire.BytesType)
+	nested1 = protowire.AppendString(nested1, "node-1")
+	nested1 = protowire.AppendTag(nested1, 2, protowire.VarintType)
+	nested1 = protowire.AppendVarint(nested1, 7)
+	nested2 = protowire.AppendTag(nested2, 1, protowire.BytesType)
+	nested2 = protowire.AppendString(nested2, "node-2")
+	msg = protowire.AppendTag(msg, 1, protowire.BytesType)
+	msg = protowire.AppendString(msg, "pod-a")
+	msg = protowire.AppendTag(msg, 2, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, nested1)
+	msg = protowire.AppendTag(msg, 2, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, nested2)
+
+	jsonDoc := []byte(`{"metadata":{"name":"pod-a"},"spec":{"nodeName":"node-1","replicas":3}}`)
+
+	tests := []struct {
+		name    string
+		filters []*pb.WatchValueFilter
+		value   []byte
+		match   bool
+	}{
+		{
+			name:    "prefix match",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PREFIX, Value: []byte("foo")}},
+			value:   []byte("foobar"),
+			match:   true,
+		},
+		{
+			name:    "prefix mismatch",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PREFIX, Value: []byte("foo")}},
+			value:   []byte("barfoo"),
+		},
+		{
+			name:    "range match",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_RANGE, Value: []byte("b"), RangeEnd: []byte("d")}},
+			value:   []byte("c"),
+			match:   true,
+		},
+		{
+			name:    "range end is exclusive",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_RANGE, Value: []byte("b"), RangeEnd: []byte("d")}},
+			value:   []byte("d"),
+		},
+		{
+			name:    "open range",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_RANGE, Value: []byte("b")}},
+			value:   []byte("zzz"),
+			match:   true,
+		},
+		{
+			name:    "json string field",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_JSON_FIELD, Path: "spec.nodeName", Value: []byte(`"node-1"`)}},
+			value:   jsonDoc,
+			match:   true,
+		},
+		{
+			name:    "json number field",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_JSON_FIELD, Path: "spec.replicas", Value: []byte(`3`)}},
+			value:   jsonDoc,
+			match:   true,
+		},
+		{
+			name:    "json field mismatch",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_JSON_FIELD, Path: "spec.nodeName", Value: []byte(`"node-2"`)}},
+			value:   jsonDoc,
+		},
+		{
+			name:    "json missing field",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_JSON_FIELD, Path: "spec.nodeName.x", Value: []byte(`"node-1"`)}},
+			value:   jsonDoc,
+		},
+		{
+			name:    "invalid json document",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_JSON_FIELD, Path: "spec", Value: []byte(`{}`)}},
+			value:   []byte("not json"),
+		},
+		{
+			name:    "proto top-level field",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{1}, Value: []byte("pod-a")}},
+			value:   msg,
+			match:   true,
+		},
+		{
+			name:    "proto nested field in repeated occurrence",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{2, 1}, Value: []byte("node-2")}},
+			value:   msg,
+			match:   true,
+		},
+		{
+			name:    "proto varint field",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{2, 2}, Value: protowire.AppendVarint(nil, 7)}},
+			value:   msg,
+			match:   true,
+		},
+		{
+			name:    "proto field mismatch",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{2, 1}, Value: []byte("node-3")}},
+			value:   msg,
+		},
+		{
+			name:    "malformed proto",
+			filters: []*pb.WatchValueFilter{{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{1}, Value: []byte("pod-a")}},
+			value:   []byte{0xff},
+		},
+		{
+			name: "all filters must match",
+			filters: []*pb.WatchValueFilter{
+				{Type: pb.WatchValueFilter_PREFIX, Value: []byte("{")},
+				{Type: pb.WatchValueFilter_JSON_FIELD, Path: "metadata.name", Value: []byte(`"pod-b"`)},
+			},
+			value: jsonDoc,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fcs, err := ValueFiltersFromRequest(&pb.WatchCreateRequest{ValueFilters: tt.filters})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(fcs) != 1 {
+				t.Fatalf("expected 1 filter, got %d", len(fcs))
+			}
+			put := mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("k"), Value: tt.value}}
+			if filtered := fcs[0](put); filtered == tt.match {
+				t.Errorf("filtered = %v, want %v", filtered, !tt.match)
+			}
+			del := mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("k")}}
+			if fcs[0](del) {
+				t.Errorf("delete event should never be filtered by value")
+			}
+		})
+	}
+}
+
+func TestValueFiltersFromRequestInvalid(t *testing.T) {
+	tests := []*pb.WatchValueFilter{
+		nil,
+		{Type: pb.WatchValueFilter_RANGE, Value: []byte("b"), RangeEnd: []byte("a")},
+		{Type: pb.WatchValueFilter_JSON_FIELD, Value: []byte(`"x"`)},
+		{Type: pb.WatchValueFilter_JSON_FIELD, Path: "a", Value: []byte(`{`)},
+		{Type: pb.WatchValueFilter_PROTO_FIELD, Value: []byte("x")},
+		{Type: pb.WatchValueFilter_PROTO_FIELD, FieldPath: []int32{0}, Value: []byte("x")},
+		{Type: pb.WatchValueFilter_FilterType(100)},
+	}
+	for i, vf := range tests {
+		_, err := ValueFiltersFromRequest(&pb.WatchCreateRequest{ValueFilters: []*pb.WatchValueFilter{vf}})
+		if !errors.Is(err, errInvalidWatchValueFilter) {
+			t.Errorf("#%d: err = %v, want %v", i, err, errInvalidWatchValueFilter)
+		}
+	}
+}
@@ -81,8 +81,12 @@ type applierV3 interface {
 
 	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error)
 
+	KeyExpire(ke *pb.KeyExpireRequest) (*pb.KeyExpireResponse, error)
+
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
+	PrefixQuota(*pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error)
+
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
 
 	AuthEnable() (*pb.AuthEnableResponse, error)
@@ -112,6 +116,7 @@ type applierV3backend struct {
 	lg              *zap.Logger
 	kv              mvcc.KV
 	alarmStore      *v3alarm.AlarmStore
+	prefixQuotas    *serverstorage.PrefixQuotaStore
 	authStore       auth.AuthStore
 	lessor          lease.Lessor
 	cluster         *membership.RaftCluster
@@ -126,6 +131,7 @@ func newApplierV3Backend(
 	lg *zap.Logger,
 	kv mvcc.KV,
 	alarmStore *v3alarm.AlarmStore,
+	prefixQuotas *serverstorage.PrefixQuotaStore,
 	authStore auth.AuthStore,
 	lessor lease.Lessor,
 	cluster *membership.RaftCluster,
@@ -138,6 +144,7 @@ func newApplierV3Backend(
 		lg:                           lg,
 		kv:                           kv,
 		alarmStore:                   alarmStore,
+		prefixQuotas:                 prefixQuotas,
 		authStore:                    authStore,
 		lessor:                       lessor,
 		cluster:                      cluster,
@@ -209,9 +216,30 @@ func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.L
 			return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, err
 		}
 	}
+	for _, c := range lc.KeyCheckpoints {
+		a.lessor.CheckpointKeyTTL(c.Key, c.ModRevision, c.Remaining_TTL)
+	}
 	return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, nil
 }
 
+func (a *applierV3backend) KeyExpire(ke *pb.KeyExpireRequest) (*pb.KeyExpireResponse, error) {
+	txn := a.kv.Write(traceutil.TODO())
+	for _, k := range ke.Keys {
+		rr, err := txn.Range(context.TODO(), k.Key, nil, mvcc.RangeOptions{})
+		if err != nil {
+			txn.End()
+			return nil, err
+		}
+		if len(rr.KVs) == 0 || rr.KVs[0].ModRevision != k.ModRevision {
+			// the key was deleted or put again since its ttl was set
+			continue
+		}
+		txn.DeleteRange(k.Key, nil)
+	}
+	txn.End()
+	return &pb.KeyExpireResponse{Header: a.newHeader()}, nil
+}
+
 func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	resp := &pb.AlarmResponse{}
 
@@ -241,6 +269,34 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 	return resp, nil
 }
 
+func (a *applierV3backend) PrefixQuota(r *pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error) {
+	if a.prefixQuotas == nil {
+		return nil, errors.ErrPrefixQuotaNotFound
+	}
+	resp := &pb.PrefixQuotaResponse{}
+
+	switch r.Action {
+	case pb.PrefixQuotaRequest_GET:
+		resp.Quotas = a.prefixQuotas.List()
+	case pb.PrefixQuotaRequest_PUT:
+		q, err := a.prefixQuotas.Put(r.Quota, a.kv)
+		if err != nil {
+			return nil, err
+		}
+		resp.Quotas = append(resp.Quotas, q)
+	case pb.PrefixQuotaRequest_DELETE:
+		q := a.prefixQuotas.Delete(r.Quota.Prefix)
+		if q == nil {
+			return nil, errors.ErrPrefixQuotaNotFound
+		}
+		resp.Quotas = append(resp.Quotas, q)
+	default:
+		return nil, nil
+	}
+	resp.Header = a.newHeader()
+	return resp, nil
+}
+
 type applierV3Capped struct {
 	applierV3
 	q serverstorage.BackendQuota
@@ -450,13 +506,23 @@ func (a *applierMembership) DowngradeInfoSet(r *membershippb.DowngradeInfoSetReq
 type quotaApplierV3 struct {
 	applierV3
 	q serverstorage.Quota
+	// pq rejects requests exceeding the quota of a prefix before they are
+	// applied, since its decision only depends on the replicated key space.
+	pq serverstorage.Quota
 }
 
-func newQuotaApplierV3(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, app applierV3) applierV3 {
-	return &quotaApplierV3{app, serverstorage.NewBackendQuota(lg, quotaBackendBytesCfg, be, "v3-applier")}
+func newQuotaApplierV3(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Backend, prefixQuotas *serverstorage.PrefixQuotaStore, kv mvcc.KV, app applierV3) applierV3 {
+	a := &quotaApplierV3{applierV3: app, q: serverstorage.NewBackendQuota(lg, quotaBackendBytesCfg, be, "v3-applier")}
+	if prefixQuotas != nil {
+		a.pq = serverstorage.NewPrefixQuota(prefixQuotas, kv)
+	}
+	return a
 }
 
 func (a *quotaApplierV3) Put(p *pb.PutRequest) (*pb.PutResponse, *traceutil.Trace, error) {
+	if a.pq != nil && !a.pq.Available(p) {
+		return nil, nil, errors.ErrPrefixQuotaExceeded
+	}
 	ok := a.q.Available(p)
 	resp, trace, err := a.applierV3.Put(p)
 	if err == nil && !ok {
@@ -466,6 +532,9 @@ func (a *quotaApplierV3) Put(p *pb.PutRequest) (*pb.PutResponse, *traceutil.Trac
 }
 
 func (a *quotaApplierV3) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, *traceutil.Trace, error) {
+	if a.pq != nil && !a.pq.Available(rt) {
+		return nil, nil, errors.ErrPrefixQuotaExceeded
+	}
 	ok := a.q.Available(rt)
 	resp, trace, err := a.applierV3.Txn(rt)
 	if err == nil && !ok {
@@ -15,8 +15,10 @@
 package apply
 
 import (
+	"errors"
 	"sync"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
@@ -48,17 +50,20 @@ func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest, applyFunc applyFunc) *
 		// does not have header field
 		aa.authInfo.Username = r.Header.Username
 		aa.authInfo.Revision = r.Header.AuthRevision
+		aa.authInfo.Roles = r.Header.Roles
 	}
 	if needAdminPermission(r) {
-		if err := aa.as.IsAdminPermitted(&aa.authInfo); err != nil {
+		if err := aa.checkAdminPermission(r); err != nil {
 			aa.authInfo.Username = ""
 			aa.authInfo.Revision = 0
+			aa.authInfo.Roles = nil
 			return &Result{Err: err}
 		}
 	}
 	ret := aa.applierV3.Apply(r, applyFunc)
 	aa.authInfo.Username = ""
 	aa.authInfo.Revision = 0
+	aa.authInfo.Roles = nil
 	return ret
 }
 
@@ -143,11 +148,41 @@ func (aa *authApplierV3) checkLeasePutsKeys(l *lease.Lease) error {
 	return nil
 }
 
-func (aa *authApplierV3) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error) {
+// checkAdminPermission falls back to the delegated admin permissions of the user
+// when it doesn't have the root role.
+func (aa *authApplierV3) checkAdminPermission(r *pb.InternalRaftRequest) error {
 	err := aa.as.IsAdminPermitted(&aa.authInfo)
+	if errors.Is(err, auth.ErrPermissionDenied) {
+		return aa.as.IsDelegatedAdminPermitted(&aa.authInfo, r)
+	}
+	return err
+}
+
+// UserAdd records the delegated admin adding the user, which then manages the
+// user even without roles. The recorded admin is never taken from the request.
+func (aa *authApplierV3) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
+	var addedBy string
+	if aa.as.IsAdminPermitted(&aa.authInfo) != nil {
+		addedBy = aa.authInfo.Username
+	}
+	if r.Options != nil || addedBy != "" {
+		options := &authpb.UserAddOptions{AddedBy: addedBy}
+		if r.Options != nil {
+			options.NoPassword = r.Options.NoPassword
+		}
+		ua := *r
+		ua.Options = options
+		r = &ua
+	}
+	return aa.applierV3.UserAdd(r)
+}
+
+func (aa *authApplierV3) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error) {
+	err := aa.checkAdminPermission(&pb.InternalRaftRequest{AuthUserGet: r})
 	if err != nil && r.Name != aa.authInfo.Username {
 		aa.authInfo.Username = ""
 		aa.authInfo.Revision = 0
+		aa.authInfo.Roles = nil
 		return &pb.AuthUserGetResponse{}, err
 	}
 
@@ -155,10 +190,11 @@ func (aa *authApplierV3) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetRespo
 }
 
 func (aa *authApplierV3) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error) {
-	err := aa.as.IsAdminPermitted(&aa.authInfo)
+	err := aa.checkAdminPermission(&pb.InternalRaftRequest{AuthRoleGet: r})
 	if err != nil && !aa.as.HasRole(aa.authInfo.Username, r.Role) {
 		aa.authInfo.Username = ""
 		aa.authInfo.Revision = 0
+		aa.authInfo.Roles = nil
 		return &pb.AuthRoleGetResponse{}, err
 	}
 
@@ -195,6 +231,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthRoleList != nil:
 		return true
+	case r.PrefixQuota != nil:
+		return true
 	default:
 		return false
 	}
@@ -102,6 +102,7 @@ func defaultAuthApplierV3(t *testing.T) *authApplierV3 {
 			lg,
 			kv,
 			alarmStore,
+			nil,
 			authStore,
 			lessor,
 			cluster,
@@ -390,6 +391,92 @@ func TestAuthApplierV3_AdminPermission(t *testing.T) {
 	}
 }
 
+// TestAuthApplierV3_DelegatedAdminPermission ensures users with ADMIN permissions can manage
+// users and roles within the key ranges of the permissions
+func TestAuthApplierV3_DelegatedAdminPermission(t *testing.T) {
+	const (
+		userDelegatedAdmin = "user_delegated_admin"
+		roleDelegatedAdmin = "role_delegated_admin"
+	)
+	authApplier := defaultAuthApplierV3(t)
+	mustCreateRolesAndEnableAuth(t, authApplier)
+	_, err := authApplier.UserAdd(&pb.AuthUserAddRequest{Name: userDelegatedAdmin, Options: &authpb.UserAddOptions{NoPassword: true}})
+	require.NoError(t, err)
+	_, err = authApplier.RoleAdd(&pb.AuthRoleAddRequest{Name: roleDelegatedAdmin})
+	require.NoError(t, err)
+	_, err = authApplier.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: userDelegatedAdmin, Role: roleDelegatedAdmin})
+	require.NoError(t, err)
+	_, err = authApplier.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: roleDelegatedAdmin, Perm: &authpb.Permission{
+		PermType: authpb.ADMIN,
+		Key:      []byte(key),
+		RangeEnd: []byte(rangeEnd),
+	}})
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name        string
+		request     *pb.InternalRaftRequest
+		expectError error
+	}{
+		{
+			name: "AuthRoleGrantPermission within the range is permitted",
+			request: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: roleWriteOnly, Perm: &authpb.Permission{
+				PermType: authpb.WRITE,
+				Key:      []byte(key),
+			}}},
+		},
+		{
+			name: "AuthRoleGrantPermission outside the range is denied",
+			request: &pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{Name: roleWriteOnly, Perm: &authpb.Permission{
+				PermType: authpb.WRITE,
+				Key:      []byte(keyOutsideRange),
+			}}},
+			expectError: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "AuthUserAdd is permitted",
+			request: &pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "new_user"}},
+		},
+		{
+			name:        "AuthUserGrantRole of the root role is denied",
+			request:     &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: userReadOnly, Role: roleRoot}},
+			expectError: auth.ErrPermissionDenied,
+		},
+		{
+			name:        "AuthDisable is denied",
+			request:     &pb.InternalRaftRequest{AuthDisable: &pb.AuthDisableRequest{}},
+			expectError: auth.ErrPermissionDenied,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.request.Header = &pb.RequestHeader{Username: userDelegatedAdmin}
+			result := authApplier.Apply(tc.request, dummyApplyFunc)
+			require.Equal(t, tc.expectError, result.Err)
+		})
+	}
+
+	setAuthInfo(authApplier, userDelegatedAdmin)
+	_, err = authApplier.UserGet(&pb.AuthUserGetRequest{Name: userReadOnly})
+	require.NoError(t, err)
+	_, err = authApplier.RoleGet(&pb.AuthRoleGetRequest{Role: roleRoot})
+	require.Equal(t, auth.ErrPermissionDenied, err)
+
+	// the delegated admin manages the users it added even without roles, and
+	// the admin recorded in the request is ignored.
+	setAuthInfo(authApplier, userDelegatedAdmin)
+	_, err = authApplier.UserAdd(&pb.AuthUserAddRequest{Name: "added_user", Options: &authpb.UserAddOptions{NoPassword: true, AddedBy: "other"}})
+	require.NoError(t, err)
+	setAuthInfo(authApplier, userRoot)
+	_, err = authApplier.UserAdd(&pb.AuthUserAddRequest{Name: "root_added_user", Options: &authpb.UserAddOptions{NoPassword: true, AddedBy: userDelegatedAdmin}})
+	require.NoError(t, err)
+	admin := &auth.AuthInfo{Username: userDelegatedAdmin, Revision: authApplier.as.Revision()}
+	err = authApplier.as.IsDelegatedAdminPermitted(admin, &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "added_user", Role: roleWriteOnly}})
+	require.NoError(t, err)
+	err = authApplier.as.IsDelegatedAdminPermitted(admin, &pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "root_added_user", Role: roleWriteOnly}})
+	require.Equal(t, auth.ErrPermissionDenied, err)
+}
+
 // TestAuthApplierV3_Put verifies only users with write permissions in the key range can put
 func TestAuthApplierV3_Put(t *testing.T) {
 	tcs := []struct {
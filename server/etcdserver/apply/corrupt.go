@@ -53,3 +53,7 @@ func (a *applierV3Corrupt) LeaseGrant(_ *pb.LeaseGrantRequest) (*pb.LeaseGrantRe
 func (a *applierV3Corrupt) LeaseRevoke(_ *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
 	return nil, errors.ErrCorrupt
 }
+
+func (a *applierV3Corrupt) KeyExpire(_ *pb.KeyExpireRequest) (*pb.KeyExpireResponse, error) {
+	return nil, errors.ErrCorrupt
+}
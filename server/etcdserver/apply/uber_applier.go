@@ -27,6 +27,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/txn"
 	"go.etcd.io/etcd/server/v3/lease"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
@@ -53,6 +54,7 @@ func NewUberApplier(
 	be backend.Backend,
 	kv mvcc.KV,
 	alarmStore *v3alarm.AlarmStore,
+	prefixQuotas *serverstorage.PrefixQuotaStore,
 	authStore auth.AuthStore,
 	lessor lease.Lessor,
 	cluster *membership.RaftCluster,
@@ -63,7 +65,7 @@ func NewUberApplier(
 	txnModeWriteWithSharedBuffer bool,
 	quotaBackendBytesCfg int64,
 ) UberApplier {
-	applyV3base := newApplierV3(lg, be, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer, quotaBackendBytesCfg)
+	applyV3base := newApplierV3(lg, be, kv, alarmStore, prefixQuotas, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer, quotaBackendBytesCfg)
 
 	ua := &uberApplier{
 		lg:                   lg,
@@ -81,6 +83,7 @@ func newApplierV3(
 	be backend.Backend,
 	kv mvcc.KV,
 	alarmStore *v3alarm.AlarmStore,
+	prefixQuotas *serverstorage.PrefixQuotaStore,
 	authStore auth.AuthStore,
 	lessor lease.Lessor,
 	cluster *membership.RaftCluster,
@@ -90,10 +93,10 @@ func newApplierV3(
 	txnModeWriteWithSharedBuffer bool,
 	quotaBackendBytesCfg int64,
 ) applierV3 {
-	applierBackend := newApplierV3Backend(lg, kv, alarmStore, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer)
+	applierBackend := newApplierV3Backend(lg, kv, alarmStore, prefixQuotas, authStore, lessor, cluster, raftStatus, snapshotServer, consistentIndex, txnModeWriteWithSharedBuffer)
 	return newAuthApplierV3(
 		authStore,
-		newQuotaApplierV3(lg, quotaBackendBytesCfg, be, applierBackend),
+		newQuotaApplierV3(lg, quotaBackendBytesCfg, be, prefixQuotas, kv, applierBackend),
 		lessor,
 	)
 }
@@ -158,9 +161,15 @@ func (a *uberApplier) dispatch(r *pb.InternalRaftRequest) *Result {
 	case r.LeaseCheckpoint != nil:
 		op = "LeaseCheckpoint"
 		ar.Resp, ar.Err = a.applyV3.LeaseCheckpoint(r.LeaseCheckpoint)
+	case r.KeyExpire != nil:
+		op = "KeyExpire"
+		ar.Resp, ar.Err = a.applyV3.KeyExpire(r.KeyExpire)
 	case r.Alarm != nil:
 		op = "Alarm"
 		ar.Resp, ar.Err = a.Alarm(r.Alarm)
+	case r.PrefixQuota != nil:
+		op = "PrefixQuota"
+		ar.Resp, ar.Err = a.applyV3.PrefixQuota(r.PrefixQuota)
 	case r.Authenticate != nil:
 		op = "Authenticate"
 		ar.Resp, ar.Err = a.applyV3.Authenticate(r.Authenticate)
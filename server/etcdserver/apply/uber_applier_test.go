@@ -30,6 +30,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
@@ -47,7 +48,9 @@ func defaultUberApplier(t *testing.T) UberApplier {
 	cluster := membership.NewCluster(lg)
 	cluster.AddMember(&membership.Member{ID: memberID}, true)
 	lessor := lease.NewLessor(lg, be, cluster, lease.LessorConfig{})
-	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{})
+	prefixQuotas, err := serverstorage.NewPrefixQuotaStore(lg, schema.NewQuotaBackend(lg, be))
+	require.NoError(t, err)
+	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{UsageTracker: prefixQuotas})
 	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
 	require.NoError(t, err)
 
@@ -65,6 +68,7 @@ func defaultUberApplier(t *testing.T) UberApplier {
 		be,
 		kv,
 		alarmStore,
+		prefixQuotas,
 		authStore,
 		lessor,
 		cluster,
@@ -277,3 +281,76 @@ func TestUberApplier_Alarm_Deactivate(t *testing.T) {
 	require.NotNil(t, result)
 	assert.NoError(t, result.Err)
 }
+
+// TestUberApplier_PrefixQuota tests the applier rejects writes exceeding the quota of a prefix
+func TestUberApplier_PrefixQuota(t *testing.T) {
+	ua := defaultUberApplier(t)
+	// the usage of a new quota includes the keys written before it
+	result := ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("/tenant/a"), Value: []byte("v")}})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	result = ua.Apply(&pb.InternalRaftRequest{
+		Header: &pb.RequestHeader{},
+		PrefixQuota: &pb.PrefixQuotaRequest{
+			Action: pb.PrefixQuotaRequest_PUT,
+			Quota:  &pb.PrefixQuota{Prefix: []byte("/tenant/"), QuotaKeys: 1},
+		},
+	})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	result = ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("/tenant/b"), Value: []byte("v")}})
+	require.NotNil(t, result)
+	require.Equalf(t, errors.ErrPrefixQuotaExceeded, result.Err, "Apply: got %v, expect: %v", result.Err, errors.ErrPrefixQuotaExceeded)
+
+	// overwriting an existing key does not add a key under the prefix
+	result = ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("/tenant/a"), Value: []byte("v2")}})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	// keys outside of the prefix are not limited
+	result = ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("/other/b"), Value: []byte("v")}})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	result = ua.Apply(&pb.InternalRaftRequest{
+		Header:      &pb.RequestHeader{},
+		PrefixQuota: &pb.PrefixQuotaRequest{Action: pb.PrefixQuotaRequest_GET},
+	})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+	quotas := result.Resp.(*pb.PrefixQuotaResponse).Quotas
+	require.Len(t, quotas, 1)
+	assert.Equal(t, int64(1), quotas[0].UsedKeys)
+	assert.Equal(t, int64(len("/tenant/a")+len("v2")), quotas[0].UsedBytes)
+
+	result = ua.Apply(&pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("/tenant/"), RangeEnd: []byte("/tenant0")}})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	result = ua.Apply(&pb.InternalRaftRequest{
+		Header:      &pb.RequestHeader{},
+		PrefixQuota: &pb.PrefixQuotaRequest{Action: pb.PrefixQuotaRequest_GET},
+	})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+	quotas = result.Resp.(*pb.PrefixQuotaResponse).Quotas
+	require.Len(t, quotas, 1)
+	assert.Equal(t, int64(0), quotas[0].UsedKeys)
+	assert.Equal(t, int64(0), quotas[0].UsedBytes)
+
+	result = ua.Apply(&pb.InternalRaftRequest{
+		Header: &pb.RequestHeader{},
+		PrefixQuota: &pb.PrefixQuotaRequest{
+			Action: pb.PrefixQuotaRequest_DELETE,
+			Quota:  &pb.PrefixQuota{Prefix: []byte("/tenant/")},
+		},
+	})
+	require.NotNil(t, result)
+	require.NoError(t, result.Err)
+
+	result = ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("/tenant/b"), Value: []byte("v")}})
+	require.NotNil(t, result)
+	assert.NoError(t, result.Err)
+}
@@ -45,6 +45,7 @@ import (
 	"go.etcd.io/etcd/server/v3/revbump"
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
@@ -168,6 +169,9 @@ type bootstrappedCluster struct {
 type bootstrappedRaft struct {
 	lg        *zap.Logger
 	heartbeat time.Duration
+	// maxTickDelay is the delay of a tick past the heartbeat that suspends the
+	// leader lease, zero if the lease read index mode is disabled.
+	maxTickDelay time.Duration
 
 	peers   []raft.Peer
 	config  *raft.Config
@@ -248,6 +252,10 @@ func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, s
 			cfg.Logger.Error("Failed to validate schema", zap.Error(err))
 			return nil, err
 		}
+		if err = checkEncryption(cfg, be); err != nil {
+			cfg.Logger.Error("Failed to load the data encryption keys", zap.Error(err))
+			return nil, err
+		}
 	}
 
 	return &bootstrappedBackend{
@@ -259,6 +267,13 @@ func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, s
 	}, nil
 }
 
+func checkEncryption(cfg config.ServerConfig, be backend.Backend) error {
+	tx := be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	return encryption.UnsafeCheck(tx, cfg.EncryptionKMS)
+}
+
 func maybeDefragBackend(cfg config.ServerConfig, be backend.Backend) error {
 	size := be.Size()
 	sizeInUse := be.SizeInUse()
@@ -511,25 +526,38 @@ func bootstrapRaftFromCluster(cfg config.ServerConfig, cl *membership.RaftCluste
 	)
 	s := bwal.MemoryStorage()
 	return &bootstrappedRaft{
-		lg:        cfg.Logger,
-		heartbeat: time.Duration(cfg.TickMs) * time.Millisecond,
-		config:    raftConfig(cfg, uint64(member.ID), s),
-		peers:     peers,
-		storage:   s,
+		lg:           cfg.Logger,
+		heartbeat:    time.Duration(cfg.TickMs) * time.Millisecond,
+		maxTickDelay: maxTickDelay(cfg),
+		config:       raftConfig(cfg, uint64(member.ID), s),
+		peers:        peers,
+		storage:      s,
 	}
 }
 
 func bootstrapRaftFromWAL(cfg config.ServerConfig, bwal *bootstrappedWAL) *bootstrappedRaft {
 	s := bwal.MemoryStorage()
 	return &bootstrappedRaft{
-		lg:        cfg.Logger,
-		heartbeat: time.Duration(cfg.TickMs) * time.Millisecond,
-		config:    raftConfig(cfg, uint64(bwal.meta.nodeID), s),
-		storage:   s,
+		lg:           cfg.Logger,
+		heartbeat:    time.Duration(cfg.TickMs) * time.Millisecond,
+		maxTickDelay: maxTickDelay(cfg),
+		config:       raftConfig(cfg, uint64(bwal.meta.nodeID), s),
+		storage:      s,
 	}
 }
 
+func maxTickDelay(cfg config.ServerConfig) time.Duration {
+	if !cfg.LeaseRead {
+		return 0
+	}
+	return cfg.LeaseReadMaxClockDrift
+}
+
 func raftConfig(cfg config.ServerConfig, id uint64, s *raft.MemoryStorage) *raft.Config {
+	readOnlyOption := raft.ReadOnlySafe
+	if cfg.LeaseRead {
+		readOnlyOption = raft.ReadOnlyLeaseBased
+	}
 	return &raft.Config{
 		ID:              id,
 		ElectionTick:    cfg.ElectionTicks,
@@ -539,6 +567,7 @@ func raftConfig(cfg config.ServerConfig, id uint64, s *raft.MemoryStorage) *raft
 		MaxInflightMsgs: maxInflightMsgs,
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
+		ReadOnlyOption:  readOnlyOption,
 		Logger:          NewRaftLoggerZap(cfg.Logger.Named("raft")),
 	}
 }
@@ -555,12 +584,13 @@ func (b *bootstrappedRaft) newRaftNode(ss *snap.Snapshotter, wal *wal.WAL, cl *m
 	raftStatusMu.Unlock()
 	return newRaftNode(
 		raftNodeConfig{
-			lg:          b.lg,
-			isIDRemoved: func(id uint64) bool { return cl.IsIDRemoved(types.ID(id)) },
-			Node:        n,
-			heartbeat:   b.heartbeat,
-			raftStorage: b.storage,
-			storage:     serverstorage.NewStorage(b.lg, wal, ss),
+			lg:           b.lg,
+			isIDRemoved:  func(id uint64) bool { return cl.IsIDRemoved(types.ID(id)) },
+			Node:         n,
+			heartbeat:    b.heartbeat,
+			maxTickDelay: b.maxTickDelay,
+			raftStorage:  b.storage,
+			storage:      serverstorage.NewStorage(b.lg, wal, ss),
 		},
 	)
 }
@@ -35,6 +35,8 @@ var (
 	ErrNotLeader                   = errors.New("etcdserver: not leader")
 	ErrRequestTooLarge             = errors.New("etcdserver: request is too large")
 	ErrNoSpace                     = errors.New("etcdserver: no space")
+	ErrPrefixQuotaExceeded         = errors.New("etcdserver: prefix quota exceeded")
+	ErrPrefixQuotaNotFound         = errors.New("etcdserver: prefix quota not found")
 	ErrTooManyRequests             = errors.New("etcdserver: too many requests")
 	ErrUnhealthy                   = errors.New("etcdserver: unhealthy cluster")
 	ErrCorrupt                     = errors.New("etcdserver: corrupt cluster")
@@ -42,6 +44,10 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrInvalidContinueToken        = errors.New("etcdserver: invalid continue token")
+	ErrContinueTokenCompacted      = errors.New("etcdserver: revision of continue token has been compacted")
+	ErrConfigReloadNotSupported    = errors.New("etcdserver: configuration reload is not supported")
+	ErrClusterVersionTooLow        = errors.New("etcdserver: request not supported by the cluster version")
 )
 
 type DiscoveryError struct {
@@ -0,0 +1,275 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/features"
+	"go.etcd.io/raft/v3"
+	"go.etcd.io/raft/v3/tracker"
+)
+
+// LearnerPromotionsPath is the peer path serving the auto-promotion state of
+// the learners tracked by the leader.
+const LearnerPromotionsPath = "/members/learnerpromotions"
+
+// learnerPromotionCheckInterval is the interval between two checks of the
+// learners by the leader, if the LearnerAutoPromotion feature is enabled.
+var learnerPromotionCheckInterval = time.Second
+
+// LearnerPromotion is the auto-promotion state of a learner.
+type LearnerPromotion struct {
+	// Lag is the number of entries the learner is behind the leader.
+	Lag uint64
+	// CaughtUpSince is the time since the lag of the learner is within
+	// LearnerAutoPromoteMaxLag, zero if it is not.
+	CaughtUpSince time.Time
+}
+
+// LearnerPromotions returns the auto-promotion state of the learners. It is
+// empty if the member is not leader or the LearnerAutoPromotion feature is
+// disabled.
+func (s *EtcdServer) LearnerPromotions() map[types.ID]LearnerPromotion {
+	s.learnerPromotionsMu.RLock()
+	defer s.learnerPromotionsMu.RUnlock()
+	return s.learnerPromotions
+}
+
+// LeaderLearnerPromotions returns the auto-promotion state of the learners
+// tracked by the leader. A follower fetches it from the leader. It is empty if
+// the cluster has no learner or the LearnerAutoPromotion feature is disabled.
+func (s *EtcdServer) LeaderLearnerPromotions(ctx context.Context) (map[types.ID]LearnerPromotion, error) {
+	if !s.FeatureEnabled(features.LearnerAutoPromotion) || !s.hasLearner() {
+		return nil, nil
+	}
+	if s.isLeader() {
+		return s.LearnerPromotions(), nil
+	}
+	leader := s.cluster.Member(s.Leader())
+	if leader == nil {
+		return nil, errors.ErrNoLeader
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	return getLearnerPromotions(ctx, leader.PeerURLs, s.peerRt)
+}
+
+func (s *EtcdServer) hasLearner() bool {
+	for _, m := range s.cluster.Members() {
+		if m.IsLearner {
+			return true
+		}
+	}
+	return false
+}
+
+// getLearnerPromotions returns the auto-promotion state of the learners from
+// the leader via its peerURLs. Returns the last error if it fails to get it.
+func getLearnerPromotions(ctx context.Context, urls []string, rt http.RoundTripper) (map[types.ID]LearnerPromotion, error) {
+	cc := &http.Client{
+		Transport: rt,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	var err error
+	for _, u := range urls {
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, u+LearnerPromotionsPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		var resp *http.Response
+		resp, err = cc.Do(req)
+		if err != nil {
+			continue
+		}
+		var b []byte
+		b, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("learner promotions: unexpected status %d (%s)", resp.StatusCode, b)
+			continue
+		}
+		var lps map[types.ID]LearnerPromotion
+		if err = json.Unmarshal(b, &lps); err != nil {
+			continue
+		}
+		return lps, nil
+	}
+	return nil, err
+}
+
+type learnerPromotionsHandler struct {
+	lg      *zap.Logger
+	cluster api.Cluster
+	server  *EtcdServer
+}
+
+func (s *EtcdServer) LearnerPromotionsHandler() http.Handler {
+	return &learnerPromotionsHandler{
+		lg:      s.Logger(),
+		cluster: s.cluster,
+		server:  s,
+	}
+}
+
+func (h *learnerPromotionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("X-Etcd-Cluster-ID", h.cluster.ID().String())
+
+	if r.URL.Path != LearnerPromotionsPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+
+	// only the leader tracks the learners; an empty state from a follower
+	// would be taken for learners that are not tracked.
+	if !h.server.isLeader() {
+		http.Error(w, errors.ErrNotLeader.Error(), http.StatusPreconditionFailed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(h.server.LearnerPromotions()); err != nil {
+		h.lg.Warn("failed to encode learner promotions", zap.Error(err))
+	}
+}
+
+// monitorLearners promotes the learners that stay within LearnerAutoPromoteMaxLag
+// entries of the leader for LearnerAutoPromoteDuration, while this member is leader.
+func (s *EtcdServer) monitorLearners() {
+	if !s.FeatureEnabled(features.LearnerAutoPromotion) {
+		return
+	}
+	lg := s.Logger()
+	lg.Info(
+		"enabled learner auto-promotion",
+		zap.Uint64("max-lag", s.Cfg.LearnerAutoPromoteMaxLag),
+		zap.Duration("duration", s.Cfg.LearnerAutoPromoteDuration),
+	)
+	for {
+		select {
+		case <-time.After(learnerPromotionCheckInterval):
+		case <-s.stopping:
+			lg.Info("server has stopped; stopping learner auto-promotion's monitor")
+			return
+		}
+		for _, id := range s.updateLearnerPromotions(time.Now()) {
+			s.autoPromoteLearner(id)
+		}
+	}
+}
+
+// updateLearnerPromotions updates the auto-promotion state of the learners from
+// the raft progress of the leader, and returns the learners to promote.
+func (s *EtcdServer) updateLearnerPromotions(now time.Time) []types.ID {
+	var rs raft.Status
+	if s.isLeader() {
+		rs = s.raftStatus()
+	}
+	if rs.Progress == nil {
+		s.learnerPromotionsMu.Lock()
+		s.learnerPromotions = nil
+		s.learnerPromotionsMu.Unlock()
+		return nil
+	}
+
+	lg := s.Logger()
+	prev := s.LearnerPromotions()
+	next := make(map[types.ID]LearnerPromotion)
+	leaderMatch := rs.Progress[rs.ID].Match
+	var ready []types.ID
+	for _, m := range s.cluster.Members() {
+		if !m.IsLearner {
+			continue
+		}
+		pr, ok := rs.Progress[uint64(m.ID)]
+		if !ok {
+			continue
+		}
+		var lp LearnerPromotion
+		if leaderMatch > pr.Match {
+			lp.Lag = leaderMatch - pr.Match
+		}
+		// a learner that does not acknowledge appends is not caught up,
+		// even if it is close to the leader.
+		caughtUp := pr.State == tracker.StateReplicate && lp.Lag <= s.Cfg.LearnerAutoPromoteMaxLag
+		wasCaughtUp := !prev[m.ID].CaughtUpSince.IsZero()
+		switch {
+		case caughtUp && wasCaughtUp:
+			lp.CaughtUpSince = prev[m.ID].CaughtUpSince
+		case caughtUp:
+			lp.CaughtUpSince = now
+			learnerAutoPromoteEvents.WithLabelValues("caught-up").Inc()
+			lg.Info(
+				"learner caught up with leader",
+				zap.String("learner-id", m.ID.String()),
+				zap.Uint64("lag", lp.Lag),
+				zap.Duration("promote-after", s.Cfg.LearnerAutoPromoteDuration),
+			)
+		case wasCaughtUp:
+			learnerAutoPromoteEvents.WithLabelValues("fell-behind").Inc()
+			lg.Info(
+				"learner fell behind leader",
+				zap.String("learner-id", m.ID.String()),
+				zap.Uint64("lag", lp.Lag),
+				zap.Uint64("max-lag", s.Cfg.LearnerAutoPromoteMaxLag),
+			)
+		}
+		if !lp.CaughtUpSince.IsZero() && now.Sub(lp.CaughtUpSince) >= s.Cfg.LearnerAutoPromoteDuration {
+			ready = append(ready, m.ID)
+		}
+		next[m.ID] = lp
+	}
+
+	s.learnerPromotionsMu.Lock()
+	s.learnerPromotions = next
+	s.learnerPromotionsMu.Unlock()
+	return ready
+}
+
+func (s *EtcdServer) autoPromoteLearner(id types.ID) {
+	lg := s.Logger()
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	_, err := s.promoteLearner(ctx, uint64(id))
+	cancel()
+	if err != nil {
+		learnerAutoPromoteEvents.WithLabelValues("promote-failed").Inc()
+		lg.Warn("failed to auto-promote learner", zap.String("learner-id", id.String()), zap.Error(err))
+		return
+	}
+	learnerPromoteSucceed.Inc()
+	learnerAutoPromoteEvents.WithLabelValues("promoted").Inc()
+	lg.Info("auto-promoted learner", zap.String("learner-id", id.String()))
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"time"
+
+	"go.etcd.io/raft/v3"
+)
+
+// In the lease read index mode (Cfg.LeaseRead), the leader confirms the read
+// indexes with raft ReadOnlyLeaseBased: it answers them with its commit index
+// without a quorum round, as raft CheckQuorum keeps the followers from voting
+// for another member while they hear from it, and steps the leader down once
+// it does not hear from a quorum for an election timeout. The followers still
+// get their read indexes from the leader.
+//
+// The lease counts the election timeout in ticks, so it does not hold if the
+// ticks of the leader are delayed, e.g. by a paused process, while the other
+// members elect a new leader. A leader whose tick was delayed by more than
+// LeaseReadMaxClockDrift does not confirm read indexes with its lease for an
+// election timeout, by when it either heard from a quorum again or stepped
+// down.
+
+// leaseReadSuspended returns whether this member is the leader and can not
+// confirm read indexes with its lease, as one of its ticks is or was delayed
+// within the last election timeout, or it transfers its leadership: the
+// transferee campaigns without waiting for the lease to expire.
+func (s *EtcdServer) leaseReadSuspended() bool {
+	if !s.Cfg.LeaseRead || !s.isLeader() {
+		return false
+	}
+	heartbeat := time.Duration(s.Cfg.TickMs) * time.Millisecond
+	electionTimeout := time.Duration(s.Cfg.ElectionTicks) * heartbeat
+	// a tick in progress can be delayed as well.
+	if time.Since(s.r.getLatestTickTs()) > heartbeat+s.Cfg.LeaseReadMaxClockDrift ||
+		time.Since(s.r.getDelayedTickTs()) < electionTimeout {
+		return true
+	}
+	return s.raftStatus().LeadTransferee != raft.None
+}
@@ -59,6 +59,15 @@ var (
 		Name:      "learner_promote_successes",
 		Help:      "The total number of successful learner promotions while this member is leader.",
 	})
+	learnerAutoPromoteEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "learner_auto_promote_events_total",
+			Help:      "The total number of learner auto-promotion events (caught-up, fell-behind, promoted, promote-failed) while this member is leader.",
+		},
+		[]string{"event"},
+	)
 	heartbeatSendFailures = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -107,12 +116,27 @@ var (
 		Name:      "read_indexes_failed_total",
 		Help:      "The total number of failed read indexes seen.",
 	})
+	leaseReads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "lease_reads_total",
+			Help:      "The total number of read indexes confirmed by the leader lease, of the leader (local) or forwarded by a follower (forwarded), or not confirmed as the lease is suspended (suspended), in the lease read index mode.",
+		},
+		[]string{"result"},
+	)
 	leaseExpired = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "server",
 		Name:      "lease_expired_total",
 		Help:      "The total number of expired leases.",
 	})
+	keyExpired = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "key_expired_total",
+		Help:      "The total number of keys deleted because their ttl expired.",
+	})
 	currentVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "etcd",
@@ -173,13 +197,16 @@ func init() {
 	prometheus.MustRegister(proposalsFailed)
 	prometheus.MustRegister(slowReadIndex)
 	prometheus.MustRegister(readIndexFailed)
+	prometheus.MustRegister(leaseReads)
 	prometheus.MustRegister(leaseExpired)
+	prometheus.MustRegister(keyExpired)
 	prometheus.MustRegister(currentVersion)
 	prometheus.MustRegister(currentGoVersion)
 	prometheus.MustRegister(serverID)
 	prometheus.MustRegister(serverFeatureEnabled)
 	prometheus.MustRegister(learnerPromoteSucceed)
 	prometheus.MustRegister(learnerPromoteFailed)
+	prometheus.MustRegister(learnerAutoPromoteEvents)
 	prometheus.MustRegister(fdUsed)
 	prometheus.MustRegister(fdLimit)
 
@@ -83,6 +83,8 @@ type raftNode struct {
 	tickMu *sync.RWMutex
 	// timestamp of the latest tick
 	latestTickTs time.Time
+	// timestamp of the latest tick delayed by more than maxTickDelay
+	delayedTickTs time.Time
 	raftNodeConfig
 
 	// a chan to send/receive snapshot
@@ -112,6 +114,9 @@ type raftNodeConfig struct {
 	raftStorage *raft.MemoryStorage
 	storage     serverstorage.Storage
 	heartbeat   time.Duration // for logging
+	// maxTickDelay is the delay of a tick past the heartbeat after which the
+	// tick is recorded as delayed; zero disables it.
+	maxTickDelay time.Duration
 	// transport specifies the transport to send and receive msgs to members.
 	// Sending messages MUST NOT block. It is okay to drop messages, since
 	// clients should timeout and reissue their messages.
@@ -156,9 +161,14 @@ func newRaftNode(cfg raftNodeConfig) *raftNode {
 
 // raft.Node does not have locks in Raft package
 func (r *raftNode) tick() {
+	// gofail: var raftBeforeTick struct{}
 	r.tickMu.Lock()
 	r.Tick()
-	r.latestTickTs = time.Now()
+	now := time.Now()
+	if r.maxTickDelay > 0 && now.Sub(r.latestTickTs) > r.heartbeat+r.maxTickDelay {
+		r.delayedTickTs = now
+	}
+	r.latestTickTs = now
 	r.tickMu.Unlock()
 }
 
@@ -168,6 +178,12 @@ func (r *raftNode) getLatestTickTs() time.Time {
 	return r.latestTickTs
 }
 
+func (r *raftNode) getDelayedTickTs() time.Time {
+	r.tickMu.RLock()
+	defer r.tickMu.RUnlock()
+	return r.delayedTickTs
+}
+
 // start prepares and starts raftNode in a new goroutine. It is no longer safe
 // to modify the fields after it has been started.
 func (r *raftNode) start(rh *raftReadyHandler) {
@@ -70,8 +70,10 @@ import (
 	"go.etcd.io/etcd/server/v3/lease/leasehttp"
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/walarchive"
 	"go.etcd.io/raft/v3"
 	"go.etcd.io/raft/v3/raftpb"
 )
@@ -264,13 +266,22 @@ type EtcdServer struct {
 	beHooks    *serverstorage.BackendHooks
 	authStore  auth.AuthStore
 	alarmStore *v3alarm.AlarmStore
+	// prefixQuotas holds the quotas scoped to key prefixes.
+	prefixQuotas *serverstorage.PrefixQuotaStore
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
 
 	SyncTicker *time.Ticker
+
+	compactorMu sync.Mutex
 	// compactor is used to auto-compact the KV.
 	compactor v3compactor.Compactor
+	// compactionMode, compactionRetention and compactionTarget are the
+	// settings of compactor.
+	compactionMode      string
+	compactionRetention time.Duration
+	compactionTarget    int64
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -299,6 +310,26 @@ type EtcdServer struct {
 	// TODO: Replace with flush db in v3.7 assuming v3.6 bootstraps from db file.
 	forceDiskSnapshot bool
 	corruptionChecker CorruptionChecker
+
+	// defragActive is set while a defragmentation of the backend is running.
+	defragActive atomic.Bool
+
+	learnerPromotionsMu sync.RWMutex
+	// learnerPromotions is the auto-promotion state of the learners, tracked
+	// while this member is leader.
+	learnerPromotions map[types.ID]LearnerPromotion
+
+	// walArchiver copies finished WAL segments and snapshot files to
+	// Cfg.WALArchiveSink. It is nil if archiving is disabled.
+	walArchiver *walarchive.Archiver
+
+	// runtimeConfig overrides the settings of Cfg after UpdateRuntimeConfig.
+	runtimeConfig atomic.Pointer[RuntimeConfig]
+	// applierStale is set when the settings of the applier changed.
+	applierStale atomic.Bool
+
+	configReloaderMu sync.Mutex
+	configReloader   ConfigReloader
 }
 
 // NewServer creates a new EtcdServer from the supplied configuration. The
@@ -350,6 +381,10 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 
 	srv.be = b.storage.backend.be
 	srv.beHooks = b.storage.backend.beHooks
+	if cfg.WALArchiveSink != nil {
+		srv.walArchiver = walarchive.New(cfg.Logger, cfg.WALArchiveSink, cfg.WALDir(), cfg.SnapDir())
+		b.storage.wal.w.SetCutHook(func(string) { srv.walArchiver.Notify() })
+	}
 	minTTL := time.Duration((3*cfg.ElectionTicks)/2) * heartbeat
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
@@ -372,9 +407,17 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		return nil, err
 	}
 
+	// the prefix quotas track the usage of their prefixes through the writes
+	// to the key-value store.
+	srv.prefixQuotas, err = serverstorage.NewPrefixQuotaStore(srv.Logger(), schema.NewQuotaBackend(srv.Logger(), srv.be))
+	if err != nil {
+		return nil, err
+	}
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		Cipher:                  encryption.NewCipher(cfg.EncryptionKMS),
+		UsageTracker:            srv.prefixQuotas,
 	}
 	srv.kv = mvcc.New(srv.Logger(), srv.be, srv.lessor, mvccStoreConfig)
 	srv.corruptionChecker = newCorruptionChecker(cfg.Logger, srv, srv.kv.HashStorage())
@@ -389,32 +432,44 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 			newSrv.kv.Close()
 		}
 	}()
+	target := autoCompactionTargetBackendBytes(cfg.AutoCompactionTargetBackendBytes, cfg.QuotaBackendBytes)
 	if num := cfg.AutoCompactionRetention; num != 0 {
-		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, srv)
+		srv.compactor, err = srv.newCompactor(cfg.AutoCompactionMode, num, target)
 		if err != nil {
 			return nil, err
 		}
 		srv.compactor.Run()
 	}
+	srv.compactionMode, srv.compactionRetention, srv.compactionTarget = cfg.AutoCompactionMode, cfg.AutoCompactionRetention, target
 
 	if err = srv.restoreAlarms(); err != nil {
 		return nil, err
 	}
 	srv.uberApply = srv.NewUberApplier()
 
+	checkpointer := func(ctx context.Context, cp *pb.LeaseCheckpointRequest) error {
+		if !srv.ensureLeadership() {
+			srv.lg.Warn("Ignore the checkpoint request because current member isn't a leader",
+				zap.Uint64("local-member-id", uint64(srv.MemberID())))
+			return lease.ErrNotPrimary
+		}
+
+		srv.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseCheckpoint: cp})
+		return nil
+	}
 	if srv.FeatureEnabled(features.LeaseCheckpoint) {
 		// setting checkpointer enables lease checkpoint feature.
-		srv.lessor.SetCheckpointer(func(ctx context.Context, cp *pb.LeaseCheckpointRequest) error {
-			if !srv.ensureLeadership() {
-				srv.lg.Warn("Ignore the checkpoint request because current member isn't a leader",
-					zap.Uint64("local-member-id", uint64(srv.MemberID())))
-				return lease.ErrNotPrimary
-			}
-
-			srv.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseCheckpoint: cp})
-			return nil
-		})
+		srv.lessor.SetCheckpointer(checkpointer)
 	}
+	// the remaining ttls of keys are always checkpointed, so keys put with a
+	// ttl do not get their full ttl back on restart. v3.6 members drop the
+	// key checkpoints.
+	srv.lessor.SetKeyCheckpointer(func(ctx context.Context, cp *pb.LeaseCheckpointRequest) error {
+		if !srv.clusterVersionAtLeast(version.V3_7) {
+			return nil
+		}
+		return checkpointer(ctx, cp)
+	})
 
 	// Set the hook after EtcdServer finishes the initialization to avoid
 	// the hook being called during the initialization process.
@@ -544,6 +599,9 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(func() { s.adjustTicks() })
 	s.GoAttach(func() { s.publishV3(s.Cfg.ReqTimeout()) })
 	s.GoAttach(s.purgeFile)
+	if s.walArchiver != nil {
+		s.GoAttach(func() { s.walArchiver.Run(s.stopping) })
+	}
 	s.GoAttach(func() { monitorFileDescriptor(s.Logger(), s.stopping) })
 	s.GoAttach(s.monitorClusterVersions)
 	s.GoAttach(s.monitorStorageVersion)
@@ -551,6 +609,7 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorCompactHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorLearners)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -612,12 +671,17 @@ func (s *EtcdServer) purgeFile() {
 	lg := s.Logger()
 	var dberrc, serrc, werrc <-chan error
 	var dbdonec, sdonec, wdonec <-chan struct{}
+	// files are kept until they are archived
+	var archived func(string) bool
+	if s.walArchiver != nil {
+		archived = s.walArchiver.Archived
+	}
 	if s.Cfg.MaxSnapFiles > 0 {
 		dbdonec, dberrc = fileutil.PurgeFileWithoutFlock(lg, s.Cfg.SnapDir(), "snap.db", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
-		sdonec, serrc = fileutil.PurgeFileWithoutFlock(lg, s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
+		sdonec, serrc = fileutil.PurgeFileIf(lg, s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping, false, archived)
 	}
 	if s.Cfg.MaxWALFiles > 0 {
-		wdonec, werrc = fileutil.PurgeFileWithDoneNotify(lg, s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.stopping)
+		wdonec, werrc = fileutil.PurgeFileIf(lg, s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.stopping, true, archived)
 	}
 
 	select {
@@ -664,6 +728,7 @@ type ServerPeerV2 interface {
 	ServerPeer
 	HashKVHandler() http.Handler
 	DowngradeEnabledHandler() http.Handler
+	LearnerPromotionsHandler() http.Handler
 }
 
 func (s *EtcdServer) DowngradeInfo() *serverversion.DowngradeInfo { return s.cluster.DowngradeInfo() }
@@ -733,6 +798,14 @@ func (s *EtcdServer) Process(ctx context.Context, m raftpb.Message) error {
 	if m.Type == raftpb.MsgApp {
 		s.stats.RecvAppendReq(types.ID(m.From).String(), m.Size())
 	}
+	if m.Type == raftpb.MsgReadIndex && s.Cfg.LeaseRead && s.isLeader() {
+		if s.leaseReadSuspended() {
+			// the follower retries the read index.
+			leaseReads.WithLabelValues("suspended").Inc()
+			return nil
+		}
+		leaseReads.WithLabelValues("forwarded").Inc()
+	}
 	return s.r.Step(ctx, m)
 }
 
@@ -783,9 +856,11 @@ func (s *EtcdServer) run() {
 				if s.lessor != nil {
 					s.lessor.Demote()
 				}
+				s.compactorMu.Lock()
 				if s.compactor != nil {
 					s.compactor.Pause()
 				}
+				s.compactorMu.Unlock()
 			} else {
 				if newLeader {
 					t := time.Now()
@@ -793,9 +868,11 @@ func (s *EtcdServer) run() {
 					s.leadElectedTime = t
 					s.leadTimeMu.Unlock()
 				}
+				s.compactorMu.Lock()
 				if s.compactor != nil {
 					s.compactor.Resume()
 				}
+				s.compactorMu.Unlock()
 			}
 			if newLeader {
 				s.leaderChanged.Notify()
@@ -845,8 +922,10 @@ func (s *EtcdServer) run() {
 	}()
 
 	var expiredLeaseC <-chan []*lease.Lease
+	var expiredKeysC <-chan []*pb.KeyExpiry
 	if s.lessor != nil {
 		expiredLeaseC = s.lessor.ExpiredLeasesC()
+		expiredKeysC = s.lessor.ExpiredKeysC()
 	}
 
 	for {
@@ -856,6 +935,8 @@ func (s *EtcdServer) run() {
 			sched.Schedule(f)
 		case leases := <-expiredLeaseC:
 			s.revokeExpiredLeases(leases)
+		case keys := <-expiredKeysC:
+			s.expireKeys(keys)
 		case err := <-s.errorc:
 			lg.Warn("server error", zap.Error(err))
 			lg.Warn("data-dir used by this member must be removed")
@@ -913,6 +994,32 @@ func (s *EtcdServer) revokeExpiredLeases(leases []*lease.Lease) {
 	})
 }
 
+func (s *EtcdServer) expireKeys(keys []*pb.KeyExpiry) {
+	s.GoAttach(func() {
+		// Like revoking leases, only the leader deletes expired keys.
+		lg := s.Logger()
+		if !s.ensureLeadership() {
+			lg.Warn("Ignore the key expiring request because current member isn't a leader",
+				zap.Uint64("local-member-id", uint64(s.MemberID())))
+			return
+		}
+		// v3.6 members can not apply the request; the keys expire once the
+		// cluster is upgraded.
+		if !s.clusterVersionAtLeast(version.V3_7) {
+			lg.Warn("Ignore the key expiring request because the cluster version is lower than 3.7",
+				zap.Int("keys", len(keys)))
+			return
+		}
+
+		_, err := s.raftRequestOnce(s.ctx, pb.InternalRaftRequest{KeyExpire: &pb.KeyExpireRequest{Keys: keys}})
+		if err != nil {
+			lg.Warn("failed to expire keys", zap.Int("keys", len(keys)), zap.Error(err))
+			return
+		}
+		keyExpired.Add(float64(len(keys)))
+	})
+}
+
 // isActive checks if the etcd instance is still actively processing the
 // heartbeat message (ticks). It returns false if no heartbeat has been
 // received within 3 * tickMs.
@@ -969,17 +1076,58 @@ func (s *EtcdServer) Cleanup() {
 	if s.be != nil {
 		s.be.Close()
 	}
+	s.compactorMu.Lock()
 	if s.compactor != nil {
 		s.compactor.Stop()
 	}
+	s.compactorMu.Unlock()
+}
+
+// newCompactor returns the auto compactor of the given mode. The retention
+// is the retention floor of the adaptive compactor, which targets the backend
+// size target.
+func (s *EtcdServer) newCompactor(mode string, retention time.Duration, target int64) (v3compactor.Compactor, error) {
+	if mode == v3compactor.ModeAdaptive {
+		return v3compactor.NewAdaptive(s.Logger(), retention, target, s.kv, backendSizeGetter{s}, s), nil
+	}
+	return v3compactor.New(s.Logger(), mode, retention, s.kv, s)
+}
+
+// backendSizeGetter returns the size in use of the backend of the server,
+// which is replaced when a snapshot is applied.
+type backendSizeGetter struct{ s *EtcdServer }
+
+func (g backendSizeGetter) SizeInUse() int64 { return g.s.Backend().SizeInUse() }
+
+// autoCompactionTargetBackendBytes returns the backend size targeted by the
+// adaptive auto compaction, which defaults to 80% of the backend quota.
+func autoCompactionTargetBackendBytes(target, quotaBackendBytes int64) int64 {
+	if target > 0 || quotaBackendBytes < 0 {
+		return target
+	}
+	if quotaBackendBytes == 0 {
+		quotaBackendBytes = serverstorage.DefaultQuotaBytes
+	}
+	return quotaBackendBytes / 10 * 8
 }
 
 func (s *EtcdServer) Defragment() error {
 	s.bemu.Lock()
 	defer s.bemu.Unlock()
+	s.defragActive.Store(true)
+	defer s.defragActive.Store(false)
 	return s.be.Defrag()
 }
 
+// IsDefragActive returns true while a defragmentation of the backend is running.
+func (s *EtcdServer) IsDefragActive() bool { return s.defragActive.Load() }
+
+// RecentWALFsyncDuration returns the given quantile of the WAL fsync durations
+// of the last minute.
+func (s *EtcdServer) RecentWALFsyncDuration(quantile float64) time.Duration {
+	return s.r.storage.RecentFsyncDuration(quantile)
+}
+
 func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
 	s.applySnapshot(ep, apply)
 	s.applyEntries(ep, apply)
@@ -1111,6 +1259,16 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 
 	lg.Info("restored alarm store")
 
+	if s.prefixQuotas != nil {
+		lg.Info("restoring prefix quota store")
+
+		if err := s.prefixQuotas.Recover(schema.NewQuotaBackend(lg, newbe)); err != nil {
+			lg.Panic("failed to restore prefix quota store", zap.Error(err))
+		}
+
+		lg.Info("restored prefix quota store")
+	}
+
 	if s.authStore != nil {
 		lg.Info("restoring auth store")
 
@@ -1166,8 +1324,8 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 }
 
 func (s *EtcdServer) NewUberApplier() apply.UberApplier {
-	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
-		s.Cfg.WarningApplyDuration, s.Cfg.ServerFeatureGate.Enabled(features.TxnModeWriteWithSharedBuffer), s.Cfg.QuotaBackendBytes)
+	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.prefixQuotas, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
+		s.WarningApplyDuration(), s.Cfg.ServerFeatureGate.Enabled(features.TxnModeWriteWithSharedBuffer), s.QuotaBackendBytes())
 }
 
 func verifySnapshotIndex(snapshot raftpb.Snapshot, cindex uint64) {
@@ -1206,6 +1364,7 @@ func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *toApply) {
 	if len(ents) == 0 {
 		return
 	}
+	s.reloadApplierIfStale()
 	var shouldstop bool
 	if ep.appliedt, ep.appliedi, shouldstop = s.apply(ents, &ep.confState, apply.raftAdvancedC); shouldstop {
 		go s.stopWithDelay(10*100*time.Millisecond, fmt.Errorf("the member has been permanently removed from the cluster"))
@@ -1509,7 +1668,11 @@ func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) ([]*membershi
 	if err := s.checkMembershipOperationPermission(ctx); err != nil {
 		return nil, err
 	}
+	return s.promoteLearner(ctx, id)
+}
 
+// promoteLearner is promoteMember without the permission check.
+func (s *EtcdServer) promoteLearner(ctx context.Context, id uint64) ([]*membership.Member, error) {
 	// check if we can promote this learner.
 	if err := s.mayPromoteMember(types.ID(id)); err != nil {
 		return nil, err
@@ -2008,8 +2171,8 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry, shouldApplyV3 membership.
 	lg := s.Logger()
 	lg.Warn(
 		"message exceeded backend quota; raising alarm",
-		zap.Int64("quota-size-bytes", s.Cfg.QuotaBackendBytes),
-		zap.String("quota-size", humanize.Bytes(uint64(s.Cfg.QuotaBackendBytes))),
+		zap.Int64("quota-size-bytes", s.QuotaBackendBytes()),
+		zap.String("quota-size", humanize.Bytes(uint64(s.QuotaBackendBytes()))),
 		zap.Error(ar.Err),
 	)
 
@@ -2035,7 +2198,7 @@ func (s *EtcdServer) applyInternalRaftRequest(r *pb.InternalRaftRequest, shouldA
 	op := "unknown"
 	defer func(start time.Time) {
 		txn.ApplySecObserve("v3", op, true, time.Since(start))
-		txn.WarnOfExpensiveRequest(s.lg, s.Cfg.WarningApplyDuration, start, &pb.InternalRaftStringer{Request: r}, nil, nil)
+		txn.WarnOfExpensiveRequest(s.lg, s.WarningApplyDuration(), start, &pb.InternalRaftStringer{Request: r}, nil, nil)
 	}(time.Now())
 	switch {
 	case r.ClusterVersionSet != nil:
@@ -2310,6 +2473,13 @@ func (s *EtcdServer) ClusterVersion() *semver.Version {
 	return s.cluster.Version()
 }
 
+// clusterVersionAtLeast returns whether all members run at least version v,
+// and the cluster is not being downgraded below it.
+func (s *EtcdServer) clusterVersionAtLeast(v semver.Version) bool {
+	cv := s.ClusterVersion()
+	return cv != nil && !cv.LessThan(v) && !s.DowngradeInfo().Enabled
+}
+
 func (s *EtcdServer) StorageVersion() *semver.Version {
 	// `applySnapshot` sets a new backend instance, so we need to acquire the bemu lock.
 	s.bemu.RLock()
@@ -2521,6 +2691,9 @@ func (s *EtcdServer) restoreAlarms() error {
 	return nil
 }
 
+// PrefixQuotas returns the store of the quotas scoped to key prefixes.
+func (s *EtcdServer) PrefixQuotas() *serverstorage.PrefixQuotaStore { return s.prefixQuotas }
+
 // GoAttach creates a goroutine on a given function and tracks it using
 // the etcdserver waitgroup.
 // The passed function should interrupt on s.StoppingNotify().
@@ -63,3 +63,17 @@ func (ac *AccessController) IsHostWhitelisted(host string) bool {
 	_, ok = ac.HostWhitelist[host]
 	return ok
 }
+
+// SetCORS replaces the allowed CORS origins.
+func (ac *AccessController) SetCORS(cors map[string]struct{}) {
+	ac.corsMu.Lock()
+	defer ac.corsMu.Unlock()
+	ac.CORS = cors
+}
+
+// SetHostWhitelist replaces the whitelisted hosts.
+func (ac *AccessController) SetHostWhitelist(hosts map[string]struct{}) {
+	ac.hostWhitelistMu.Lock()
+	defer ac.hostWhitelistMu.Unlock()
+	ac.HostWhitelist = hosts
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
+)
+
+// RuntimeConfig holds the settings of the server which can be changed without
+// a restart by UpdateRuntimeConfig. They override the settings of Cfg.
+type RuntimeConfig struct {
+	// MaxRequestBytes is the maximum size of the requests proposed over raft.
+	// The gRPC servers keep receiving messages up to the size set at startup.
+	MaxRequestBytes      uint
+	WarningApplyDuration time.Duration
+	QuotaBackendBytes    int64
+
+	AutoCompactionMode               string
+	AutoCompactionRetention          time.Duration
+	AutoCompactionTargetBackendBytes int64
+
+	CORS          map[string]struct{}
+	HostWhitelist map[string]struct{}
+}
+
+// ConfigReloader reloads the configuration of the server, e.g. from its
+// configuration file. It returns the names of the changed settings which were
+// applied, and of those which need a restart.
+type ConfigReloader func() (applied, restartRequired []string, err error)
+
+// MaxRequestBytes returns the maximum size of the requests proposed over raft.
+func (s *EtcdServer) MaxRequestBytes() uint {
+	if rc := s.runtimeConfig.Load(); rc != nil {
+		return rc.MaxRequestBytes
+	}
+	return s.Cfg.MaxRequestBytes
+}
+
+// WarningApplyDuration returns the duration after which the applies and the
+// reads are logged as expensive.
+func (s *EtcdServer) WarningApplyDuration() time.Duration {
+	if rc := s.runtimeConfig.Load(); rc != nil {
+		return rc.WarningApplyDuration
+	}
+	return s.Cfg.WarningApplyDuration
+}
+
+// QuotaBackendBytes returns the backend quota. It is the default quota if
+// zero, and disables the quota if negative.
+func (s *EtcdServer) QuotaBackendBytes() int64 {
+	if rc := s.runtimeConfig.Load(); rc != nil {
+		return rc.QuotaBackendBytes
+	}
+	return s.Cfg.QuotaBackendBytes
+}
+
+// SetConfigReloader sets the reloader of the configuration used by
+// ReloadConfig.
+func (s *EtcdServer) SetConfigReloader(r ConfigReloader) {
+	s.configReloaderMu.Lock()
+	defer s.configReloaderMu.Unlock()
+	s.configReloader = r
+}
+
+// ReloadConfig reloads the configuration of the server with its reloader. It
+// fails with ErrConfigReloadNotSupported if the server has no reloader.
+func (s *EtcdServer) ReloadConfig(ctx context.Context, r *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error) {
+	s.configReloaderMu.Lock()
+	defer s.configReloaderMu.Unlock()
+	if s.configReloader == nil {
+		return nil, errors.ErrConfigReloadNotSupported
+	}
+	applied, restartRequired, err := s.configReloader()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReloadConfigResponse{AppliedFields: applied, RestartRequiredFields: restartRequired}, nil
+}
+
+// UpdateRuntimeConfig applies the settings of rc. The applier picks up the
+// warning apply duration and the backend quota before applying the next
+// entries.
+func (s *EtcdServer) UpdateRuntimeConfig(rc RuntimeConfig) error {
+	target := autoCompactionTargetBackendBytes(rc.AutoCompactionTargetBackendBytes, rc.QuotaBackendBytes)
+	if err := s.updateCompactor(rc.AutoCompactionMode, rc.AutoCompactionRetention, target); err != nil {
+		return err
+	}
+
+	s.runtimeConfig.Store(&rc)
+	s.applierStale.Store(true)
+	s.AccessController.SetCORS(rc.CORS)
+	s.AccessController.SetHostWhitelist(rc.HostWhitelist)
+
+	lg := s.Logger()
+	serverstorage.UpdateBackendQuota(lg, rc.QuotaBackendBytes)
+	lg.Info(
+		"updated runtime configuration",
+		zap.Uint("max-request-bytes", rc.MaxRequestBytes),
+		zap.Duration("warning-apply-duration", rc.WarningApplyDuration),
+		zap.Int64("quota-backend-bytes", rc.QuotaBackendBytes),
+		zap.String("auto-compaction-mode", rc.AutoCompactionMode),
+		zap.Duration("auto-compaction-retention", rc.AutoCompactionRetention),
+		zap.Int64("auto-compaction-target-backend-bytes", target),
+		zap.Strings("cors", sortedKeys(rc.CORS)),
+		zap.Strings("host-whitelist", sortedKeys(rc.HostWhitelist)),
+	)
+	return nil
+}
+
+// updateCompactor replaces the compactor if its settings changed.
+func (s *EtcdServer) updateCompactor(mode string, retention time.Duration, target int64) error {
+	s.compactorMu.Lock()
+	defer s.compactorMu.Unlock()
+	// the compactor is stopped by Cleanup after stopping is closed.
+	select {
+	case <-s.stopping:
+		return errors.ErrStopped
+	default:
+	}
+	if mode == s.compactionMode && retention == s.compactionRetention && target == s.compactionTarget {
+		return nil
+	}
+
+	var c v3compactor.Compactor
+	if retention != 0 {
+		var err error
+		if c, err = s.newCompactor(mode, retention, target); err != nil {
+			return err
+		}
+	}
+	if s.compactor != nil {
+		s.compactor.Stop()
+	}
+	if c != nil {
+		if !s.isLeader() {
+			c.Pause()
+		}
+		c.Run()
+	}
+	s.compactor, s.compactionMode, s.compactionRetention, s.compactionTarget = c, mode, retention, target
+	return nil
+}
+
+// reloadApplierIfStale rebuilds the applier after a change of the runtime
+// configuration. It must be called by the apply routine.
+func (s *EtcdServer) reloadApplierIfStale() {
+	if s.applierStale.CompareAndSwap(true, false) {
+		s.uberApply = s.NewUberApplier()
+	}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	return slices.Sorted(maps.Keys(m))
+}
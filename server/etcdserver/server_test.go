@@ -1688,6 +1688,65 @@ func TestIsActive(t *testing.T) {
 	}
 }
 
+func TestLeaseReadSuspended(t *testing.T) {
+	cases := []struct {
+		name                   string
+		durationSinceLastTick  time.Duration
+		durationSinceDelayTick time.Duration
+		expectSuspended        bool
+	}{
+		{
+			name:                   "ticks on time",
+			durationSinceLastTick:  50 * time.Millisecond,
+			durationSinceDelayTick: time.Hour,
+			expectSuspended:        false,
+		},
+		{
+			name:                   "tick in progress delayed",
+			durationSinceLastTick:  300 * time.Millisecond,
+			durationSinceDelayTick: time.Hour,
+			expectSuspended:        true,
+		},
+		{
+			name:                   "tick delayed within the election timeout",
+			durationSinceLastTick:  50 * time.Millisecond,
+			durationSinceDelayTick: 500 * time.Millisecond,
+			expectSuspended:        true,
+		},
+		{
+			name:                   "tick delayed before the election timeout",
+			durationSinceLastTick:  50 * time.Millisecond,
+			durationSinceDelayTick: 1500 * time.Millisecond,
+			expectSuspended:        false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := EtcdServer{
+				Cfg: config.ServerConfig{
+					TickMs:                 100,
+					ElectionTicks:          10,
+					LeaseRead:              true,
+					LeaseReadMaxClockDrift: 100 * time.Millisecond,
+				},
+				memberID: 1,
+				lead:     1,
+				r: raftNode{
+					tickMu:        new(sync.RWMutex),
+					latestTickTs:  time.Now().Add(-tc.durationSinceLastTick),
+					delayedTickTs: time.Now().Add(-tc.durationSinceDelayTick),
+					raftNodeConfig: raftNodeConfig{
+						Node: newNodeNop(),
+					},
+				},
+			}
+
+			require.Equal(t, tc.expectSuspended, s.leaseReadSuspended())
+		})
+	}
+}
+
 func TestAddFeatureGateMetrics(t *testing.T) {
 	const testAlphaGate featuregate.Feature = "TestAlpha"
 	const testBetaGate featuregate.Feature = "TestBeta"
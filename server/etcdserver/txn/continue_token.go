@@ -0,0 +1,83 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// continueTokenV1 is the version prefix of continue tokens. A token is encoded
+// as the version, followed by the uvarint revision and the key to resume from.
+const continueTokenV1 byte = 1
+
+// continueToken identifies where a paginated range resumes.
+type continueToken struct {
+	// key is the first key of the next page.
+	key []byte
+	// rev is the revision the range is served at.
+	rev int64
+}
+
+func (ct continueToken) encode() []byte {
+	buf := make([]byte, 0, 1+binary.MaxVarintLen64+len(ct.key))
+	buf = append(buf, continueTokenV1)
+	buf = binary.AppendUvarint(buf, uint64(ct.rev))
+	return append(buf, ct.key...)
+}
+
+// decodeContinueToken decodes the continue token of the range request and
+// checks that it resumes the requested range.
+func decodeContinueToken(r *pb.RangeRequest) (continueToken, error) {
+	token := r.ContinueToken
+	if len(token) == 0 || token[0] != continueTokenV1 {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	rev, n := binary.Uvarint(token[1:])
+	if n <= 0 || rev == 0 || rev > uint64(1<<63-1) {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	ct := continueToken{key: token[1+n:], rev: int64(rev)}
+	if r.Revision != 0 && r.Revision != ct.rev {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	if !continueTokenInRange(ct.key, r.Key, mkGteRange(r.RangeEnd)) {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	return ct, nil
+}
+
+// continueTokenInRange returns true if key lies in the range [start, end).
+// An empty end denotes all keys >= start, a nil end denotes the single key start,
+// in which case nothing can be resumed.
+func continueTokenInRange(key, start, end []byte) bool {
+	if end == nil || bytes.Compare(key, start) <= 0 {
+		return false
+	}
+	return len(end) == 0 || bytes.Compare(key, end) < 0
+}
+
+// isResumableRange returns true if the response of the range request can be
+// resumed with a continue token, i.e. the keys are returned sorted by key in
+// ascending order.
+func isResumableRange(r *pb.RangeRequest) bool {
+	if r.SortTarget != pb.RangeRequest_KEY {
+		return false
+	}
+	return r.SortOrder == pb.RangeRequest_NONE || r.SortOrder == pb.RangeRequest_ASCEND
+}
@@ -17,6 +17,7 @@ package txn
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -27,7 +28,7 @@ import (
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
-	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	servererrors "go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
@@ -74,7 +75,7 @@ func put(ctx context.Context, txnWrite mvcc.TxnWrite, p *pb.PutRequest) (resp *p
 	if p.IgnoreValue || p.IgnoreLease {
 		if rr == nil || len(rr.KVs) == 0 {
 			// ignore_{lease,value} flag expects previous key-value pair
-			return nil, errors.ErrKeyNotFound
+			return nil, servererrors.ErrKeyNotFound
 		}
 	}
 	if p.IgnoreValue {
@@ -89,7 +90,11 @@ func put(ctx context.Context, txnWrite mvcc.TxnWrite, p *pb.PutRequest) (resp *p
 		}
 	}
 
-	resp.Header.Revision = txnWrite.Put(p.Key, val, leaseID)
+	if p.TTL > 0 {
+		resp.Header.Revision = txnWrite.PutWithTTL(p.Key, val, leaseID, p.TTL)
+	} else {
+		resp.Header.Revision = txnWrite.Put(p.Key, val, leaseID)
+	}
 	trace.AddField(traceutil.Field{Key: "response_revision", Value: resp.Header.Revision})
 	return resp, nil
 }
@@ -155,8 +160,33 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 	resp := &pb.RangeResponse{}
 	resp.Header = &pb.ResponseHeader{}
 
+	key, rev := r.Key, r.Revision
+	if len(r.ContinueToken) != 0 {
+		if !isResumableRange(r) {
+			return nil, servererrors.ErrInvalidContinueToken
+		}
+		ct, err := decodeContinueToken(r)
+		if err != nil {
+			return nil, err
+		}
+		key, rev = ct.key, ct.rev
+	}
+
+	sortOrder := r.SortOrder
+	if r.SortTarget != pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_NONE {
+		// Since current mvcc.Range implementation returns results
+		// sorted by keys in lexiographically ascending order,
+		// sort ASCEND by default only when target is not 'KEY'
+		sortOrder = pb.RangeRequest_ASCEND
+	} else if r.SortTarget == pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_ASCEND {
+		// Since current mvcc.Range implementation returns results
+		// sorted by keys in lexiographically ascending order,
+		// don't re-sort when target is 'KEY' and order is ASCEND
+		sortOrder = pb.RangeRequest_NONE
+	}
+
 	limit := r.Limit
-	if r.SortOrder != pb.RangeRequest_NONE ||
+	if sortOrder != pb.RangeRequest_NONE ||
 		r.MinModRevision != 0 || r.MaxModRevision != 0 ||
 		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0 {
 		// fetch everything; sort and truncate afterwards
@@ -169,12 +199,15 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 
 	ro := mvcc.RangeOptions{
 		Limit: limit,
-		Rev:   r.Revision,
+		Rev:   rev,
 		Count: r.CountOnly,
 	}
 
-	rr, err := txnRead.Range(ctx, r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txnRead.Range(ctx, key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
+		if len(r.ContinueToken) != 0 && errors.Is(err, mvcc.ErrCompacted) {
+			return nil, servererrors.ErrContinueTokenCompacted
+		}
 		return nil, err
 	}
 
@@ -195,18 +228,6 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 		pruneKVs(rr, f)
 	}
 
-	sortOrder := r.SortOrder
-	if r.SortTarget != pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_NONE {
-		// Since current mvcc.Range implementation returns results
-		// sorted by keys in lexiographically ascending order,
-		// sort ASCEND by default only when target is not 'KEY'
-		sortOrder = pb.RangeRequest_ASCEND
-	} else if r.SortTarget == pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_ASCEND {
-		// Since current mvcc.Range implementation returns results
-		// sorted by keys in lexiographically ascending order,
-		// don't re-sort when target is 'KEY' and order is ASCEND
-		sortOrder = pb.RangeRequest_NONE
-	}
 	if sortOrder != pb.RangeRequest_NONE {
 		var sorter sort.Interface
 		switch {
@@ -234,6 +255,18 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if isResumableRange(r) {
+			lastKey := rr.KVs[len(rr.KVs)-1].Key
+			next := make([]byte, len(lastKey)+1)
+			copy(next, lastKey)
+			// rr.Rev is the current revision of the store, resume at
+			// the revision the range was served at instead
+			tokenRev := rev
+			if tokenRev == 0 {
+				tokenRev = rr.Rev
+			}
+			resp.ContinueToken = continueToken{key: next, rev: tokenRev}.encode()
+		}
 	}
 	trace.Step("filter and sort the key-value pairs")
 	resp.Header.Revision = rr.Rev
@@ -418,7 +451,7 @@ func checkPut(rv mvcc.ReadView, lessor lease.Lessor, req *pb.PutRequest) error {
 			return err
 		}
 		if rr == nil || len(rr.KVs) == 0 {
-			return errors.ErrKeyNotFound
+			return servererrors.ErrKeyNotFound
 		}
 	}
 	if lease.LeaseID(req.Lease) != lease.NoLease {
@@ -430,12 +463,26 @@ func checkPut(rv mvcc.ReadView, lessor lease.Lessor, req *pb.PutRequest) error {
 }
 
 func checkRange(rv mvcc.ReadView, req *pb.RangeRequest) error {
+	rev := req.Revision
+	if len(req.ContinueToken) != 0 {
+		if !isResumableRange(req) {
+			return servererrors.ErrInvalidContinueToken
+		}
+		ct, err := decodeContinueToken(req)
+		if err != nil {
+			return err
+		}
+		if ct.rev < rv.FirstRev() {
+			return servererrors.ErrContinueTokenCompacted
+		}
+		rev = ct.rev
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRev():
 		return mvcc.ErrCompacted
 	}
 	return nil
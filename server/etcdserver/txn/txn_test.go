@@ -87,6 +87,60 @@ var rangeTestCases = []testCase{
 		},
 		expectError: "mvcc: required revision has been compacted",
 	},
+	{
+		name: "Range with malformed continue token should fail",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestRange{
+				RequestRange: &pb.RangeRequest{
+					Key:           []byte("a"),
+					RangeEnd:      []byte("b"),
+					ContinueToken: []byte("foo"),
+				},
+			},
+		},
+		expectError: "etcdserver: invalid continue token",
+	},
+	{
+		name: "Range with continue token outside of the range should fail",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestRange{
+				RequestRange: &pb.RangeRequest{
+					Key:           []byte("a"),
+					RangeEnd:      []byte("b"),
+					ContinueToken: continueToken{key: []byte("c"), rev: 1}.encode(),
+				},
+			},
+		},
+		expectError: "etcdserver: invalid continue token",
+	},
+	{
+		name: "Range with continue token and a different revision should fail",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestRange{
+				RequestRange: &pb.RangeRequest{
+					Key:           []byte("a"),
+					RangeEnd:      []byte("b"),
+					Revision:      2,
+					ContinueToken: continueToken{key: []byte("a\x00"), rev: 1}.encode(),
+				},
+			},
+		},
+		expectError: "etcdserver: invalid continue token",
+	},
+	{
+		name:  "Range with continue token on compacted rev should fail",
+		setup: testSetup{compactRevision: 10},
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestRange{
+				RequestRange: &pb.RangeRequest{
+					Key:           []byte("a"),
+					RangeEnd:      []byte("b"),
+					ContinueToken: continueToken{key: []byte("a\x00"), rev: 9}.encode(),
+				},
+			},
+		},
+		expectError: "etcdserver: revision of continue token has been compacted",
+	},
 }
 
 var putTestCases = []testCase{
@@ -281,6 +335,68 @@ func TestCheckRange(t *testing.T) {
 	}
 }
 
+func TestRangeContinueToken(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(k), []byte("v"), lease.NoLease)
+	}
+	rev := s.Rev()
+	// keys put after the first page are not returned by the next pages
+	s.Put([]byte("c0"), []byte("v"), lease.NoLease)
+	s.Put([]byte("d"), []byte("v2"), lease.NoLease)
+
+	req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, Revision: rev}
+	var keys []string
+	for pages := 1; ; pages++ {
+		resp, _, err := Range(context.TODO(), zaptest.NewLogger(t), s, req)
+		require.NoError(t, err)
+		for _, kv := range resp.Kvs {
+			assert.LessOrEqual(t, kv.ModRevision, rev, "unexpected revision of key %q", kv.Key)
+			keys = append(keys, string(kv.Key))
+		}
+		if !resp.More {
+			assert.Empty(t, resp.ContinueToken)
+			assert.Equal(t, 3, pages)
+			break
+		}
+		require.NotEmpty(t, resp.ContinueToken)
+		req = &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, ContinueToken: resp.ContinueToken}
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+
+	// sorting by key ascending reads only the page from the store
+	txnRead := &limitRecordingTxnRead{TxnRead: s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())}
+	resp, err := executeRange(context.TODO(), zaptest.NewLogger(t), txnRead, &pb.RangeRequest{
+		Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2,
+		SortTarget: pb.RangeRequest_KEY, SortOrder: pb.RangeRequest_ASCEND,
+		ContinueToken: continueToken{key: []byte("b"), rev: rev}.encode(),
+	})
+	txnRead.End()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), txnRead.limit)
+	assert.True(t, resp.More)
+	assert.Len(t, resp.Kvs, 2)
+
+	// sorting by anything but key ascending cannot be resumed
+	resp, _, err = Range(context.TODO(), zaptest.NewLogger(t), s, &pb.RangeRequest{
+		Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, SortOrder: pb.RangeRequest_DESCEND,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.More)
+	assert.Empty(t, resp.ContinueToken)
+}
+
+// limitRecordingTxnRead records the limit of the last range read from the store.
+type limitRecordingTxnRead struct {
+	mvcc.TxnRead
+	limit int64
+}
+
+func (tr *limitRecordingTxnRead) Range(ctx context.Context, key, end []byte, ro mvcc.RangeOptions) (*mvcc.RangeResult, error) {
+	tr.limit = ro.Limit
+	return tr.TxnRead.Range(ctx, key, end, ro)
+}
+
 func setup(t *testing.T, setup testSetup) (mvcc.KV, lease.Lessor) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
 	t.Cleanup(func() {
@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
@@ -112,7 +113,7 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	var resp *pb.RangeResponse
 	var err error
 	defer func(start time.Time) {
-		txn.WarnOfExpensiveReadOnlyRangeRequest(s.Logger(), s.Cfg.WarningApplyDuration, start, r, resp, err)
+		txn.WarnOfExpensiveReadOnlyRangeRequest(s.Logger(), s.WarningApplyDuration(), start, r, resp, err)
 		if resp != nil {
 			trace.AddField(
 				traceutil.Field{Key: "response_count", Value: len(resp.Kvs)},
@@ -142,6 +143,10 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 }
 
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	// v3.6 members would drop the ttl of the put.
+	if r.TTL > 0 && !s.clusterVersionAtLeast(version.V3_7) {
+		return nil, errors.ErrClusterVersionTooLow
+	}
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
@@ -179,7 +184,7 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		}
 
 		defer func(start time.Time) {
-			txn.WarnOfExpensiveReadOnlyTxnRequest(s.Logger(), s.Cfg.WarningApplyDuration, start, r, resp, err)
+			txn.WarnOfExpensiveReadOnlyTxnRequest(s.Logger(), s.WarningApplyDuration(), start, r, resp, err)
 			trace.LogIfLong(traceThreshold)
 		}(time.Now())
 
@@ -192,6 +197,9 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		return resp, err
 	}
 
+	if hasTTLPut(r) && !s.clusterVersionAtLeast(version.V3_7) {
+		return nil, errors.ErrClusterVersionTooLow
+	}
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Txn: r})
 	if err != nil {
@@ -200,6 +208,25 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 	return resp.(*pb.TxnResponse), nil
 }
 
+// hasTTLPut returns whether a put of the txn, or of its nested txns, has a ttl.
+func hasTTLPut(r *pb.TxnRequest) bool {
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				if tv.RequestPut.TTL > 0 {
+					return true
+				}
+			case *pb.RequestOp_RequestTxn:
+				if hasTTLPut(tv.RequestTxn) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	startTime := time.Now()
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
@@ -487,6 +514,18 @@ func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmRe
 	return resp.(*pb.AlarmResponse), nil
 }
 
+func (s *EtcdServer) PrefixQuota(ctx context.Context, r *pb.PrefixQuotaRequest) (*pb.PrefixQuotaResponse, error) {
+	// v3.6 members can not apply prefix quota requests.
+	if !s.clusterVersionAtLeast(version.V3_7) {
+		return nil, errors.ErrClusterVersionTooLow
+	}
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{PrefixQuota: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.PrefixQuotaResponse), nil
+}
+
 func (s *EtcdServer) AuthEnable(ctx context.Context, r *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{AuthEnable: r})
 	if err != nil {
@@ -648,6 +687,11 @@ func (s *EtcdServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb
 }
 
 func (s *EtcdServer) RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
+	// v3.6 members would store a deny permission as an allow one, and
+	// don't know the ADMIN permission type.
+	if r.Perm != nil && (r.Perm.Deny || r.Perm.PermType == authpb.ADMIN) && !s.clusterVersionAtLeast(version.V3_7) {
+		return nil, errors.ErrClusterVersionTooLow
+	}
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleGrantPermission: r})
 	if err != nil {
 		return nil, err
@@ -746,6 +790,12 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	r.Header = &pb.RequestHeader{
 		ID: s.reqIDGen.Next(),
 	}
+	// the proposal time is a v3.7 field. It is left out until all members
+	// can record it and during a downgrade, as it would prevent the storage
+	// from being downgraded while the entry is in the WAL.
+	if cv := s.ClusterVersion(); cv != nil && !cv.LessThan(version.V3_7) && !s.DowngradeInfo().Enabled {
+		r.Header.Timestamp = time.Now().UnixNano()
+	}
 
 	// check authinfo if it is not InternalAuthenticateRequest
 	if r.Authenticate == nil {
@@ -754,8 +804,14 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 			return nil, err
 		}
 		if authInfo != nil {
+			// v3.6 members ignore the roles of the token, and would check
+			// the request against the roles of a user of the same name.
+			if len(authInfo.Roles) > 0 && !s.clusterVersionAtLeast(version.V3_7) {
+				return nil, errors.ErrClusterVersionTooLow
+			}
 			r.Header.Username = authInfo.Username
 			r.Header.AuthRevision = authInfo.Revision
+			r.Header.Roles = authInfo.Roles
 		}
 	}
 
@@ -764,7 +820,7 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 		return nil, err
 	}
 
-	if len(data) > int(s.Cfg.MaxRequestBytes) {
+	if len(data) > int(s.MaxRequestBytes()) {
 		return nil, errors.ErrRequestTooLarge
 	}
 
@@ -824,10 +880,21 @@ func (s *EtcdServer) linearizableReadLoop() {
 		s.readNotifier = nextnr
 		s.readMu.Unlock()
 
+		term := s.Term()
 		confirmedIndex, err := s.requestCurrentIndex(leaderChangedNotifier, requestID)
 		if isStopped(err) {
 			return
 		}
+		if err == nil && s.Cfg.LeaseRead && s.isLeader() {
+			if s.Term() != term || s.leaseReadSuspended() {
+				// the lease may have confirmed the read index after another
+				// member was elected.
+				leaseReads.WithLabelValues("suspended").Inc()
+				err = errors.ErrLeaderChanged
+			} else {
+				leaseReads.WithLabelValues("local").Inc()
+			}
+		}
 		if err != nil {
 			nr.notify(err)
 			continue
@@ -74,6 +74,9 @@ const (
 	// alpha: v3.6
 	// main PR: https://github.com/etcd-io/etcd/pull/17661
 	SetMemberLocalAddr featuregate.Feature = "SetMemberLocalAddr"
+	// LearnerAutoPromotion enables leader to promote a learner once it stays caught up with the leader for a sustained period.
+	// alpha: v3.7
+	LearnerAutoPromotion featuregate.Feature = "LearnerAutoPromotion"
 )
 
 var (
@@ -85,6 +88,7 @@ var (
 		LeaseCheckpoint:              {Default: false, PreRelease: featuregate.Alpha},
 		LeaseCheckpointPersist:       {Default: false, PreRelease: featuregate.Alpha},
 		SetMemberLocalAddr:           {Default: false, PreRelease: featuregate.Alpha},
+		LearnerAutoPromotion:         {Default: false, PreRelease: featuregate.Alpha},
 	}
 	// ExperimentalFlagToFeatureMap is the map from the cmd line flags of experimental features
 	// to their corresponding feature gates.
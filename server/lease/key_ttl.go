@@ -0,0 +1,290 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// maximum number of expired keys to batch into a single consensus log entry
+var maxKeyExpireBatchSize = 1000
+
+// keyTTL tracks the expiry of a key put with a ttl. Like leases, keys only
+// expire on the primary lessor, the expiry of keys on other lessors is forever.
+type keyTTL struct {
+	key string
+	// rev is the revision of the put that set the ttl of the key.
+	rev          int64
+	ttl          int64
+	remainingTTL int64
+	expiry       time.Time
+	// persisted is set if the remainingTTL is checkpointed to the backend.
+	persisted bool
+}
+
+func (kt *keyTTL) getRemainingTTL() int64 {
+	if kt.remainingTTL > 0 {
+		return kt.remainingTTL
+	}
+	return kt.ttl
+}
+
+func (kt *keyTTL) refresh(extend time.Duration) {
+	kt.expiry = time.Now().Add(extend + time.Duration(kt.getRemainingTTL())*time.Second)
+}
+
+// keyWithTime identifies a key put at a revision with a time. For the key expiry
+// heap, time is the expiration time. For the key checkpoint heap, time is the
+// next checkpoint time. Items are not removed from the heaps when a key is
+// removed or put again, they are dropped when popped instead.
+type keyWithTime struct {
+	key  string
+	rev  int64
+	time time.Time
+}
+
+type keyQueue []*keyWithTime
+
+func (pq keyQueue) Len() int { return len(pq) }
+
+func (pq keyQueue) Less(i, j int) bool {
+	return pq[i].time.Before(pq[j].time)
+}
+
+func (pq keyQueue) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+}
+
+func (pq *keyQueue) Push(x any) {
+	*pq = append(*pq, x.(*keyWithTime))
+}
+
+func (pq *keyQueue) Pop() any {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[0 : n-1]
+	return item
+}
+
+// SetKeyTTL starts tracking the expiry of the key put at the given revision with the given ttl.
+// A put replacing a checkpointed key must hold the backend batch tx lock.
+func (le *lessor) SetKeyTTL(key []byte, rev int64, ttl int64) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	kt, ok := le.keyTTLs[string(key)]
+	if ok && kt.rev == rev {
+		// the key was checkpointed before the lessor recovered
+		kt.ttl = ttl
+	} else {
+		if ok {
+			le.unsafeDeleteKeyCheckpoint(kt)
+		}
+		kt = &keyTTL{key: string(key), rev: rev, ttl: ttl, expiry: forever}
+		le.keyTTLs[kt.key] = kt
+	}
+	if le.isPrimary() {
+		kt.refresh(0)
+		heap.Push(&le.keyExpiryHeap, &keyWithTime{key: kt.key, rev: kt.rev, time: kt.expiry})
+		le.scheduleKeyCheckpointIfNeeded(kt)
+	}
+}
+
+// RemoveKeyTTL stops tracking the expiry of the key. Removing a checkpointed
+// key must hold the backend batch tx lock, so the checkpoint is deleted in the
+// same backend transaction as the key.
+func (le *lessor) RemoveKeyTTL(key []byte) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	if kt, ok := le.keyTTLs[string(key)]; ok {
+		le.unsafeDeleteKeyCheckpoint(kt)
+		delete(le.keyTTLs, string(key))
+	}
+}
+
+func (le *lessor) unsafeDeleteKeyCheckpoint(kt *keyTTL) {
+	if kt.persisted {
+		schema.UnsafeDeleteKeyTTL(le.b.BatchTx(), []byte(kt.key))
+		kt.persisted = false
+	}
+}
+
+// CheckpointKeyTTL applies the remainingTTL of the key put at the given revision
+// and persists it, so the key does not get its full ttl back on restart.
+func (le *lessor) CheckpointKeyTTL(key []byte, rev int64, remainingTTL int64) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	if kt, ok := le.keyTTLs[string(key)]; ok && kt.rev == rev {
+		kt.remainingTTL = remainingTTL
+		tx := le.b.BatchTx()
+		tx.LockInsideApply()
+		schema.UnsafeCreateKeyTTLBucket(tx)
+		schema.MustUnsafePutKeyTTL(tx, &pb.KeyExpiry{Key: key, ModRevision: rev, Remaining_TTL: remainingTTL})
+		tx.Unlock()
+		kt.persisted = true
+		if le.isPrimary() {
+			le.scheduleKeyCheckpointIfNeeded(kt)
+		}
+	}
+}
+
+func (le *lessor) ExpiredKeysC() <-chan []*pb.KeyExpiry {
+	return le.expiredKeysC
+}
+
+// promoteKeyTTLs refreshes the expiries of all keys with a ttl. It must be
+// called with le.mu held.
+func (le *lessor) promoteKeyTTLs(extend time.Duration) {
+	for _, kt := range le.keyTTLs {
+		kt.refresh(extend)
+		heap.Push(&le.keyExpiryHeap, &keyWithTime{key: kt.key, rev: kt.rev, time: kt.expiry})
+		le.scheduleKeyCheckpointIfNeeded(kt)
+	}
+}
+
+// demoteKeyTTLs sets the expiries of all keys with a ttl to forever. It must be
+// called with le.mu held.
+func (le *lessor) demoteKeyTTLs() {
+	for _, kt := range le.keyTTLs {
+		kt.expiry = forever
+	}
+	le.keyExpiryHeap = make(keyQueue, 0)
+	le.keyCheckpointHeap = make(keyQueue, 0)
+}
+
+// expireKeys finds all keys past their expiry and sends them to the expired
+// keys channel to be deleted.
+func (le *lessor) expireKeys() {
+	var ks []*pb.KeyExpiry
+
+	le.mu.Lock()
+	if le.isPrimary() {
+		ks = le.findExpiredKeys(maxKeyExpireBatchSize)
+	}
+	le.mu.Unlock()
+
+	if len(ks) != 0 {
+		select {
+		case <-le.stopC:
+			return
+		case le.expiredKeysC <- ks:
+		default:
+			// the receiver of expiredKeysC is probably busy handling
+			// other stuff, the keys are retried after the retry interval
+		}
+	}
+}
+
+func (le *lessor) findExpiredKeys(limit int) []*pb.KeyExpiry {
+	now := time.Now()
+	var ks []*pb.KeyExpiry
+	for le.keyExpiryHeap.Len() > 0 && len(ks) < limit {
+		item := le.keyExpiryHeap[0]
+		if now.Before(item.time) {
+			break
+		}
+		heap.Pop(&le.keyExpiryHeap)
+		kt, ok := le.keyTTLs[item.key]
+		if !ok || kt.rev != item.rev || now.Before(kt.expiry) {
+			// the key was deleted, put again or its expiry was moved
+			continue
+		}
+		// recheck if the key is deleted after the retry interval
+		item.time = now.Add(le.expiredLeaseRetryInterval)
+		heap.Push(&le.keyExpiryHeap, item)
+		ks = append(ks, &pb.KeyExpiry{Key: []byte(kt.key), ModRevision: kt.rev})
+	}
+	return ks
+}
+
+// checkpointScheduledKeys finds all scheduled key checkpoints that are due and
+// submits them to the checkpointer to persist them to the consensus log.
+func (le *lessor) checkpointScheduledKeys() {
+	for i := 0; i < leaseCheckpointRate/2; i++ {
+		var cps []*pb.KeyExpiry
+
+		le.mu.Lock()
+		if le.isPrimary() {
+			cps = le.findDueScheduledKeyCheckpoints(maxLeaseCheckpointBatchSize)
+		}
+		le.mu.Unlock()
+
+		if len(cps) != 0 {
+			if err := le.keyCp(context.Background(), &pb.LeaseCheckpointRequest{KeyCheckpoints: cps}); err != nil {
+				return
+			}
+		}
+		if len(cps) < maxLeaseCheckpointBatchSize {
+			return
+		}
+	}
+}
+
+func (le *lessor) scheduleKeyCheckpointIfNeeded(kt *keyTTL) {
+	if le.keyCp == nil {
+		return
+	}
+
+	if kt.getRemainingTTL() > int64(le.checkpointInterval.Seconds()) {
+		heap.Push(&le.keyCheckpointHeap, &keyWithTime{
+			key:  kt.key,
+			rev:  kt.rev,
+			time: time.Now().Add(le.checkpointInterval),
+		})
+	}
+}
+
+func (le *lessor) findDueScheduledKeyCheckpoints(checkpointLimit int) []*pb.KeyExpiry {
+	if le.keyCp == nil {
+		return nil
+	}
+
+	now := time.Now()
+	var cps []*pb.KeyExpiry
+	for le.keyCheckpointHeap.Len() > 0 && len(cps) < checkpointLimit {
+		item := le.keyCheckpointHeap[0]
+		if item.time.After(now) {
+			return cps
+		}
+		heap.Pop(&le.keyCheckpointHeap)
+		kt, ok := le.keyTTLs[item.key]
+		if !ok || kt.rev != item.rev || !now.Before(kt.expiry) {
+			continue
+		}
+		remainingTTL := int64(math.Ceil(kt.expiry.Sub(now).Seconds()))
+		if remainingTTL >= kt.ttl {
+			continue
+		}
+		if le.lg != nil {
+			le.lg.Debug("Checkpointing key ttl",
+				zap.String("key", kt.key),
+				zap.Int64("remainingTTL", remainingTTL),
+			)
+		}
+		cps = append(cps, &pb.KeyExpiry{Key: []byte(kt.key), ModRevision: kt.rev, Remaining_TTL: remainingTTL})
+	}
+	return cps
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestLessorKeyTTLExpire(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+
+	le.Promote(0)
+	le.SetKeyTTL([]byte("foo"), 5, 1)
+
+	select {
+	case ks := <-le.ExpiredKeysC():
+		if len(ks) != 1 || string(ks[0].Key) != "foo" || ks[0].ModRevision != 5 {
+			t.Fatalf("expired keys = %v, want [foo@5]", ks)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("failed to receive expired key")
+	}
+}
+
+// TestLessorKeyTTLFindExpired ensures only keys whose latest ttl elapsed are
+// reported as expired.
+func TestLessorKeyTTLFindExpired(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+
+	le.Promote(0)
+	le.SetKeyTTL([]byte("expired"), 2, 1)
+	le.SetKeyTTL([]byte("removed"), 3, 1)
+	le.RemoveKeyTTL([]byte("removed"))
+	le.SetKeyTTL([]byte("reput"), 4, 1)
+	le.SetKeyTTL([]byte("reput"), 5, 100)
+
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	// move every expiry of a ttl of 1 second to the past
+	past := time.Now().Add(-time.Second)
+	for _, item := range le.keyExpiryHeap {
+		if kt := le.keyTTLs[item.key]; kt == nil || kt.ttl == 1 {
+			item.time = past
+		}
+	}
+	le.keyTTLs["expired"].expiry = past
+
+	ks := le.findExpiredKeys(maxKeyExpireBatchSize)
+	if len(ks) != 1 || string(ks[0].Key) != "expired" || ks[0].ModRevision != 2 {
+		t.Fatalf("expired keys = %v, want [expired@2]", ks)
+	}
+	// the expired key is retried after the retry interval
+	if ks = le.findExpiredKeys(maxKeyExpireBatchSize); len(ks) != 0 {
+		t.Fatalf("expired keys = %v, want none before the retry interval", ks)
+	}
+}
+
+func TestLessorKeyTTLDemote(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+
+	le.SetKeyTTL([]byte("foo"), 5, 10)
+	le.mu.RLock()
+	if !le.keyTTLs["foo"].expiry.IsZero() || le.keyExpiryHeap.Len() != 0 {
+		t.Errorf("expected key to never expire on a non-primary lessor")
+	}
+	le.mu.RUnlock()
+
+	le.Promote(0)
+	le.mu.RLock()
+	if le.keyTTLs["foo"].expiry.IsZero() || le.keyExpiryHeap.Len() != 1 {
+		t.Errorf("expected key to expire on the primary lessor")
+	}
+	le.mu.RUnlock()
+
+	le.Demote()
+	le.mu.RLock()
+	if !le.keyTTLs["foo"].expiry.IsZero() || le.keyExpiryHeap.Len() != 0 {
+		t.Errorf("expected key to never expire after demotion")
+	}
+	le.mu.RUnlock()
+}
+
+func TestLessorKeyTTLCheckpointsRestoredOnPromote(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+
+	le.SetKeyTTL([]byte("foo"), 5, 10)
+	le.CheckpointKeyTTL([]byte("foo"), 5, 5)
+	// checkpoints of an older put of the key are ignored
+	le.CheckpointKeyTTL([]byte("foo"), 4, 1)
+	le.Promote(0)
+
+	le.mu.RLock()
+	remaining := time.Until(le.keyTTLs["foo"].expiry).Seconds()
+	le.mu.RUnlock()
+	if !(remaining > 4 && remaining <= 5) {
+		t.Fatalf("expected expiry to be 5s in the future, but got %f seconds", remaining)
+	}
+}
+
+// TestLessorKeyTTLCheckpointRecover ensures the checkpointed remaining ttl of a
+// key is recovered on restart until the key is removed.
+func TestLessorKeyTTLCheckpointRecover(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	le.SetKeyTTL([]byte("foo"), 5, 10)
+	le.CheckpointKeyTTL([]byte("foo"), 5, 5)
+	le.Stop()
+
+	le = newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	// the key-value pair is recovered
+	le.SetKeyTTL([]byte("foo"), 5, 10)
+	le.mu.RLock()
+	remaining := le.keyTTLs["foo"].getRemainingTTL()
+	le.mu.RUnlock()
+	if remaining != 5 {
+		t.Fatalf("remaining ttl = %d, want 5", remaining)
+	}
+
+	tx := be.BatchTx()
+	tx.Lock()
+	le.RemoveKeyTTL([]byte("foo"))
+	tx.Unlock()
+	le.Stop()
+
+	le = newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	if _, ok := le.keyTTLs["foo"]; ok {
+		t.Fatalf("expected the checkpoint of the removed key to be deleted")
+	}
+}
+
+func TestLessorKeyTTLCheckpointScheduling(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL, CheckpointInterval: 1 * time.Second})
+	defer le.Stop()
+	checkpointedC := make(chan *pb.KeyExpiry, 1)
+	le.SetKeyCheckpointer(func(ctx context.Context, lc *pb.LeaseCheckpointRequest) error {
+		for _, c := range lc.KeyCheckpoints {
+			select {
+			case checkpointedC <- c:
+			default:
+			}
+		}
+		return nil
+	})
+	le.SetKeyTTL([]byte("foo"), 5, 2)
+	le.Promote(0)
+
+	select {
+	case c := <-checkpointedC:
+		if string(c.Key) != "foo" || c.ModRevision != 5 || c.Remaining_TTL != 1 {
+			t.Errorf("checkpoint = %v, want foo@5 with Remaining_TTL=1", c)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected checkpointer to be called, but it was not")
+	}
+}
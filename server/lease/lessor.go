@@ -88,6 +88,10 @@ type Lessor interface {
 
 	SetCheckpointer(cp Checkpointer)
 
+	// SetKeyCheckpointer sets the Checkpointer of the remaining ttls of the
+	// keys put with a ttl.
+	SetKeyCheckpointer(cp Checkpointer)
+
 	// Grant grants a lease that expires at least after TTL seconds.
 	Grant(id LeaseID, ttl int64) (*Lease, error)
 	// Revoke revokes a lease with given ID. The item attached to the
@@ -132,6 +136,21 @@ type Lessor interface {
 	// ExpiredLeasesC returns a chan that is used to receive expired leases.
 	ExpiredLeasesC() <-chan []*Lease
 
+	// SetKeyTTL starts tracking the expiry of a key put at revision rev with
+	// a ttl in seconds. The primary lessor reports the key through ExpiredKeysC
+	// once the ttl elapses.
+	SetKeyTTL(key []byte, rev int64, ttl int64)
+
+	// RemoveKeyTTL stops tracking the expiry of a key.
+	RemoveKeyTTL(key []byte)
+
+	// CheckpointKeyTTL applies the remainingTTL of a key put at revision rev. The remainingTTL
+	// is used in Promote to set the expiry of the key to less than the full TTL when possible.
+	CheckpointKeyTTL(key []byte, rev int64, remainingTTL int64)
+
+	// ExpiredKeysC returns a chan that is used to receive expired keys.
+	ExpiredKeysC() <-chan []*pb.KeyExpiry
+
 	// Recover recovers the lessor state from the given backend and RangeDeleter.
 	Recover(b backend.Backend, rd RangeDeleter)
 
@@ -154,6 +173,11 @@ type lessor struct {
 	leaseCheckpointHeap  LeaseQueue
 	itemMap              map[LeaseItem]LeaseID
 
+	// keyTTLs tracks the expiry of keys put with a ttl.
+	keyTTLs           map[string]*keyTTL
+	keyExpiryHeap     keyQueue
+	keyCheckpointHeap keyQueue
+
 	// When a lease expires, the lessor will delete the
 	// leased range (or key) by the RangeDeleter.
 	rd RangeDeleter
@@ -161,6 +185,9 @@ type lessor struct {
 	// When a lease's deadline should be persisted to preserve the remaining TTL across leader
 	// elections and restarts, the lessor will checkpoint the lease by the Checkpointer.
 	cp Checkpointer
+	// keyCp checkpoints the remaining ttls of the keys put with a ttl. Unlike
+	// lease checkpoints, they are persisted whenever keyCp is set.
+	keyCp Checkpointer
 
 	// backend to persist leases. We only persist lease ID and expiry for now.
 	// The leased items can be recovered by iterating all the keys in kv.
@@ -174,6 +201,8 @@ type lessor struct {
 	leaseRevokeRate int
 
 	expiredC chan []*Lease
+	// expiredKeysC is used to notify the expired keys.
+	expiredKeysC chan []*pb.KeyExpiry
 	// stopC is a channel whose closure indicates that the lessor should be stopped.
 	stopC chan struct{}
 	// doneC is a channel whose closure indicates that the lessor is stopped.
@@ -225,6 +254,9 @@ func newLessor(lg *zap.Logger, b backend.Backend, cluster cluster, cfg LessorCon
 	l := &lessor{
 		leaseMap:                  make(map[LeaseID]*Lease),
 		itemMap:                   make(map[LeaseItem]LeaseID),
+		keyTTLs:                   make(map[string]*keyTTL),
+		keyExpiryHeap:             make(keyQueue, 0),
+		keyCheckpointHeap:         make(keyQueue, 0),
 		leaseExpiredNotifier:      newLeaseExpiredNotifier(),
 		leaseCheckpointHeap:       make(LeaseQueue, 0),
 		b:                         b,
@@ -234,11 +266,12 @@ func newLessor(lg *zap.Logger, b backend.Backend, cluster cluster, cfg LessorCon
 		expiredLeaseRetryInterval: expiredLeaseRetryInterval,
 		checkpointPersist:         cfg.CheckpointPersist,
 		// expiredC is a small buffered chan to avoid unnecessary blocking.
-		expiredC: make(chan []*Lease, 16),
-		stopC:    make(chan struct{}),
-		doneC:    make(chan struct{}),
-		lg:       lg,
-		cluster:  cluster,
+		expiredC:     make(chan []*Lease, 16),
+		expiredKeysC: make(chan []*pb.KeyExpiry, 16),
+		stopC:        make(chan struct{}),
+		doneC:        make(chan struct{}),
+		lg:           lg,
+		cluster:      cluster,
 	}
 	l.initAndRecover()
 
@@ -278,6 +311,13 @@ func (le *lessor) SetCheckpointer(cp Checkpointer) {
 	le.cp = cp
 }
 
+func (le *lessor) SetKeyCheckpointer(cp Checkpointer) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	le.keyCp = cp
+}
+
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
@@ -491,6 +531,9 @@ func (le *lessor) Promote(extend time.Duration) {
 		le.scheduleCheckpointIfNeeded(l)
 	}
 
+	// refresh the expiries of all keys with a ttl.
+	le.promoteKeyTTLs(extend)
+
 	if len(le.leaseMap) < le.leaseRevokeRate {
 		// no possibility of lease pile-up
 		return
@@ -542,6 +585,7 @@ func (le *lessor) Demote() {
 
 	le.clearScheduledLeasesCheckpoints()
 	le.clearLeaseExpiredNotifier()
+	le.demoteKeyTTLs()
 
 	if le.demotec != nil {
 		close(le.demotec)
@@ -605,6 +649,11 @@ func (le *lessor) Recover(b backend.Backend, rd RangeDeleter) {
 	le.rd = rd
 	le.leaseMap = make(map[LeaseID]*Lease)
 	le.itemMap = make(map[LeaseItem]LeaseID)
+	// checkpointed keys with a ttl are filled in by initAndRecover, the
+	// others when recover key-value pairs
+	le.keyTTLs = make(map[string]*keyTTL)
+	le.keyExpiryHeap = make(keyQueue, 0)
+	le.keyCheckpointHeap = make(keyQueue, 0)
 	le.initAndRecover()
 }
 
@@ -626,6 +675,8 @@ func (le *lessor) runLoop() {
 	for {
 		le.revokeExpiredLeases()
 		le.checkpointScheduledLeases()
+		le.expireKeys()
+		le.checkpointScheduledKeys()
 
 		select {
 		case <-delayTicker.C:
@@ -811,6 +862,7 @@ func (le *lessor) initAndRecover() {
 	tx.LockOutsideApply()
 	schema.UnsafeCreateLeaseBucket(tx)
 	lpbs := schema.MustUnsafeGetAllLeases(tx)
+	kes := schema.MustUnsafeGetAllKeyTTLs(tx)
 	tx.Unlock()
 	for _, lpb := range lpbs {
 		ID := LeaseID(lpb.ID)
@@ -828,6 +880,16 @@ func (le *lessor) initAndRecover() {
 			remainingTTL: lpb.RemainingTTL,
 		}
 	}
+	for _, ke := range kes {
+		// the ttl is set when the key-value pair is recovered
+		le.keyTTLs[string(ke.Key)] = &keyTTL{
+			key:          string(ke.Key),
+			rev:          ke.ModRevision,
+			remainingTTL: ke.Remaining_TTL,
+			expiry:       forever,
+			persisted:    true,
+		}
+	}
 	le.leaseExpiredNotifier.Init()
 	heap.Init(&le.leaseCheckpointHeap)
 
@@ -844,6 +906,8 @@ func (fl *FakeLessor) SetRangeDeleter(dr RangeDeleter) {}
 
 func (fl *FakeLessor) SetCheckpointer(cp Checkpointer) {}
 
+func (fl *FakeLessor) SetKeyCheckpointer(cp Checkpointer) {}
+
 func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	fl.LeaseSet[id] = struct{}{}
 	return nil, nil
@@ -875,6 +939,14 @@ func (fl *FakeLessor) Leases() []*Lease { return nil }
 
 func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
 
+func (fl *FakeLessor) SetKeyTTL(key []byte, rev int64, ttl int64) {}
+
+func (fl *FakeLessor) RemoveKeyTTL(key []byte) {}
+
+func (fl *FakeLessor) CheckpointKeyTTL(key []byte, rev int64, remainingTTL int64) {}
+
+func (fl *FakeLessor) ExpiredKeysC() <-chan []*pb.KeyExpiry { return nil }
+
 func (fl *FakeLessor) Recover(b backend.Backend, rd RangeDeleter) {}
 
 func (fl *FakeLessor) Stop() {}
@@ -15,6 +15,8 @@
 package mockstorage
 
 import (
+	"time"
+
 	"github.com/coreos/go-semver/semver"
 
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
@@ -61,3 +63,5 @@ func (p *storageRecorder) Sync() error {
 
 func (p *storageRecorder) Close() error                        { return nil }
 func (p *storageRecorder) MinimalEtcdVersion() *semver.Version { return nil }
+
+func (p *storageRecorder) RecentFsyncDuration(float64) time.Duration { return 0 }
@@ -56,6 +56,14 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) PrefixQuota(ctx context.Context, r *pb.PrefixQuotaRequest, opts ...grpc.CallOption) (*pb.PrefixQuotaResponse, error) {
+	return s.mts.PrefixQuota(ctx, r)
+}
+
+func (s *mts2mtc) ReloadConfig(ctx context.Context, r *pb.ReloadConfigRequest, opts ...grpc.CallOption) (*pb.ReloadConfigResponse, error) {
+	return s.mts.ReloadConfig(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
@@ -92,3 +100,40 @@ func (s *ss2scServerStream) Recv() (*pb.SnapshotRequest, error) {
 	}
 	return v.(*pb.SnapshotRequest), nil
 }
+
+func (s *mts2mtc) SnapshotDelta(ctx context.Context, in *pb.SnapshotDeltaRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotDeltaClient, error) {
+	cs := newPipeStream(ctx, func(ss chanServerStream) error {
+		return s.mts.SnapshotDelta(in, &sds2sdcServerStream{ss})
+	})
+	return &sds2sdcClientStream{cs}, nil
+}
+
+// sds2sdcClientStream implements Maintenance_SnapshotDeltaClient
+type sds2sdcClientStream struct{ chanClientStream }
+
+// sds2sdcServerStream implements Maintenance_SnapshotDeltaServer
+type sds2sdcServerStream struct{ chanServerStream }
+
+func (s *sds2sdcClientStream) Send(rr *pb.SnapshotDeltaRequest) error {
+	return s.SendMsg(rr)
+}
+
+func (s *sds2sdcClientStream) Recv() (*pb.SnapshotDeltaResponse, error) {
+	var v any
+	if err := s.RecvMsg(&v); err != nil {
+		return nil, err
+	}
+	return v.(*pb.SnapshotDeltaResponse), nil
+}
+
+func (s *sds2sdcServerStream) Send(rr *pb.SnapshotDeltaResponse) error {
+	return s.SendMsg(rr)
+}
+
+func (s *sds2sdcServerStream) Recv() (*pb.SnapshotDeltaRequest, error) {
+	var v any
+	if err := s.RecvMsg(&v); err != nil {
+		return nil, err
+	}
+	return v.(*pb.SnapshotDeltaRequest), nil
+}
@@ -183,6 +183,9 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.KeysOnly {
 		opts = append(opts, clientv3.WithKeysOnly())
 	}
+	if len(r.ContinueToken) != 0 {
+		opts = append(opts, clientv3.WithContinueToken(r.ContinueToken))
+	}
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
@@ -199,6 +202,9 @@ func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
 	if r.IgnoreLease {
 		opts = append(opts, clientv3.WithIgnoreLease())
 	}
+	if r.TTL != 0 {
+		opts = append(opts, clientv3.WithTTL(r.TTL))
+	}
 	if r.PrevKv {
 		opts = append(opts, clientv3.WithPrevKV())
 	}
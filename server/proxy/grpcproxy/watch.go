@@ -257,6 +257,31 @@ func (wps *watchProxyStream) recvLoop() error {
 				continue
 			}
 
+			// the watchers of a range share the events of one broadcast, which
+			// can't hold them back for a single watcher.
+			if cr.CoalesceWindowMs != 0 || cr.CoalesceRevisions != 0 {
+				wps.watchCh <- &pb.WatchResponse{
+					Header:       &pb.ResponseHeader{},
+					WatchId:      clientv3.InvalidWatchID,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: rpctypes.ErrWatchCoalesceNotSupported.Error(),
+				}
+				continue
+			}
+
+			valueFilters, err := v3rpc.ValueFiltersFromRequest(cr)
+			if err != nil {
+				wps.watchCh <- &pb.WatchResponse{
+					Header:       &pb.ResponseHeader{},
+					WatchId:      clientv3.InvalidWatchID,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: err.Error(),
+				}
+				continue
+			}
+
 			wps.mu.Lock()
 			w := &watcher{
 				wr:  watchRange{string(cr.Key), string(cr.RangeEnd)},
@@ -266,7 +291,7 @@ func (wps *watchProxyStream) recvLoop() error {
 				nextrev:  cr.StartRevision,
 				progress: cr.ProgressNotify,
 				prevKV:   cr.PrevKv,
-				filters:  v3rpc.FiltersFromRequest(cr),
+				filters:  append(v3rpc.FiltersFromRequest(cr), valueFilters...),
 			}
 			if !w.wr.valid() {
 				w.post(&pb.WatchResponse{WatchId: clientv3.InvalidWatchID, Created: true, Canceled: true})
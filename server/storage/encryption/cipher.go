@@ -0,0 +1,247 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package encryption implements the envelope encryption at rest of the values
+// of the key bucket. The values are encrypted with AES-256-GCM data encryption
+// keys, which are stored in the backend wrapped with the key encryption keys of
+// a KMS. The WAL is not encrypted.
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+const (
+	dataKeySize = 32
+
+	// valueMagic starts the encrypted values. The plaintext values of the key
+	// bucket are marshaled mvccpb.KeyValue, which never start with a zero byte,
+	// or empty.
+	valueMagic   = 0x00
+	valueVersion = 1
+	// valueHeaderSize is the size of the magic, the version and the ID of the
+	// data encryption key.
+	valueHeaderSize = 6
+)
+
+var (
+	ErrKeyRequired       = errors.New("encryption: the data is encrypted, but no encryption key is configured")
+	ErrUnknownDataKey    = errors.New("encryption: unknown data encryption key")
+	ErrUnsupportedFormat = errors.New("encryption: unsupported encrypted value format")
+)
+
+// Cipher encrypts and decrypts the values of the key bucket. A nil Cipher
+// leaves the values in plaintext.
+type Cipher struct {
+	kms KMS
+
+	mu     sync.RWMutex
+	active uint32
+	aeads  map[uint32]cipher.AEAD
+}
+
+// NewCipher returns a cipher of the data encryption keys wrapped by kms, or nil
+// if kms is nil. The keys are loaded by UnsafeInit.
+func NewCipher(kms KMS) *Cipher {
+	if kms == nil {
+		return nil
+	}
+	return &Cipher{kms: kms}
+}
+
+// UnsafeInit loads the data encryption keys of the backend of tx, and creates
+// the first one if there is none. With a nil cipher, it fails if the values
+// of the backend were encrypted.
+func (c *Cipher) UnsafeInit(tx backend.UnsafeReadWriter) error {
+	if c == nil {
+		keys, _, err := schema.UnsafeReadDataKeys(tx)
+		if err != nil {
+			return err
+		}
+		if len(keys) != 0 {
+			return ErrKeyRequired
+		}
+		return nil
+	}
+	if err := c.unsafeLoad(tx); err != nil {
+		return err
+	}
+	c.mu.RLock()
+	empty := len(c.aeads) == 0
+	c.mu.RUnlock()
+	if empty {
+		return c.UnsafeRotate(tx)
+	}
+	return nil
+}
+
+// UnsafeCheck checks that the data encryption keys of the backend of tx can be
+// unwrapped by kms, or that there is none if kms is nil.
+func UnsafeCheck(tx backend.UnsafeReader, kms KMS) error {
+	keys, _, err := schema.UnsafeReadDataKeys(tx)
+	if err != nil {
+		return err
+	}
+	if kms == nil {
+		if len(keys) != 0 {
+			return ErrKeyRequired
+		}
+		return nil
+	}
+	for _, dk := range keys {
+		if _, err = kms.Unwrap(dk.KEKID, dk.Wrapped); err != nil {
+			return fmt.Errorf("cannot unwrap data encryption key %d: %w", dk.ID, err)
+		}
+	}
+	return nil
+}
+
+func (c *Cipher) unsafeLoad(tx backend.UnsafeReader) error {
+	keys, active, err := schema.UnsafeReadDataKeys(tx)
+	if err != nil {
+		return err
+	}
+	return c.Load(keys, active)
+}
+
+// Load unwraps the data encryption keys read from a backend, e.g. with
+// schema.ReadDataKeysFromSnapshot.
+func (c *Cipher) Load(keys []schema.DataKey, active uint32) error {
+	aeads := make(map[uint32]cipher.AEAD, len(keys))
+	for _, dk := range keys {
+		dek, err := c.kms.Unwrap(dk.KEKID, dk.Wrapped)
+		if err != nil {
+			return fmt.Errorf("cannot unwrap data encryption key %d: %w", dk.ID, err)
+		}
+		if aeads[dk.ID], err = newAEAD(dek); err != nil {
+			return fmt.Errorf("invalid data encryption key %d: %w", dk.ID, err)
+		}
+	}
+	if _, ok := aeads[active]; !ok && len(aeads) != 0 {
+		return fmt.Errorf("%w %d", ErrUnknownDataKey, active)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.active, c.aeads = active, aeads
+	return nil
+}
+
+// UnsafeRotate creates a new data encryption key, wrapped with the current key
+// encryption key of the KMS, to encrypt the new values. The values encrypted
+// with the previous keys are re-encrypted by Rotate.
+func (c *Cipher) UnsafeRotate(tx backend.UnsafeWriter) error {
+	dek := make([]byte, dataKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return err
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return err
+	}
+	wrapped, err := c.kms.Wrap(dek)
+	if err != nil {
+		return fmt.Errorf("cannot wrap data encryption key: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var id uint32
+	for kid := range c.aeads {
+		id = max(id, kid)
+	}
+	id++
+	schema.UnsafePutDataKey(tx, schema.DataKey{ID: id, KEKID: c.kms.KeyID(), Wrapped: wrapped})
+	if c.aeads == nil {
+		c.aeads = make(map[uint32]cipher.AEAD)
+	}
+	c.aeads[id], c.active = aead, id
+	return nil
+}
+
+// UnsafePrune deletes the data encryption keys other than the active one.
+func (c *Cipher) UnsafePrune(tx backend.UnsafeWriter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id := range c.aeads {
+		if id != c.active {
+			schema.UnsafeDeleteDataKey(tx, id)
+			delete(c.aeads, id)
+		}
+	}
+}
+
+// Encrypt encrypts the value of the key bucket at key with the active data
+// encryption key. The key authenticates the value, so that it cannot be moved
+// to another revision.
+func (c *Cipher) Encrypt(key, value []byte) []byte {
+	if c == nil {
+		return value
+	}
+	c.mu.RLock()
+	active, aead := c.active, c.aeads[c.active]
+	c.mu.RUnlock()
+
+	dst := make([]byte, valueHeaderSize, valueHeaderSize+aead.NonceSize()+len(value)+aead.Overhead())
+	dst[0], dst[1] = valueMagic, valueVersion
+	binary.BigEndian.PutUint32(dst[2:valueHeaderSize], active)
+	return sealTo(dst, aead, value, key)
+}
+
+// Decrypt returns the plaintext of the value of the key bucket at key. The
+// plaintext values, written before the encryption was enabled, are returned
+// as is.
+func (c *Cipher) Decrypt(key, value []byte) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	if c == nil {
+		return nil, ErrKeyRequired
+	}
+	if len(value) < valueHeaderSize || value[1] != valueVersion {
+		return nil, ErrUnsupportedFormat
+	}
+	id := binary.BigEndian.Uint32(value[2:valueHeaderSize])
+	c.mu.RLock()
+	aead, ok := c.aeads[id]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrUnknownDataKey, id)
+	}
+	return open(aead, value[valueHeaderSize:], key)
+}
+
+// isActive returns whether the value is encrypted with the active data
+// encryption key.
+func (c *Cipher) isActive(value []byte) bool {
+	if !IsEncrypted(value) || len(value) < valueHeaderSize {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return value[1] == valueVersion && binary.BigEndian.Uint32(value[2:valueHeaderSize]) == c.active
+}
+
+// IsEncrypted returns whether the value of the key bucket is encrypted.
+func IsEncrypted(value []byte) bool {
+	return len(value) > 0 && value[0] == valueMagic
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// newKeyLine returns the line of a key file of a new random key.
+func newKeyLine(t *testing.T, id string) string {
+	t.Helper()
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return fmt.Sprintf("%s:%s\n", id, base64.StdEncoding.EncodeToString(key))
+}
+
+// writeKeyFile writes a key file of the given lines.
+func writeKeyFile(t *testing.T, lines ...string) string {
+	t.Helper()
+	content := "# key encryption keys\n\n" + strings.Join(lines, "")
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLocalKMS(t *testing.T) {
+	kms, err := NewLocalKMS(writeKeyFile(t, newKeyLine(t, "k1"), newKeyLine(t, "k2")))
+	require.NoError(t, err)
+	assert.Equal(t, "k1", kms.KeyID())
+
+	dek := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, err := kms.Wrap(dek)
+	require.NoError(t, err)
+	assert.NotContains(t, string(wrapped), string(dek))
+
+	got, err := kms.Unwrap("k1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dek, got)
+
+	_, err = kms.Unwrap("k2", wrapped)
+	require.Error(t, err)
+	_, err = kms.Unwrap("k3", wrapped)
+	require.ErrorIs(t, err, ErrUnknownKeyEncryptionKey)
+}
+
+func TestLocalKMSInvalid(t *testing.T) {
+	tests := map[string]string{
+		"empty":       "# no key\n",
+		"no id":       ":" + base64.StdEncoding.EncodeToString(make([]byte, dataKeySize)) + "\n",
+		"no key":      "k1\n",
+		"not base64":  "k1:???\n",
+		"short key":   "k1:" + base64.StdEncoding.EncodeToString(make([]byte, 16)) + "\n",
+		"duplicate":   "k1:" + base64.StdEncoding.EncodeToString(make([]byte, dataKeySize)) + "\nk1:" + base64.StdEncoding.EncodeToString(make([]byte, dataKeySize)) + "\n",
+		"nonexistent": "",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys")
+			if name != "nonexistent" {
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			_, err := NewLocalKMS(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCipher(t *testing.T) {
+	kms, err := NewLocalKMS(writeKeyFile(t, newKeyLine(t, "k1")))
+	require.NoError(t, err)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+
+	c := NewCipher(kms)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	require.NoError(t, c.UnsafeInit(tx))
+	tx.Unlock()
+
+	key, value := []byte("revision"), []byte("\x0a\x03foo")
+	encrypted := c.Encrypt(key, value)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, string(encrypted), "foo")
+
+	got, err := c.Decrypt(key, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, value, got)
+
+	// the values are bound to their revision.
+	_, err = c.Decrypt([]byte("other"), encrypted)
+	require.Error(t, err)
+
+	// the plaintext values written before the encryption are readable.
+	got, err = c.Decrypt(key, value)
+	require.NoError(t, err)
+	assert.Equal(t, value, got)
+
+	// a nil cipher leaves the values in plaintext, but cannot read encrypted ones.
+	var nc *Cipher
+	assert.Equal(t, value, nc.Encrypt(key, value))
+	_, err = nc.Decrypt(key, encrypted)
+	require.ErrorIs(t, err, ErrKeyRequired)
+	tx.LockOutsideApply()
+	require.ErrorIs(t, nc.UnsafeInit(tx), ErrKeyRequired)
+	tx.Unlock()
+
+	// the keys are reloaded from the backend.
+	c2 := NewCipher(kms)
+	tx.LockOutsideApply()
+	require.NoError(t, c2.UnsafeInit(tx))
+	tx.Unlock()
+	got, err = c2.Decrypt(key, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, value, got)
+}
+
+func TestUnsafeCheck(t *testing.T) {
+	kms, err := NewLocalKMS(writeKeyFile(t, newKeyLine(t, "k1")))
+	require.NoError(t, err)
+	other, err := NewLocalKMS(writeKeyFile(t, newKeyLine(t, "k1")))
+	require.NoError(t, err)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	require.NoError(t, UnsafeCheck(tx, nil))
+	require.NoError(t, NewCipher(kms).UnsafeInit(tx))
+
+	require.NoError(t, UnsafeCheck(tx, kms))
+	require.ErrorIs(t, UnsafeCheck(tx, nil), ErrKeyRequired)
+	require.Error(t, UnsafeCheck(tx, other))
+}
+
+func TestRotate(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	defer func(limit int64) { rotateBatchLimit = limit }(rotateBatchLimit)
+	rotateBatchLimit = 3
+
+	revision := func(i int) []byte { return []byte{0, 0, 0, 0, 0, 0, 0, byte(i), '_', 0, 0, 0, 0, 0, 0, 0, 0} }
+	value := func(i int) []byte { return []byte(fmt.Sprintf("\x0a\x04key%d", i)) }
+
+	// values written in plaintext, then with the first data encryption key
+	oldLine, newLine := newKeyLine(t, "old"), newKeyLine(t, "new")
+	oldKMS, err := NewLocalKMS(writeKeyFile(t, oldLine))
+	require.NoError(t, err)
+	oldCipher := NewCipher(oldKMS)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	tx.UnsafeCreateBucket(schema.Key)
+	for i := 1; i <= 4; i++ {
+		tx.UnsafePut(schema.Key, revision(i), value(i))
+	}
+	require.NoError(t, oldCipher.UnsafeInit(tx))
+	for i := 5; i <= 8; i++ {
+		tx.UnsafePut(schema.Key, revision(i), oldCipher.Encrypt(revision(i), value(i)))
+	}
+	// the marker of a revision bump
+	tx.UnsafePut(schema.Key, revision(9), []byte{})
+	tx.Unlock()
+
+	// the new key encryption key is first, the old one unwraps the old data
+	// encryption key.
+	kms, err := NewLocalKMS(writeKeyFile(t, newLine, oldLine))
+	require.NoError(t, err)
+
+	c := NewCipher(kms)
+	n, err := Rotate(lg, be, c)
+	require.NoError(t, err)
+	assert.Equal(t, 8, n)
+
+	// the old key encryption key is not needed anymore.
+	newKMS, err := NewLocalKMS(writeKeyFile(t, newLine))
+	require.NoError(t, err)
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	require.NoError(t, UnsafeCheck(tx, newKMS))
+	keys, active, err := schema.UnsafeReadDataKeys(tx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, active, keys[0].ID)
+	assert.Equal(t, "new", keys[0].KEKID)
+
+	c = NewCipher(newKMS)
+	require.NoError(t, c.UnsafeInit(tx))
+	for i := 1; i <= 8; i++ {
+		_, vs := tx.UnsafeRange(schema.Key, revision(i), nil, 0)
+		require.Len(t, vs, 1)
+		require.True(t, c.isActive(vs[0]))
+		v, err := c.Decrypt(revision(i), vs[0])
+		require.NoError(t, err)
+		assert.Equal(t, value(i), v)
+	}
+	_, vs := tx.UnsafeRange(schema.Key, revision(9), nil, 0)
+	assert.Equal(t, [][]byte{{}}, vs)
+}
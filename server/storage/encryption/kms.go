@@ -0,0 +1,143 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KMS wraps the data encryption keys with key encryption keys, e.g. the keys
+// of a key management service.
+type KMS interface {
+	// KeyID returns the ID of the key encryption key wrapping the new data
+	// encryption keys.
+	KeyID() string
+	// Wrap encrypts a data encryption key with the key encryption key KeyID.
+	Wrap(dek []byte) ([]byte, error)
+	// Unwrap decrypts a data encryption key wrapped with the key encryption
+	// key keyID.
+	Unwrap(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// ErrUnknownKeyEncryptionKey is returned when a data encryption key was
+// wrapped with a key encryption key unknown to the KMS.
+var ErrUnknownKeyEncryptionKey = errors.New("encryption: unknown key encryption key")
+
+type localKMS struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewLocalKMS returns a KMS of the AES-256 key encryption keys of a local
+// file. Each line of the file is "<id>:<base64 encoded 32 bytes key>". The
+// first key wraps the new data encryption keys, and the following keys only
+// unwrap the data encryption keys wrapped before a rotation. Empty lines and
+// lines starting with '#' are ignored.
+func NewLocalKMS(path string) (KMS, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	kms := &localKMS{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(text, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid key at %s:%d: expected <id>:<base64 key>", path, line)
+		}
+		if _, ok := kms.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate key %q at %s:%d", id, path, line)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q at %s:%d: %w", id, path, line, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("invalid key %q at %s:%d: expected %d bytes, got %d", id, path, line, dataKeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		kms.keys[id] = aead
+		if kms.primary == "" {
+			kms.primary = id
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if kms.primary == "" {
+		return nil, fmt.Errorf("no key in %s", path)
+	}
+	return kms, nil
+}
+
+func (k *localKMS) KeyID() string { return k.primary }
+
+func (k *localKMS) Wrap(dek []byte) ([]byte, error) {
+	return seal(k.keys[k.primary], dek, []byte(k.primary)), nil
+}
+
+func (k *localKMS) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKeyEncryptionKey, keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the random nonce followed by the ciphertext of plaintext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) []byte {
+	return sealTo(nil, aead, plaintext, additionalData)
+}
+
+func sealTo(dst []byte, aead cipher.AEAD, plaintext, additionalData []byte) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, aead.NonceSize())...)
+	if _, err := rand.Read(dst[n:]); err != nil {
+		panic(err)
+	}
+	return aead.Seal(dst, dst[n:], plaintext, additionalData)
+}
+
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("encryption: ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
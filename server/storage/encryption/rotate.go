@@ -0,0 +1,85 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// rotateBatchLimit is the maximum number of values re-encrypted at once.
+var rotateBatchLimit int64 = 1000
+
+// Rotate creates a new data encryption key, re-encrypts all the values of the
+// key bucket with it, including the plaintext ones, and deletes the previous
+// keys. It returns the number of re-encrypted values. The backend must not be
+// used by a running member.
+func Rotate(lg *zap.Logger, be backend.Backend, c *Cipher) (int, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	tx.UnsafeCreateBucket(schema.Key)
+	err := c.UnsafeInit(tx)
+	if err == nil {
+		err = c.UnsafeRotate(tx)
+	}
+	tx.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	c.mu.RLock()
+	lg.Info("rotated data encryption key", zap.Uint32("data-key-id", c.active), zap.String("kek-id", c.kms.KeyID()))
+	c.mu.RUnlock()
+
+	// revisions start with their big endian main revision, which is never
+	// negative.
+	start, end := []byte{0}, []byte{0x80}
+	reencrypted := 0
+	for {
+		tx.LockOutsideApply()
+		keys, vals := tx.UnsafeRange(schema.Key, start, end, rotateBatchLimit)
+		for i := range keys {
+			// the markers of revision bumps are empty.
+			if len(vals[i]) == 0 || c.isActive(vals[i]) {
+				continue
+			}
+			v, derr := c.Decrypt(keys[i], vals[i])
+			if derr != nil {
+				tx.Unlock()
+				return reencrypted, derr
+			}
+			tx.UnsafePut(schema.Key, keys[i], c.Encrypt(keys[i], v))
+			reencrypted++
+		}
+		if len(keys) > 0 {
+			start = append(append(start[:0], keys[len(keys)-1]...), 0)
+		}
+		tx.Unlock()
+		if int64(len(keys)) < rotateBatchLimit {
+			break
+		}
+	}
+
+	tx.LockOutsideApply()
+	c.UnsafePrune(tx)
+	tx.Unlock()
+	be.ForceCommit()
+	lg.Info("re-encrypted values", zap.Int("values", reencrypted))
+	return reencrypted, nil
+}
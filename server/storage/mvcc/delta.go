@@ -0,0 +1,279 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// A delta holds the entries of the key bucket of all revisions in the range
+// (SinceRev, Rev]. It is encoded as the magic, followed by the uvarint since and
+// end revisions, followed by the uvarint number of wrapped data encryption keys
+// of the member and the uvarint ID and length prefixed key encryption key ID and
+// wrapped key of each of them, followed by the length prefixed bucket key and
+// value of every entry in revision order, terminated by an empty bucket key,
+// followed by the uvarint number of leases and the length prefixed entry of each
+// of them in the lease bucket.
+//
+// The lease bucket is not revisioned, so a delta holds the leases as of its
+// writing, which replace the leases of the snapshot it is restored on.
+const deltaMagic = "etcd-delta-v1"
+
+// deltaBatchLimit is the maximum number of entries read from the backend at once.
+var deltaBatchLimit int64 = 1000
+
+// maxDeltaDataKeys bounds the number of data encryption keys read from a delta.
+const maxDeltaDataKeys = 1 << 16
+
+var ErrInvalidDelta = errors.New("mvcc: invalid delta")
+
+// WriteDelta writes the delta of the revisions in range (sinceRev, rev] of the
+// key-value store to w. It returns ErrCompacted if sinceRev is compacted before
+// the delta is fully written.
+func WriteDelta(w io.Writer, kv KV, b backend.Backend, sinceRev, rev int64) error {
+	if sinceRev < 0 || sinceRev > rev {
+		return fmt.Errorf("%w: since revision %d is not in range [0, %d]", ErrInvalidDelta, sinceRev, rev)
+	}
+	checkCompacted := func() error {
+		txn := kv.Read(SharedBufReadTxMode, traceutil.TODO())
+		defer txn.End()
+		if sinceRev < txn.FirstRev() {
+			return ErrCompacted
+		}
+		if rev > txn.Rev() {
+			return ErrFutureRev
+		}
+		return nil
+	}
+	if err := checkCompacted(); err != nil {
+		return err
+	}
+
+	// the values are written encrypted as stored, with the keys to decrypt
+	// them. The keys of a running member are not rotated.
+	tx := b.ReadTx()
+	tx.RLock()
+	dataKeys, _, err := schema.UnsafeReadDataKeys(tx)
+	tx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	buf := []byte(deltaMagic)
+	buf = binary.AppendUvarint(buf, uint64(sinceRev))
+	buf = binary.AppendUvarint(buf, uint64(rev))
+	buf = binary.AppendUvarint(buf, uint64(len(dataKeys)))
+	for _, dk := range dataKeys {
+		buf = binary.AppendUvarint(buf, uint64(dk.ID))
+		buf = binary.AppendUvarint(buf, uint64(len(dk.KEKID)))
+		buf = append(buf, dk.KEKID...)
+		buf = binary.AppendUvarint(buf, uint64(len(dk.Wrapped)))
+		buf = append(buf, dk.Wrapped...)
+	}
+	if _, err := bw.Write(buf); err != nil {
+		return err
+	}
+
+	start := RevToBytes(Revision{Main: sinceRev + 1}, NewRevBytes())
+	end := RevToBytes(Revision{Main: rev + 1}, NewRevBytes())
+	for {
+		// copy the entries out of the read transaction, so it is not held
+		// open while the entries are written.
+		tx := b.ReadTx()
+		tx.RLock()
+		keys, vals := tx.UnsafeRange(schema.Key, start, end, deltaBatchLimit)
+		buf = buf[:0]
+		for i := range keys {
+			buf = binary.AppendUvarint(buf, uint64(len(keys[i])))
+			buf = append(buf, keys[i]...)
+			buf = binary.AppendUvarint(buf, uint64(len(vals[i])))
+			buf = append(buf, vals[i]...)
+		}
+		if len(keys) > 0 {
+			// resume right after the last read key
+			start = append(append(start[:0], keys[len(keys)-1]...), 0)
+		}
+		tx.RUnlock()
+
+		// entries read after a compaction of sinceRev started might be
+		// incomplete.
+		if err := checkCompacted(); err != nil {
+			return err
+		}
+		if _, err := bw.Write(buf); err != nil {
+			return err
+		}
+		if int64(len(keys)) < deltaBatchLimit {
+			break
+		}
+	}
+	if _, err := bw.Write(binary.AppendUvarint(nil, 0)); err != nil {
+		return err
+	}
+
+	// the leases are read after the entries, so the leases of all the keys of
+	// the delta are included unless they were revoked since.
+	tx = b.ReadTx()
+	tx.RLock()
+	leases := schema.MustUnsafeGetAllLeases(tx)
+	tx.RUnlock()
+	buf = binary.AppendUvarint(buf[:0], uint64(len(leases)))
+	for _, l := range leases {
+		data, err := l.Marshal()
+		if err != nil {
+			return err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(data)))
+		buf = append(buf, data...)
+	}
+	if _, err := bw.Write(buf); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// DeltaReader reads the entries of a delta.
+type DeltaReader struct {
+	r *bufio.Reader
+	// SinceRev is the revision the delta starts after.
+	SinceRev int64
+	// Rev is the last revision of the delta.
+	Rev int64
+	// DataKeys are the wrapped data encryption keys of the encrypted values.
+	DataKeys []schema.DataKey
+
+	entriesDone bool
+}
+
+// NewDeltaReader reads the header of the delta from r.
+func NewDeltaReader(r io.Reader) (*DeltaReader, error) {
+	dr := &DeltaReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(deltaMagic))
+	if _, err := io.ReadFull(dr.r, magic); err != nil || string(magic) != deltaMagic {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidDelta)
+	}
+	since, err := binary.ReadUvarint(dr.r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	rev, err := binary.ReadUvarint(dr.r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	dr.SinceRev, dr.Rev = int64(since), int64(rev)
+	if dr.SinceRev < 0 || dr.SinceRev > dr.Rev {
+		return nil, fmt.Errorf("%w: invalid revision range (%d, %d]", ErrInvalidDelta, dr.SinceRev, dr.Rev)
+	}
+
+	n, err := binary.ReadUvarint(dr.r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	if n > maxDeltaDataKeys {
+		return nil, fmt.Errorf("%w: too many data encryption keys (%d)", ErrInvalidDelta, n)
+	}
+	for i := uint64(0); i < n; i++ {
+		id, err := binary.ReadUvarint(dr.r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+		}
+		if id > math.MaxUint32 {
+			return nil, fmt.Errorf("%w: invalid data encryption key %d", ErrInvalidDelta, id)
+		}
+		kekID, err := dr.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		wrapped, err := dr.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		dr.DataKeys = append(dr.DataKeys, schema.DataKey{ID: uint32(id), KEKID: string(kekID), Wrapped: wrapped})
+	}
+	return dr, nil
+}
+
+// Next returns the bucket key and value of the next entry in the key bucket.
+// It returns io.EOF after the last entry.
+func (dr *DeltaReader) Next() (key, value []byte, err error) {
+	if key, err = dr.readBytes(); err != nil {
+		return nil, nil, err
+	}
+	if len(key) == 0 {
+		dr.entriesDone = true
+		return nil, nil, io.EOF
+	}
+	if len(key) != revBytesLen && len(key) != markedRevBytesLen {
+		return nil, nil, fmt.Errorf("%w: invalid bucket key %x", ErrInvalidDelta, key)
+	}
+	if main := BytesToRev(key).Main; main <= dr.SinceRev || main > dr.Rev {
+		return nil, nil, fmt.Errorf("%w: revision %d is not in range (%d, %d]", ErrInvalidDelta, main, dr.SinceRev, dr.Rev)
+	}
+	if value, err = dr.readBytes(); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+// Leases returns the leases of the delta. It must be called once Next returned
+// io.EOF.
+func (dr *DeltaReader) Leases() ([]*leasepb.Lease, error) {
+	if !dr.entriesDone {
+		return nil, fmt.Errorf("%w: leases read before the end of the entries", ErrInvalidDelta)
+	}
+	n, err := binary.ReadUvarint(dr.r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	var leases []*leasepb.Lease
+	for i := uint64(0); i < n; i++ {
+		data, err := dr.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		var l leasepb.Lease
+		if err = l.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+		}
+		leases = append(leases, &l)
+	}
+	return leases, nil
+}
+
+func (dr *DeltaReader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(dr.r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	if n > math.MaxInt32 {
+		return nil, fmt.Errorf("%w: entry of %d bytes is too large", ErrInvalidDelta, n)
+	}
+	b := make([]byte, n)
+	if _, err = io.ReadFull(dr.r, b); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDelta, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestWriteDelta(t *testing.T) {
+	oldLimit := deltaBatchLimit
+	defer func() { deltaBatchLimit = oldLimit }()
+	// make the delta span multiple batches
+	deltaBatchLimit = 2
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	sinceRev := s.Put([]byte("foo1"), []byte("bar1"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("bar2"), lease.NoLease)
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("foo2"), []byte("bar"), lease.NoLease)
+	txn.DeleteRange([]byte("foo1"), nil)
+	txn.End()
+	rev := s.Put([]byte("foo3"), []byte("bar3"), lease.NoLease)
+	s.Put([]byte("foo4"), []byte("bar4"), lease.NoLease)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDelta(&buf, s, b, sinceRev, rev))
+
+	dr, err := NewDeltaReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, sinceRev, dr.SinceRev)
+	assert.Equal(t, rev, dr.Rev)
+
+	type entry struct {
+		rev       Revision
+		tombstone bool
+		key       string
+		value     string
+	}
+	var entries []entry
+	for {
+		k, v, err := dr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		var kv mvccpb.KeyValue
+		require.NoError(t, kv.Unmarshal(v))
+		entries = append(entries, entry{BytesToRev(k), isTombstone(k), string(kv.Key), string(kv.Value)})
+	}
+	assert.Equal(t, []entry{
+		{Revision{Main: 4}, false, "foo", "bar2"},
+		{Revision{Main: 5}, false, "foo2", "bar"},
+		{Revision{Main: 5, Sub: 1}, true, "foo1", ""},
+		{Revision{Main: 6}, false, "foo3", "bar3"},
+	}, entries)
+}
+
+func TestWriteDeltaLeases(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	tx := b.BatchTx()
+	tx.LockOutsideApply()
+	schema.UnsafeCreateLeaseBucket(tx)
+	schema.MustUnsafePutLease(tx, &leasepb.Lease{ID: 1, TTL: 60, RemainingTTL: 30})
+	tx.Unlock()
+	rev := s.Put([]byte("foo"), []byte("bar"), 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDelta(&buf, s, b, 0, rev))
+	dr, err := NewDeltaReader(&buf)
+	require.NoError(t, err)
+
+	// the leases are read after the entries.
+	_, err = dr.Leases()
+	require.ErrorIs(t, err, ErrInvalidDelta)
+	_, _, err = dr.Next()
+	require.NoError(t, err)
+	_, _, err = dr.Next()
+	require.ErrorIs(t, err, io.EOF)
+	leases, err := dr.Leases()
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	assert.Equal(t, leasepb.Lease{ID: 1, TTL: 60, RemainingTTL: 30}, *leases[0])
+}
+
+func TestWriteDeltaEncrypted(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("k1:"+base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	kms, err := encryption.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	c := encryption.NewCipher(kms)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{Cipher: c})
+	defer cleanup(s, b)
+	rev := s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDelta(&buf, s, b, 0, rev))
+	dr, err := NewDeltaReader(&buf)
+	require.NoError(t, err)
+
+	// the values are decrypted with the data keys of the delta.
+	require.Len(t, dr.DataKeys, 1)
+	dc := encryption.NewCipher(kms)
+	require.NoError(t, dc.Load(dr.DataKeys, dr.DataKeys[0].ID))
+	k, v, err := dr.Next()
+	require.NoError(t, err)
+	require.True(t, encryption.IsEncrypted(v))
+	v, err = dc.Decrypt(k, v)
+	require.NoError(t, err)
+	var kv mvccpb.KeyValue
+	require.NoError(t, kv.Unmarshal(v))
+	assert.Equal(t, "bar", string(kv.Value))
+}
+
+func TestWriteDeltaCompacted(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	for i := 0; i < 5; i++ {
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	}
+	_, err := s.Compact(traceutil.TODO(), 3)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.ErrorIs(t, WriteDelta(&buf, s, b, 2, 5), ErrCompacted)
+	require.ErrorIs(t, WriteDelta(&buf, s, b, 3, 10), ErrFutureRev)
+	require.NoError(t, WriteDelta(&buf, s, b, 3, 5))
+}
+
+func TestDeltaReaderInvalid(t *testing.T) {
+	_, err := NewDeltaReader(bytes.NewReader([]byte("not a delta")))
+	require.ErrorIs(t, err, ErrInvalidDelta)
+
+	// an entry outside of the revision range of the delta
+	var buf bytes.Buffer
+	buf.WriteString(deltaMagic)
+	buf.Write([]byte{1, 2, 0})
+	key := RevToBytes(Revision{Main: 3}, NewRevBytes())
+	buf.WriteByte(byte(len(key)))
+	buf.Write(key)
+	dr, err := NewDeltaReader(&buf)
+	require.NoError(t, err)
+	_, _, err = dr.Next()
+	require.ErrorIs(t, err, ErrInvalidDelta)
+}
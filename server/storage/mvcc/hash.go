@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
@@ -30,9 +31,15 @@ const (
 	hashStorageMaxSize = 10
 )
 
-func unsafeHashByRev(tx backend.UnsafeReader, compactRevision, revision int64, keep map[Revision]struct{}) (KeyValueHash, error) {
+// unsafeHashByRev hashes the plaintext of the values, so that the hashes of the
+// members match regardless of their data encryption keys.
+func unsafeHashByRev(tx backend.UnsafeReader, c *encryption.Cipher, compactRevision, revision int64, keep map[Revision]struct{}) (KeyValueHash, error) {
 	h := newKVHasher(compactRevision, revision, keep)
 	err := tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		v, err := c.Decrypt(k, v)
+		if err != nil {
+			return err
+		}
 		h.WriteKeyValue(k, v)
 		return nil
 	})
@@ -85,6 +85,10 @@ type WriteView interface {
 type TxnWrite interface {
 	TxnRead
 	WriteView
+	// PutWithTTL puts the given key, value into the store like Put and sets the ttl of the key
+	// in seconds. The key is deleted once the ttl elapses unless it is put again. A ttl of 0
+	// means the key does not expire.
+	PutWithTTL(key, value []byte, lease lease.LeaseID, ttl int64) (rev int64)
 	// Changes gets the changes made since opening the write txn.
 	Changes() []mvccpb.KeyValue
 }
@@ -96,6 +100,9 @@ func (trw *txnReadWrite) DeleteRange(key, end []byte) (n, rev int64) { panic("un
 func (trw *txnReadWrite) Put(key, value []byte, lease lease.LeaseID) (rev int64) {
 	panic("unexpected Put")
 }
+func (trw *txnReadWrite) PutWithTTL(key, value []byte, lease lease.LeaseID, ttl int64) (rev int64) {
+	panic("unexpected PutWithTTL")
+}
 func (trw *txnReadWrite) Changes() []mvccpb.KeyValue { return nil }
 
 func NewReadOnlyTxnWrite(txn TxnRead) TxnWrite { return &txnReadWrite{txn} }
@@ -30,6 +30,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
@@ -47,6 +48,24 @@ var (
 type StoreConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
+	// Cipher encrypts the values of the key bucket. The values are stored
+	// in plaintext if nil.
+	Cipher *encryption.Cipher
+	// UsageTracker is notified of the size changes of the keys it tracks.
+	UsageTracker UsageTracker
+}
+
+// UsageTracker tracks the size of a subset of the keys. It is called by the
+// write transactions within their backend transaction, so it can persist the
+// usage atomically with the keys.
+type UsageTracker interface {
+	// Tracks reports whether the size of the key is tracked. The previous
+	// size of a key is only read if it is tracked.
+	Tracks(key []byte) bool
+	// UnsafeUpdateUsage records that the combined size of the key and its
+	// value changed from prevSize to size. A size of zero means the key does
+	// not exist.
+	UnsafeUpdateUsage(tx backend.UnsafeWriter, key []byte, prevSize, size int64)
 }
 
 type store struct {
@@ -120,7 +139,11 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg StoreConfi
 	tx.LockOutsideApply()
 	tx.UnsafeCreateBucket(schema.Key)
 	schema.UnsafeCreateMetaBucket(tx)
+	err := s.cfg.Cipher.UnsafeInit(tx)
 	tx.Unlock()
+	if err != nil {
+		lg.Panic("failed to load the data encryption keys", zap.Error(err))
+	}
 	s.b.ForceCommit()
 
 	s.mu.Lock()
@@ -188,7 +211,7 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	tx.RLock()
 	defer tx.RUnlock()
 	s.mu.RUnlock()
-	hash, err = unsafeHashByRev(tx, compactRev, rev, keep)
+	hash, err = unsafeHashByRev(tx, s.cfg.Cipher, compactRev, rev, keep)
 	hashRevSec.Observe(time.Since(start).Seconds())
 	return hash, currentRev, err
 }
@@ -309,6 +332,15 @@ func (s *store) Restore(b backend.Backend) error {
 	s.fifoSched = schedule.NewFIFOScheduler(s.lg)
 	s.stopc = make(chan struct{})
 
+	// the data encryption keys are those of the new backend.
+	tx := b.BatchTx()
+	tx.LockOutsideApply()
+	err := s.cfg.Cipher.UnsafeInit(tx)
+	tx.Unlock()
+	if err != nil {
+		return err
+	}
+
 	return s.restore()
 }
 
@@ -321,6 +353,7 @@ func (s *store) restore() error {
 	max = RevToBytes(Revision{Main: math.MaxInt64, Sub: math.MaxInt64}, max)
 
 	keyToLease := make(map[string]lease.LeaseID)
+	keyToTTL := make(map[string]mvccpb.KeyValue)
 
 	// restore index
 	tx := s.b.ReadTx()
@@ -349,7 +382,7 @@ func (s *store) restore() error {
 		}
 		// rkvc blocks if the total pending keys exceeds the restore
 		// chunk size to keep keys from consuming too much memory.
-		restoreChunk(s.lg, rkvc, keys, vals, keyToLease)
+		restoreChunk(s.lg, s.cfg.Cipher, rkvc, keys, vals, keyToLease, keyToTTL)
 		if len(keys) < restoreChunkKeys {
 			// partial set implies final set
 			break
@@ -403,6 +436,11 @@ func (s *store) restore() error {
 			)
 		}
 	}
+	if s.le != nil {
+		for key, kv := range keyToTTL {
+			s.le.SetKeyTTL([]byte(key), kv.ModRevision, kv.TTL)
+		}
+	}
 	tx.RUnlock()
 
 	s.lg.Info("kvstore restored", zap.Int64("current-rev", s.currentRev))
@@ -488,10 +526,10 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 	return rkvc, revc
 }
 
-func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID) {
+func restoreChunk(lg *zap.Logger, c *encryption.Cipher, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID, keyToTTL map[string]mvccpb.KeyValue) {
 	for i, key := range keys {
 		rkv := revKeyValue{key: key}
-		if err := rkv.kv.Unmarshal(vals[i]); err != nil {
+		if err := rkv.kv.Unmarshal(mustDecrypt(lg, c, key, vals[i])); err != nil {
 			lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 		rkv.kstr = string(rkv.kv.Key)
@@ -502,10 +540,24 @@ func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, k
 		} else {
 			delete(keyToLease, rkv.kstr)
 		}
+		if !isTombstone(key) && rkv.kv.TTL > 0 {
+			keyToTTL[rkv.kstr] = mvccpb.KeyValue{ModRevision: rkv.kv.ModRevision, TTL: rkv.kv.TTL}
+		} else {
+			delete(keyToTTL, rkv.kstr)
+		}
 		kvc <- rkv
 	}
 }
 
+// mustDecrypt returns the plaintext of the value of the key bucket at key.
+func mustDecrypt(lg *zap.Logger, c *encryption.Cipher, key, value []byte) []byte {
+	v, err := c.Decrypt(key, value)
+	if err != nil {
+		lg.Fatal("failed to decrypt mvccpb.KeyValue", zap.Binary("revision", key), zap.Error(err))
+	}
+	return v
+}
+
 func (s *store) Close() error {
 	close(s.stopc)
 	s.fifoSched.Stop()
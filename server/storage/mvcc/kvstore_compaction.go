@@ -56,7 +56,7 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 				tx.UnsafeDelete(schema.Key, keys[i])
 				keyCompactions++
 			}
-			h.WriteKeyValue(keys[i], values[i])
+			h.WriteKeyValue(keys[i], mustDecrypt(s.lg, s.cfg.Cipher, keys[i], values[i]))
 		}
 
 		if len(keys) < batchNum {
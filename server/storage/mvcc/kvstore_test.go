@@ -18,11 +18,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
 	mrand "math/rand"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -30,6 +33,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 
@@ -40,6 +45,7 @@ import (
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
@@ -483,6 +489,25 @@ func TestRestoreDelete(t *testing.T) {
 	}
 }
 
+// TestRestoreKeyTTLWithoutLessor ensures a store holding keys put with a ttl
+// can be restored without a lessor, as done by offline tools.
+func TestRestoreKeyTTLWithoutLessor(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer b.Close()
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	txn := s.Write(traceutil.TODO())
+	txn.PutWithTTL([]byte("foo"), []byte("bar"), lease.NoLease, 10)
+	txn.End()
+	s.Close()
+
+	s = NewStore(zaptest.NewLogger(t), b, nil, StoreConfig{})
+	defer s.Close()
+	r, err := s.Range(context.TODO(), []byte("foo"), nil, RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, r.KVs, 1)
+	assert.Equal(t, int64(10), r.KVs[0].TTL)
+}
+
 func TestRestoreContinueUnfinishedCompaction(t *testing.T) {
 	tests := []string{"recreate", "restore"}
 	for _, test := range tests {
@@ -709,6 +734,74 @@ func TestHashKVZeroRevision(t *testing.T) {
 	}
 }
 
+// TestStoreEncryption ensures that the values are encrypted in the backend, and
+// that the encrypted store reads and hashes like a plaintext one.
+func TestStoreEncryption(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("k1:"+base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	kms, err := encryption.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(lg, b, &lease.FakeLessor{}, StoreConfig{Cipher: encryption.NewCipher(kms)})
+	pb, _ := betesting.NewDefaultTmpBackend(t)
+	ps := NewStore(lg, pb, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(ps, pb)
+
+	for _, st := range []*store{s, ps} {
+		st.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+		st.Put([]byte("foo"), []byte("bar1"), lease.NoLease)
+		st.Put([]byte("foo2"), []byte("bar2"), lease.NoLease)
+		st.DeleteRange([]byte("foo2"), nil)
+		_, err = st.Compact(traceutil.TODO(), 2)
+		require.NoError(t, err)
+		st.fifoSched.WaitFinish(1)
+	}
+
+	b.ForceCommit()
+	tx := b.ReadTx()
+	tx.RLock()
+	err = tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		assert.Truef(t, encryption.IsEncrypted(v), "value of revision %x is not encrypted", k)
+		assert.NotContains(t, string(v), "foo")
+		return nil
+	})
+	tx.RUnlock()
+	require.NoError(t, err)
+
+	r, err := s.Range(context.TODO(), []byte("foo"), []byte("foo3"), RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, r.KVs, 1)
+	assert.Equal(t, []byte("bar1"), r.KVs[0].Value)
+	evs := rangeEvents(lg, b, s.cfg.Cipher, 3, 6)
+	require.Len(t, evs, 3)
+	assert.Equal(t, []byte("foo2"), evs[2].Kv.Key)
+
+	// the hashes are over the plaintext, so they match across members.
+	hash, _, err := s.HashStorage().HashByRev(0)
+	require.NoError(t, err)
+	phash, _, err := ps.HashStorage().HashByRev(0)
+	require.NoError(t, err)
+	assert.Equal(t, phash, hash)
+	assert.Equal(t, ps.HashStorage().Hashes(), s.HashStorage().Hashes())
+
+	// the store is restored with the data encryption keys of the backend.
+	s.Close()
+	s = NewStore(lg, b, &lease.FakeLessor{}, StoreConfig{Cipher: encryption.NewCipher(kms)})
+	defer cleanup(s, b)
+	r, err = s.Range(context.TODO(), []byte("foo"), nil, RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, r.KVs, 1)
+	assert.Equal(t, []byte("bar1"), r.KVs[0].Value)
+
+	// the encrypted backend cannot be read without the key.
+	assert.Panics(t, func() { NewStore(zap.NewNop(), b, &lease.FakeLessor{}, StoreConfig{}) })
+}
+
 func TestTxnPut(t *testing.T) {
 	// assign arbitrary size
 	bytesN := 30
@@ -120,7 +120,7 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 				zap.Int("len-values", len(vs)),
 			)
 		}
-		if err := kvs[i].Unmarshal(vs[0]); err != nil {
+		if err := kvs[i].Unmarshal(mustDecrypt(tr.s.lg, tr.s.cfg.Cipher, revBytes, vs[0])); err != nil {
 			tr.s.lg.Fatal(
 				"failed to unmarshal mvccpb.KeyValue",
 				zap.Error(err),
@@ -175,7 +175,12 @@ func (tw *storeTxnWrite) DeleteRange(key, end []byte) (int64, int64) {
 }
 
 func (tw *storeTxnWrite) Put(key, value []byte, lease lease.LeaseID) int64 {
-	tw.put(key, value, lease)
+	tw.put(key, value, lease, 0)
+	return tw.beginRev + 1
+}
+
+func (tw *storeTxnWrite) PutWithTTL(key, value []byte, lease lease.LeaseID, ttl int64) int64 {
+	tw.put(key, value, lease, ttl)
 	return tw.beginRev + 1
 }
 
@@ -193,19 +198,26 @@ func (tw *storeTxnWrite) End() {
 	tw.s.mu.RUnlock()
 }
 
-func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
+func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID, ttl int64) {
 	rev := tw.beginRev + 1
 	c := rev
 	oldLease := lease.NoLease
 
 	// if the key exists before, use its previous created and
 	// get its previous leaseID
-	_, created, ver, err := tw.s.kvindex.Get(key, rev)
+	modified, created, ver, err := tw.s.kvindex.Get(key, rev)
 	if err == nil {
 		c = created.Main
 		oldLease = tw.s.le.GetLease(lease.LeaseItem{Key: string(key)})
 		tw.trace.Step("get key's previous created_revision and leaseID")
 	}
+	if ut := tw.s.cfg.UsageTracker; ut != nil && ut.Tracks(key) {
+		prevSize := int64(0)
+		if err == nil {
+			prevSize = tw.sizeAt(modified)
+		}
+		ut.UnsafeUpdateUsage(tw.tx, key, prevSize, int64(len(key)+len(value)))
+	}
 	ibytes := NewRevBytes()
 	idxRev := Revision{Main: rev, Sub: int64(len(tw.changes))}
 	ibytes = RevToBytes(idxRev, ibytes)
@@ -218,6 +230,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		ModRevision:    rev,
 		Version:        ver,
 		Lease:          int64(leaseID),
+		TTL:            ttl,
 	}
 
 	d, err := kv.Marshal()
@@ -229,11 +242,23 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 	}
 
 	tw.trace.Step("marshal mvccpb.KeyValue")
-	tw.tx.UnsafeSeqPut(schema.Key, ibytes, d)
+	tw.tx.UnsafeSeqPut(schema.Key, ibytes, tw.s.cfg.Cipher.Encrypt(ibytes, d))
 	tw.s.kvindex.Put(key, idxRev)
 	tw.changes = append(tw.changes, kv)
 	tw.trace.Step("store kv pair into bolt db")
 
+	// every put replaces the ttl of the key
+	if tw.s.le == nil {
+		if ttl > 0 {
+			panic("no lessor to track key ttl")
+		}
+	} else if ttl > 0 {
+		tw.s.le.SetKeyTTL(key, rev, ttl)
+	} else if c != rev {
+		// the key existed before, drop its previous ttl if any
+		tw.s.le.RemoveKeyTTL(key)
+	}
+
 	if oldLease == leaseID {
 		tw.trace.Step("attach lease to kv pair")
 		return
@@ -279,6 +304,12 @@ func (tw *storeTxnWrite) deleteRange(key, end []byte) int64 {
 }
 
 func (tw *storeTxnWrite) delete(key []byte) {
+	if ut := tw.s.cfg.UsageTracker; ut != nil && ut.Tracks(key) {
+		if modified, _, _, err := tw.s.kvindex.Get(key, tw.beginRev+1); err == nil {
+			ut.UnsafeUpdateUsage(tw.tx, key, tw.sizeAt(modified), 0)
+		}
+	}
+
 	ibytes := NewRevBytes()
 	idxRev := newBucketKey(tw.beginRev+1, int64(len(tw.changes)), true)
 	ibytes = BucketKeyToBytes(idxRev, ibytes)
@@ -293,7 +324,7 @@ func (tw *storeTxnWrite) delete(key []byte) {
 		)
 	}
 
-	tw.tx.UnsafeSeqPut(schema.Key, ibytes, d)
+	tw.tx.UnsafeSeqPut(schema.Key, ibytes, tw.s.cfg.Cipher.Encrypt(ibytes, d))
 	err = tw.s.kvindex.Tombstone(key, idxRev.Revision)
 	if err != nil {
 		tw.storeTxnCommon.s.lg.Fatal(
@@ -304,6 +335,10 @@ func (tw *storeTxnWrite) delete(key []byte) {
 	}
 	tw.changes = append(tw.changes, kv)
 
+	if tw.s.le != nil {
+		tw.s.le.RemoveKeyTTL(key)
+	}
+
 	item := lease.LeaseItem{Key: string(key)}
 	leaseID := tw.s.le.GetLease(item)
 
@@ -318,4 +353,22 @@ func (tw *storeTxnWrite) delete(key []byte) {
 	}
 }
 
+// sizeAt returns the combined size of the key and the value put at rev.
+func (tw *storeTxnWrite) sizeAt(rev Revision) int64 {
+	revBytes := RevToBytes(rev, NewRevBytes())
+	_, vs := tw.tx.UnsafeRange(schema.Key, revBytes, nil, 0)
+	if len(vs) != 1 {
+		tw.s.lg.Fatal(
+			"failed to find the previous revision of a key",
+			zap.Int64("revision-main", rev.Main),
+			zap.Int64("revision-sub", rev.Sub),
+		)
+	}
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(mustDecrypt(tw.s.lg, tw.s.cfg.Cipher, revBytes, vs[0])); err != nil {
+		tw.s.lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
+	}
+	return int64(len(kv.Key) + len(kv.Value))
+}
+
 func (tw *storeTxnWrite) Changes() []mvccpb.KeyValue { return tw.changes }
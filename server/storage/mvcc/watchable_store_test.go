@@ -434,8 +434,8 @@ func TestRangeEvents(t *testing.T) {
 	var evs []mvccpb.Event
 	for i, tc := range tcs {
 		t.Run(fmt.Sprintf("%d rangeEvents(%d, %d)", i, tc.minRev, tc.maxRev), func(t *testing.T) {
-			assert.ElementsMatch(t, tc.expectEvents, rangeEvents(lg, b, tc.minRev, tc.maxRev))
-			evs = rangeEventsWithReuse(lg, b, evs, tc.minRev, tc.maxRev)
+			assert.ElementsMatch(t, tc.expectEvents, rangeEvents(lg, b, nil, tc.minRev, tc.maxRev))
+			evs = rangeEventsWithReuse(lg, b, nil, evs, tc.minRev, tc.maxRev)
 			assert.ElementsMatch(t, tc.expectEvents, evs)
 		})
 	}
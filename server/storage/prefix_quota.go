@@ -0,0 +1,377 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// PrefixQuotaStore persists the per-prefix quotas and their usage to the
+// backend and keeps an in-memory copy of them for the request paths. The usage
+// is kept up to date by the writes to the key-value store, as its
+// mvcc.UsageTracker.
+type PrefixQuotaStore struct {
+	lg *zap.Logger
+	mu sync.RWMutex
+	// quotas holds the quotas with their usage by prefix.
+	quotas map[string]*pb.PrefixQuota
+
+	be schema.QuotaBackend
+}
+
+var _ mvcc.UsageTracker = (*PrefixQuotaStore)(nil)
+
+func NewPrefixQuotaStore(lg *zap.Logger, be schema.QuotaBackend) (*PrefixQuotaStore, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	ret := &PrefixQuotaStore{lg: lg, quotas: make(map[string]*pb.PrefixQuota), be: be}
+	err := ret.Recover(be)
+	return ret, err
+}
+
+// Recover recovers the quotas and their usage from the given backend.
+func (s *PrefixQuotaStore) Recover(be schema.QuotaBackend) error {
+	qs, err := be.GetAllPrefixQuotas()
+	if err != nil {
+		return err
+	}
+	quotas := make(map[string]*pb.PrefixQuota, len(qs))
+	for _, q := range qs {
+		quotas[string(q.Prefix)] = q
+	}
+	be.ForceCommit()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quotas, s.be = quotas, be
+	return nil
+}
+
+// Put sets the quota of the prefix, replacing any existing quota of that
+// prefix. The usage of a new quota is computed from rv once, it is then kept
+// up to date by the writes.
+func (s *PrefixQuotaStore) Put(q *pb.PrefixQuota, rv mvcc.ReadView) (*pb.PrefixQuota, error) {
+	nq := &pb.PrefixQuota{Prefix: q.Prefix, QuotaBytes: q.QuotaBytes, QuotaKeys: q.QuotaKeys}
+	s.mu.RLock()
+	old, ok := s.quotas[string(nq.Prefix)]
+	if ok {
+		nq.UsedBytes, nq.UsedKeys = old.UsedBytes, old.UsedKeys
+	}
+	s.mu.RUnlock()
+	if !ok {
+		var err error
+		if nq.UsedBytes, nq.UsedKeys, err = rangeUsage(rv, nq.Prefix); err != nil {
+			return nil, err
+		}
+	}
+
+	// the quota is persisted before it is tracked, as the writes lock the
+	// backend before the store.
+	s.be.MustPutPrefixQuota(nq)
+	s.mu.Lock()
+	s.quotas[string(nq.Prefix)] = nq
+	s.mu.Unlock()
+	s.lg.Info(
+		"set prefix quota",
+		zap.String("prefix", string(nq.Prefix)),
+		zap.Int64("quota-bytes", nq.QuotaBytes),
+		zap.Int64("quota-keys", nq.QuotaKeys),
+	)
+	return copyQuota(nq), nil
+}
+
+// Delete removes the quota of the prefix. It returns nil if the prefix has no quota.
+func (s *PrefixQuotaStore) Delete(prefix []byte) *pb.PrefixQuota {
+	s.mu.RLock()
+	q, ok := s.quotas[string(prefix)]
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	s.be.MustDeletePrefixQuota(prefix)
+	s.mu.Lock()
+	delete(s.quotas, string(prefix))
+	s.mu.Unlock()
+	s.lg.Info("removed prefix quota", zap.String("prefix", string(prefix)))
+	return copyQuota(q)
+}
+
+// RecomputeUsage recomputes the usage of all quotas from rv, for key-value
+// stores written without tracking their usage.
+func (s *PrefixQuotaStore) RecomputeUsage(rv mvcc.ReadView) error {
+	for _, q := range s.List() {
+		var err error
+		if q.UsedBytes, q.UsedKeys, err = rangeUsage(rv, q.Prefix); err != nil {
+			return err
+		}
+		s.be.MustPutPrefixQuota(q)
+		s.mu.Lock()
+		s.quotas[string(q.Prefix)] = q
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// List returns all quotas with their usage sorted by prefix.
+func (s *PrefixQuotaStore) List() []*pb.PrefixQuota {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	qs := make([]*pb.PrefixQuota, 0, len(s.quotas))
+	for _, q := range s.quotas {
+		qs = append(qs, copyQuota(q))
+	}
+	sort.Slice(qs, func(i, j int) bool { return bytes.Compare(qs[i].Prefix, qs[j].Prefix) < 0 })
+	return qs
+}
+
+// Matching returns the quotas with their usage whose prefix covers the key.
+func (s *PrefixQuotaStore) Matching(key []byte) []*pb.PrefixQuota {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var qs []*pb.PrefixQuota
+	for _, q := range s.quotas {
+		if bytes.HasPrefix(key, q.Prefix) {
+			qs = append(qs, copyQuota(q))
+		}
+	}
+	return qs
+}
+
+// Tracks reports whether the key is under the prefix of a quota.
+func (s *PrefixQuotaStore) Tracks(key []byte) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, q := range s.quotas {
+		if bytes.HasPrefix(key, q.Prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnsafeUpdateUsage updates and persists the usage of the quotas covering the
+// key within the backend transaction of the write.
+func (s *PrefixQuotaStore) UnsafeUpdateUsage(tx backend.UnsafeWriter, key []byte, prevSize, size int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dKeys := int64(0)
+	switch {
+	case prevSize == 0 && size != 0:
+		dKeys = 1
+	case prevSize != 0 && size == 0:
+		dKeys = -1
+	}
+	for _, q := range s.quotas {
+		if !bytes.HasPrefix(key, q.Prefix) {
+			continue
+		}
+		q.UsedBytes += size - prevSize
+		q.UsedKeys += dKeys
+		s.be.MustUnsafePutPrefixQuota(tx, q)
+	}
+}
+
+func (s *PrefixQuotaStore) empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.quotas) == 0
+}
+
+func copyQuota(q *pb.PrefixQuota) *pb.PrefixQuota {
+	return &pb.PrefixQuota{
+		Prefix:     q.Prefix,
+		QuotaBytes: q.QuotaBytes,
+		QuotaKeys:  q.QuotaKeys,
+		UsedBytes:  q.UsedBytes,
+		UsedKeys:   q.UsedKeys,
+	}
+}
+
+// rangeUsage returns the combined size of keys and values and the number of
+// keys currently stored under the prefix, by ranging over it.
+func rangeUsage(rv mvcc.ReadView, prefix []byte) (usedBytes int64, usedKeys int64, err error) {
+	rr, err := rv.Range(context.TODO(), prefix, prefixEnd(prefix), mvcc.RangeOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, kv := range rr.KVs {
+		usedBytes += int64(len(kv.Key) + len(kv.Value))
+	}
+	return usedBytes, int64(len(rr.KVs)), nil
+}
+
+// PrefixQuota enforces the quotas of a PrefixQuotaStore against the keys
+// currently stored in the key-value store. Unlike BackendQuota, the usage is
+// derived from the replicated key space only, so every member reaches the
+// same decision when the check is run in the apply path.
+type PrefixQuota struct {
+	s  *PrefixQuotaStore
+	rv mvcc.ReadView
+}
+
+var _ Quota = (*PrefixQuota)(nil)
+
+// NewPrefixQuota creates a quota layer enforcing the quotas in s on the keys read from rv.
+func NewPrefixQuota(s *PrefixQuotaStore, rv mvcc.ReadView) *PrefixQuota {
+	return &PrefixQuota{s: s, rv: rv}
+}
+
+// Available judges whether the given request keeps every prefix it writes to
+// within its quota. Both branches of a transaction are checked since the
+// outcome of its comparisons is not known beforehand.
+func (q *PrefixQuota) Available(v any) bool {
+	if q.s.empty() {
+		return true
+	}
+	switch r := v.(type) {
+	case *pb.PutRequest:
+		return q.fits([]*pb.PutRequest{r})
+	case *pb.TxnRequest:
+		return q.fits(txnPuts(r.Success)) && q.fits(txnPuts(r.Failure))
+	default:
+		return true
+	}
+}
+
+// Cost computes the bytes written by the request under prefixes with a quota.
+func (q *PrefixQuota) Cost(v any) int {
+	if q.s.empty() {
+		return 0
+	}
+	var puts []*pb.PutRequest
+	switch r := v.(type) {
+	case *pb.PutRequest:
+		puts = []*pb.PutRequest{r}
+	case *pb.TxnRequest:
+		puts = append(txnPuts(r.Success), txnPuts(r.Failure)...)
+	}
+	cost := 0
+	for _, p := range puts {
+		if q.s.Tracks(p.Key) {
+			cost += len(p.Key) + len(p.Value)
+		}
+	}
+	return cost
+}
+
+// Remaining is the smallest number of bytes left across all prefixes
+// with a byte quota.
+func (q *PrefixQuota) Remaining() int64 {
+	remaining := int64(math.MaxInt64)
+	for _, pq := range q.s.List() {
+		if pq.QuotaBytes <= 0 {
+			continue
+		}
+		remaining = min(remaining, pq.QuotaBytes-pq.UsedBytes)
+	}
+	return remaining
+}
+
+type prefixUsage struct {
+	quota *pb.PrefixQuota
+	bytes int64
+	keys  int64
+}
+
+func (q *PrefixQuota) fits(puts []*pb.PutRequest) bool {
+	deltas := make(map[string]*prefixUsage)
+	for _, p := range puts {
+		quotas := q.s.Matching(p.Key)
+		if len(quotas) == 0 {
+			continue
+		}
+		rr, err := q.rv.Range(context.TODO(), p.Key, nil, mvcc.RangeOptions{})
+		if err != nil {
+			return false
+		}
+		var dBytes, dKeys int64
+		if len(rr.KVs) == 0 {
+			dBytes, dKeys = int64(len(p.Key)+len(p.Value)), 1
+		} else if !p.IgnoreValue {
+			dBytes = int64(len(p.Value) - len(rr.KVs[0].Value))
+		}
+		for _, pq := range quotas {
+			d, ok := deltas[string(pq.Prefix)]
+			if !ok {
+				d = &prefixUsage{quota: pq}
+				deltas[string(pq.Prefix)] = d
+			}
+			d.bytes += dBytes
+			d.keys += dKeys
+		}
+	}
+
+	for _, d := range deltas {
+		growsBytes := d.quota.QuotaBytes > 0 && d.bytes > 0
+		growsKeys := d.quota.QuotaKeys > 0 && d.keys > 0
+		if !growsBytes && !growsKeys {
+			// requests that do not grow the usage are always accepted so
+			// that a prefix over its quota can still be cleaned up
+			continue
+		}
+		if growsBytes && d.quota.UsedBytes+d.bytes > d.quota.QuotaBytes {
+			return false
+		}
+		if growsKeys && d.quota.UsedKeys+d.keys > d.quota.QuotaKeys {
+			return false
+		}
+	}
+	return true
+}
+
+// txnPuts collects the puts of the given operations, including the puts of
+// both branches of nested transactions.
+func txnPuts(ops []*pb.RequestOp) []*pb.PutRequest {
+	var puts []*pb.PutRequest
+	for _, op := range ops {
+		switch r := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			puts = append(puts, r.RequestPut)
+		case *pb.RequestOp_RequestTxn:
+			puts = append(puts, txnPuts(r.RequestTxn.Success)...)
+			puts = append(puts, txnPuts(r.RequestTxn.Failure)...)
+		}
+	}
+	return puts
+}
+
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// the prefix is empty or all 0xff, range to the end of the key space
+	return []byte{}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// TestPrefixQuotaStoreUsage ensures the usage of the prefixes is tracked by
+// the writes and recovered from the backend.
+func TestPrefixQuotaStoreUsage(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+
+	s, err := NewPrefixQuotaStore(lg, schema.NewQuotaBackend(lg, be))
+	require.NoError(t, err)
+	kv := mvcc.NewStore(lg, be, &lease.FakeLessor{}, mvcc.StoreConfig{UsageTracker: s})
+	defer kv.Close()
+
+	kv.Put([]byte("/a/1"), []byte("v"), lease.NoLease)
+	_, err = s.Put(&pb.PrefixQuota{Prefix: []byte("/a/"), QuotaKeys: 10}, kv)
+	require.NoError(t, err)
+	kv.Put([]byte("/a/2"), []byte("vv"), lease.NoLease)
+	kv.Put([]byte("/a/1"), []byte("vvv"), lease.NoLease)
+	kv.Put([]byte("/b/1"), []byte("v"), lease.NoLease)
+	kv.DeleteRange([]byte("/a/2"), nil)
+
+	want := []*pb.PrefixQuota{{Prefix: []byte("/a/"), QuotaKeys: 10, UsedBytes: int64(len("/a/1vvv")), UsedKeys: 1}}
+	assert.Equal(t, want, s.List())
+
+	be.ForceCommit()
+	rs, err := NewPrefixQuotaStore(lg, schema.NewQuotaBackend(lg, be))
+	require.NoError(t, err)
+	assert.Equal(t, want, rs.List())
+}
@@ -123,6 +123,73 @@ func NewBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64, be backend.Back
 	return &BackendQuota{be, quotaBackendBytesCfg}
 }
 
+// UpdateBackendQuota reports the storage limit of the quotas created by
+// NewReloadableBackendQuota after a change at runtime.
+func UpdateBackendQuota(lg *zap.Logger, quotaBackendBytesCfg int64) {
+	switch {
+	case quotaBackendBytesCfg == 0:
+		quotaBackendBytes.Set(float64(DefaultQuotaBytes))
+		return
+	case quotaBackendBytesCfg > MaxQuotaBytes:
+		lg.Warn(
+			"quota exceeds the maximum value",
+			zap.Int64("quota-size-bytes", quotaBackendBytesCfg),
+			zap.String("quota-size", humanize.Bytes(uint64(quotaBackendBytesCfg))),
+			zap.Int64("quota-maximum-size-bytes", MaxQuotaBytes),
+			zap.String("quota-maximum-size", maxQuotaSize),
+		)
+	}
+	quotaBackendBytes.Set(float64(quotaBackendBytesCfg))
+}
+
+// reloadableQuota is a backend quota whose storage limit is read for each
+// request, so that it can be changed at runtime.
+type reloadableQuota struct {
+	be                   backend.Backend
+	quotaBackendBytesCfg func() int64
+}
+
+// NewReloadableBackendQuota creates a quota layer with the storage limit
+// returned by quotaBackendBytesCfg, which is read for each request.
+func NewReloadableBackendQuota(lg *zap.Logger, quotaBackendBytesCfg func() int64, be backend.Backend, name string) Quota {
+	NewBackendQuota(lg, quotaBackendBytesCfg(), be, name)
+	return &reloadableQuota{be: be, quotaBackendBytesCfg: quotaBackendBytesCfg}
+}
+
+// quota returns the backend quota of the current storage limit, or false if
+// the quota is disabled.
+func (q *reloadableQuota) quota() (BackendQuota, bool) {
+	maxBackendBytes := q.quotaBackendBytesCfg()
+	if maxBackendBytes < 0 {
+		return BackendQuota{}, false
+	}
+	if maxBackendBytes == 0 {
+		maxBackendBytes = DefaultQuotaBytes
+	}
+	return BackendQuota{q.be, maxBackendBytes}, true
+}
+
+func (q *reloadableQuota) Available(v any) bool {
+	b, ok := q.quota()
+	return !ok || b.Available(v)
+}
+
+func (q *reloadableQuota) Cost(v any) int {
+	b, ok := q.quota()
+	if !ok {
+		return 0
+	}
+	return b.Cost(v)
+}
+
+func (q *reloadableQuota) Remaining() int64 {
+	b, ok := q.quota()
+	if !ok {
+		return 1
+	}
+	return b.Remaining()
+}
+
 func (b *BackendQuota) Available(v any) bool {
 	cost := b.Cost(v)
 	// if there are no mutating requests, it's safe to pass through
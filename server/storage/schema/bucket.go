@@ -22,10 +22,14 @@ import (
 )
 
 var (
-	keyBucketName   = []byte("key")
-	metaBucketName  = []byte("meta")
-	leaseBucketName = []byte("lease")
-	alarmBucketName = []byte("alarm")
+	keyBucketName    = []byte("key")
+	metaBucketName   = []byte("meta")
+	leaseBucketName  = []byte("lease")
+	alarmBucketName  = []byte("alarm")
+	quotaBucketName  = []byte("quota")
+	keyTTLBucketName = []byte("keyTTL")
+
+	encryptionBucketName = []byte("encryption")
 
 	clusterBucketName = []byte("cluster")
 
@@ -45,6 +49,10 @@ var (
 	Lease   = backend.Bucket(bucket{id: 3, name: leaseBucketName, safeRangeBucket: false})
 	Alarm   = backend.Bucket(bucket{id: 4, name: alarmBucketName, safeRangeBucket: false})
 	Cluster = backend.Bucket(bucket{id: 5, name: clusterBucketName, safeRangeBucket: false})
+	Quota   = backend.Bucket(bucket{id: 6, name: quotaBucketName, safeRangeBucket: false})
+	KeyTTL  = backend.Bucket(bucket{id: 8, name: keyTTLBucketName, safeRangeBucket: false})
+
+	Encryption = backend.Bucket(bucket{id: 7, name: encryptionBucketName, safeRangeBucket: false})
 
 	Members        = backend.Bucket(bucket{id: 10, name: membersBucketName, safeRangeBucket: false})
 	MembersRemoved = backend.Bucket(bucket{id: 11, name: membersRemovedBucketName, safeRangeBucket: false})
@@ -55,7 +63,7 @@ var (
 
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 
-	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
+	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Quota, KeyTTL, Encryption, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
 )
 
 type bucket struct {
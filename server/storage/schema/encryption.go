@@ -0,0 +1,99 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// EncryptionActiveKeyName holds the ID of the data encryption key encrypting
+// the new values of the key bucket.
+var EncryptionActiveKeyName = []byte("active")
+
+// DataKey is a data encryption key of the values of the key bucket, wrapped
+// with the key encryption key KEKID.
+type DataKey struct {
+	ID      uint32 `json:"-"`
+	KEKID   string `json:"kek-id"`
+	Wrapped []byte `json:"wrapped"`
+}
+
+func dataKeyName(id uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, id)
+}
+
+// UnsafeReadDataKeys returns the data encryption keys and the ID of the active
+// one. It returns no keys if the values of the key bucket were never encrypted.
+func UnsafeReadDataKeys(tx backend.UnsafeReader) (keys []DataKey, active uint32, err error) {
+	return readDataKeys(func(visitor func(k, v []byte) error) error {
+		return tx.UnsafeForEach(Encryption, visitor)
+	})
+}
+
+// ReadDataKeysFromSnapshot loads the data encryption keys from given bbolt
+// transaction.
+func ReadDataKeysFromSnapshot(tx *bbolt.Tx) (keys []DataKey, active uint32, err error) {
+	b := tx.Bucket(Encryption.Name())
+	if b == nil {
+		return nil, 0, nil
+	}
+	return readDataKeys(b.ForEach)
+}
+
+func readDataKeys(forEach func(visitor func(k, v []byte) error) error) (keys []DataKey, active uint32, err error) {
+	err = forEach(func(k, v []byte) error {
+		if string(k) == string(EncryptionActiveKeyName) {
+			if len(v) != 4 {
+				return fmt.Errorf("invalid active data encryption key %x", v)
+			}
+			active = binary.BigEndian.Uint32(v)
+			return nil
+		}
+		if len(k) != 4 {
+			return fmt.Errorf("invalid data encryption key name %x", k)
+		}
+		dk := DataKey{ID: binary.BigEndian.Uint32(k)}
+		if err := json.Unmarshal(v, &dk); err != nil {
+			return fmt.Errorf("cannot unmarshal data encryption key %d: %w", dk.ID, err)
+		}
+		keys = append(keys, dk)
+		return nil
+	})
+	return keys, active, err
+}
+
+// UnsafePutDataKey stores the data encryption key dk and makes it active.
+func UnsafePutDataKey(tx backend.UnsafeWriter, dk DataKey) {
+	v, err := json.Marshal(dk)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal data encryption key: %w", err))
+	}
+	// the bucket is created with the first key, so the backend of a cluster
+	// that never encrypts stays unchanged.
+	tx.UnsafeCreateBucket(Encryption)
+	tx.UnsafePut(Encryption, dataKeyName(dk.ID), v)
+	tx.UnsafePut(Encryption, EncryptionActiveKeyName, dataKeyName(dk.ID))
+}
+
+// UnsafeDeleteDataKey deletes the data encryption key id.
+func UnsafeDeleteDataKey(tx backend.UnsafeWriter, id uint32) {
+	tx.UnsafeDelete(Encryption, dataKeyName(id))
+}
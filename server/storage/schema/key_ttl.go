@@ -0,0 +1,58 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeCreateKeyTTLBucket creates the bucket of the checkpointed key ttls.
+// It is created with the first checkpoint, so the backend of a cluster that
+// never puts keys with a ttl stays unchanged.
+func UnsafeCreateKeyTTLBucket(tx backend.UnsafeWriter) {
+	tx.UnsafeCreateBucket(KeyTTL)
+}
+
+// MustUnsafeGetAllKeyTTLs returns the checkpointed remaining ttls of the keys.
+func MustUnsafeGetAllKeyTTLs(tx backend.UnsafeReader) []*pb.KeyExpiry {
+	var kes []*pb.KeyExpiry
+	err := tx.UnsafeForEach(KeyTTL, func(k, v []byte) error {
+		var ke pb.KeyExpiry
+		if err := ke.Unmarshal(v); err != nil {
+			return fmt.Errorf("failed to unmarshal key ttl proto item; key=%q", k)
+		}
+		kes = append(kes, &ke)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return kes
+}
+
+func MustUnsafePutKeyTTL(tx backend.UnsafeWriter, ke *pb.KeyExpiry) {
+	val, err := ke.Marshal()
+	if err != nil {
+		panic("failed to marshal key ttl proto item")
+	}
+	tx.UnsafePut(KeyTTL, ke.Key, val)
+}
+
+func UnsafeDeleteKeyTTL(tx backend.UnsafeWriter, key []byte) {
+	tx.UnsafeDelete(KeyTTL, key)
+}
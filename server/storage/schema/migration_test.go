@@ -51,11 +51,16 @@ func TestNewPlan(t *testing.T) {
 			target:  version.V3_5,
 		},
 		{
-			name:           "Upgrade v3.6 to v3.7 should fail as v3.7 is unknown",
-			current:        version.V3_6,
-			target:         version.V3_7,
+			name:    "Upgrade v3.6 to v3.7 should work",
+			current: version.V3_6,
+			target:  version.V3_7,
+		},
+		{
+			name:           "Upgrade v3.7 to v3.8 should fail as v3.8 is unknown",
+			current:        version.V3_7,
+			target:         v3_8,
 			expectError:    true,
-			expectErrorMsg: `version "3.7.0" is not supported`,
+			expectErrorMsg: `version "3.8.0" is not supported`,
 		},
 		{
 			name:           "Upgrade v3.6 to v4.0 as major version changes are unsupported",
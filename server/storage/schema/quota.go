@@ -0,0 +1,94 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+type QuotaBackend interface {
+	MustPutPrefixQuota(quota *etcdserverpb.PrefixQuota)
+	// MustUnsafePutPrefixQuota puts the quota within the given backend
+	// transaction, which must already hold a quota.
+	MustUnsafePutPrefixQuota(tx backend.UnsafeWriter, quota *etcdserverpb.PrefixQuota)
+	MustDeletePrefixQuota(prefix []byte)
+	GetAllPrefixQuotas() ([]*etcdserverpb.PrefixQuota, error)
+	ForceCommit()
+}
+
+type quotaBackend struct {
+	lg *zap.Logger
+	be backend.Backend
+}
+
+func NewQuotaBackend(lg *zap.Logger, be backend.Backend) QuotaBackend {
+	return &quotaBackend{
+		lg: lg,
+		be: be,
+	}
+}
+
+func (s *quotaBackend) MustPutPrefixQuota(quota *etcdserverpb.PrefixQuota) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	// the bucket is created with the first quota, so the backend of a
+	// cluster that never sets quotas stays unchanged.
+	tx.UnsafeCreateBucket(Quota)
+	s.MustUnsafePutPrefixQuota(tx, quota)
+}
+
+func (s *quotaBackend) MustUnsafePutPrefixQuota(tx backend.UnsafeWriter, quota *etcdserverpb.PrefixQuota) {
+	// the usage is persisted with the quota, so it is not recomputed on start
+	v, err := quota.Marshal()
+	if err != nil {
+		s.lg.Panic("failed to marshal prefix quota", zap.Error(err))
+	}
+	tx.UnsafePut(Quota, quota.Prefix, v)
+}
+
+func (s *quotaBackend) MustDeletePrefixQuota(prefix []byte) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	tx.UnsafeDelete(Quota, prefix)
+}
+
+func (s *quotaBackend) GetAllPrefixQuotas() ([]*etcdserverpb.PrefixQuota, error) {
+	tx := s.be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	return s.unsafeGetAllPrefixQuotas(tx)
+}
+
+func (s *quotaBackend) unsafeGetAllPrefixQuotas(tx backend.UnsafeReader) ([]*etcdserverpb.PrefixQuota, error) {
+	var qs []*etcdserverpb.PrefixQuota
+	err := tx.UnsafeForEach(Quota, func(k, v []byte) error {
+		var q etcdserverpb.PrefixQuota
+		if err := q.Unmarshal(v); err != nil {
+			return err
+		}
+		qs = append(qs, &q)
+		return nil
+	})
+	return qs, err
+}
+
+func (s *quotaBackend) ForceCommit() {
+	s.be.ForceCommit()
+}
@@ -33,6 +33,9 @@ import (
 	"go.etcd.io/raft/v3/raftpb"
 )
 
+// v3_8 is a storage version that is not known yet.
+var v3_8 = semver.Version{Major: 3, Minor: 8}
+
 func TestValidate(t *testing.T) {
 	tcs := []struct {
 		name    string
@@ -73,10 +76,14 @@ func TestValidate(t *testing.T) {
 			version: version.V3_6,
 		},
 		{
-			name:           `V3.7 schema is unknown and should return error`,
-			version:        version.V3_7,
+			name:    `V3.7 schema is correct`,
+			version: version.V3_7,
+		},
+		{
+			name:           `V3.8 schema is unknown and should return error`,
+			version:        v3_8,
 			expectError:    true,
-			expectErrorMsg: `version "3.7.0" is not supported`,
+			expectErrorMsg: `version "3.8.0" is not supported`,
 		},
 	}
 	for _, tc := range tcs {
@@ -148,20 +155,38 @@ func TestMigrate(t *testing.T) {
 			expectVersion: &version.V3_7,
 		},
 		{
-			name:           "Upgrading 3.6 to v3.7 is not supported",
-			version:        version.V3_6,
-			targetVersion:  version.V3_7,
-			expectVersion:  &version.V3_6,
-			expectError:    true,
-			expectErrorMsg: `cannot create migration plan: version "3.7.0" is not supported`,
+			name:          "Upgrading 3.6 to v3.7 works",
+			version:       version.V3_6,
+			targetVersion: version.V3_7,
+			expectVersion: &version.V3_7,
+		},
+		{
+			name:          "Downgrading v3.7 to v3.6 works as there are no v3.7 wal entries",
+			version:       version.V3_7,
+			targetVersion: version.V3_6,
+			expectVersion: &version.V3_6,
 		},
 		{
-			name:           "Downgrading v3.7 to v3.6 is not supported",
+			name:          `Migrate on same v3.8 version passes`,
+			version:       v3_8,
+			targetVersion: v3_8,
+			expectVersion: &v3_8,
+		},
+		{
+			name:           "Upgrading 3.7 to v3.8 is not supported",
 			version:        version.V3_7,
-			targetVersion:  version.V3_6,
+			targetVersion:  v3_8,
 			expectVersion:  &version.V3_7,
 			expectError:    true,
-			expectErrorMsg: `cannot create migration plan: version "3.7.0" is not supported`,
+			expectErrorMsg: `cannot create migration plan: version "3.8.0" is not supported`,
+		},
+		{
+			name:           "Downgrading v3.8 to v3.7 is not supported",
+			version:        v3_8,
+			targetVersion:  version.V3_7,
+			expectVersion:  &v3_8,
+			expectError:    true,
+			expectErrorMsg: `cannot create migration plan: version "3.8.0" is not supported`,
 		},
 		{
 			name:          "Downgrading v3.6 to v3.5 works as there are no v3.6 wal entries",
@@ -309,6 +334,10 @@ func setupBackendData(t *testing.T, ver semver.Version, overrideKeys func(tx bac
 			MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
 			UnsafeUpdateConsistentIndex(tx, 1, 1)
 			UnsafeSetStorageVersion(tx, &version.V3_7)
+		case v3_8:
+			MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+			UnsafeUpdateConsistentIndex(tx, 1, 1)
+			UnsafeSetStorageVersion(tx, &v3_8)
 			tx.UnsafePut(Meta, []byte("future-key"), []byte(""))
 		default:
 			t.Fatalf("Unsupported storage version")
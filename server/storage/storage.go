@@ -17,6 +17,7 @@ package storage
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"go.uber.org/zap"
@@ -41,6 +42,8 @@ type Storage interface {
 	Sync() error
 	// MinimalEtcdVersion returns minimal etcd storage able to interpret WAL log.
 	MinimalEtcdVersion() *semver.Version
+	// RecentFsyncDuration returns the given quantile of the recent WAL fsync durations.
+	RecentFsyncDuration(quantile float64) time.Duration
 }
 
 type storage struct {
@@ -107,6 +110,12 @@ func (st *storage) Sync() error {
 	return st.w.Sync()
 }
 
+func (st *storage) RecentFsyncDuration(quantile float64) time.Duration {
+	st.mux.RLock()
+	defer st.mux.RUnlock()
+	return st.w.RecentFsyncDuration(quantile)
+}
+
 func (st *storage) MinimalEtcdVersion() *semver.Version {
 	st.mux.Lock()
 	defer st.mux.Unlock()
@@ -0,0 +1,94 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// fsyncWindowSize is the number of recent fsync durations kept.
+	fsyncWindowSize = 128
+	// fsyncWindowAge is the age after which an fsync duration is no longer
+	// recent, so an idle WAL does not keep reporting past slow fsyncs.
+	fsyncWindowAge = time.Minute
+)
+
+type fsyncSample struct {
+	at   time.Time
+	took time.Duration
+}
+
+// fsyncWindow keeps the durations of the recent fsyncs of a WAL. The zero
+// value is ready to use.
+type fsyncWindow struct {
+	mu      sync.Mutex
+	samples [fsyncWindowSize]fsyncSample
+	next    int
+	// inflight is the start time of the fsync in progress, zero if there is
+	// none. A hung fsync never completes into a sample.
+	inflight time.Time
+}
+
+func (fw *fsyncWindow) start(at time.Time) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.inflight = at
+}
+
+func (fw *fsyncWindow) observe(at time.Time, took time.Duration) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.samples[fw.next] = fsyncSample{at: at, took: took}
+	fw.next = (fw.next + 1) % fsyncWindowSize
+	fw.inflight = time.Time{}
+}
+
+// carryOver copies the samples of prev, so they are kept across a reopening
+// of the WAL.
+func (fw *fsyncWindow) carryOver(prev *fsyncWindow) {
+	prev.mu.Lock()
+	samples, next := prev.samples, prev.next
+	prev.mu.Unlock()
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.samples, fw.next = samples, next
+}
+
+// quantile returns the q quantile of the fsync durations observed since
+// fsyncWindowAge before now, or the duration so far of the fsync in progress
+// if it is longer. It returns zero if there is none.
+func (fw *fsyncWindow) quantile(now time.Time, q float64) time.Duration {
+	fw.mu.Lock()
+	durations := make([]time.Duration, 0, fsyncWindowSize)
+	for _, s := range fw.samples {
+		if !s.at.IsZero() && now.Sub(s.at) <= fsyncWindowAge {
+			durations = append(durations, s.took)
+		}
+	}
+	var inflight time.Duration
+	if !fw.inflight.IsZero() {
+		inflight = now.Sub(fw.inflight)
+	}
+	fw.mu.Unlock()
+	if len(durations) == 0 {
+		return inflight
+	}
+	slices.Sort(durations)
+	i := int(q*float64(len(durations))+0.5) - 1
+	return max(durations[min(max(i, 0), len(durations)-1)], inflight)
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFsyncWindow(t *testing.T) {
+	var fw fsyncWindow
+	now := time.Now()
+	assert.Equal(t, time.Duration(0), fw.quantile(now, 0.99))
+
+	for i := 1; i <= 100; i++ {
+		fw.observe(now, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 99*time.Millisecond, fw.quantile(now, 0.99))
+	assert.Equal(t, 50*time.Millisecond, fw.quantile(now, 0.5))
+
+	// only the last fsyncWindowSize durations are kept.
+	for i := 0; i < fsyncWindowSize; i++ {
+		fw.observe(now, time.Millisecond)
+	}
+	assert.Equal(t, time.Millisecond, fw.quantile(now, 0.99))
+
+	// old durations are not recent.
+	fw.observe(now, time.Second)
+	assert.Equal(t, time.Millisecond, fw.quantile(now, 0.99))
+	assert.Equal(t, time.Second, fw.quantile(now, 1))
+	assert.Equal(t, time.Duration(0), fw.quantile(now.Add(fsyncWindowAge+time.Second), 1))
+
+	// an fsync in progress counts for its duration so far, until it completes.
+	fw.start(now)
+	assert.Equal(t, 10*time.Second, fw.quantile(now.Add(10*time.Second), 0.99))
+	assert.Equal(t, 2*time.Minute, fw.quantile(now.Add(2*time.Minute), 0.99))
+	fw.observe(now, 10*time.Millisecond)
+	assert.Equal(t, time.Millisecond, fw.quantile(now, 0.5))
+}
+
+func TestFsyncWindowCarryOver(t *testing.T) {
+	var prev, fw fsyncWindow
+	now := time.Now()
+	prev.observe(now, time.Second)
+	fw.carryOver(&prev)
+	assert.Equal(t, time.Second, fw.quantile(now, 0.99))
+}
@@ -94,6 +94,10 @@ type WAL struct {
 	locks []*fileutil.LockedFile // the locked files the WAL holds (the name is increasing)
 	fp    *filePipeline
 
+	cutHook func(path string) // called with the path of the segment finished by a cut
+
+	fsyncs fsyncWindow // the durations of the recent fsyncs of the tail segment
+
 	openshiftWarnFsyncDuration *time.Duration
 }
 
@@ -266,13 +270,29 @@ func (w *WAL) Reopen(lg *zap.Logger, snap walpb.Snapshot) (*WAL, error) {
 	if err != nil {
 		lg.Panic("failed to close WAL during reopen", zap.Error(err))
 	}
-	return Open(lg, w.dir, snap)
+	nw, err := Open(lg, w.dir, snap)
+	if err != nil {
+		return nil, err
+	}
+	nw.cutHook = w.cutHook
+	nw.fsyncs.carryOver(&w.fsyncs)
+	return nw, nil
 }
 
 func (w *WAL) SetUnsafeNoFsync() {
 	w.unsafeNoSync = true
 }
 
+// SetCutHook sets a function that is called with the path of the WAL segment
+// finished by each cut. The segment is not written anymore, but it is purged
+// once its lock is released. The hook is called with the WAL locked and must
+// not block.
+func (w *WAL) SetCutHook(hook func(path string)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.cutHook = hook
+}
+
 func (w *WAL) cleanupWAL(lg *zap.Logger) {
 	var err error
 	if err = w.Close(); err != nil {
@@ -746,6 +766,7 @@ func Verify(lg *zap.Logger, walDir string, snap walpb.Snapshot) (*raftpb.HardSta
 // cut first creates a temp wal file and writes necessary headers into it.
 // Then cut atomically rename temp wal file to a wal file.
 func (w *WAL) cut() error {
+	finished := w.tail().Name()
 	// close old wal file; truncate to avoid wasting space if an early cut
 	off, serr := w.tail().Seek(0, io.SeekCurrent)
 	if serr != nil {
@@ -826,6 +847,9 @@ func (w *WAL) cut() error {
 	}
 
 	w.lg.Info("created a new WAL segment", zap.String("path", fpath))
+	if w.cutHook != nil {
+		w.cutHook(finished)
+	}
 	return nil
 }
 
@@ -855,6 +879,7 @@ func (w *WAL) sync() error {
 	}
 
 	start := time.Now()
+	w.fsyncs.start(start)
 	err := fileutil.Fdatasync(w.tail().File)
 
 	took := time.Since(start)
@@ -866,6 +891,7 @@ func (w *WAL) sync() error {
 		)
 	}
 	walFsyncSec.Observe(took.Seconds())
+	w.fsyncs.observe(start, took)
 
 	return err
 }
@@ -874,6 +900,13 @@ func (w *WAL) Sync() error {
 	return w.sync()
 }
 
+// RecentFsyncDuration returns the given quantile of the durations of the
+// fsyncs of the last minute, or the duration so far of the fsync in progress
+// if it is longer. It returns zero if there is none.
+func (w *WAL) RecentFsyncDuration(quantile float64) time.Duration {
+	return w.fsyncs.quantile(time.Now(), quantile)
+}
+
 // ReleaseLockTo releases the locks, which has smaller index than the given index
 // except the largest one among them.
 // For example, if WAL is holding lock 1,2,3,4,5,6, ReleaseLockTo(4) will release
@@ -361,6 +361,33 @@ func TestCut(t *testing.T) {
 	}
 }
 
+func TestCutHook(t *testing.T) {
+	p := t.TempDir()
+
+	w, err := Create(zaptest.NewLogger(t), p, nil)
+	require.NoError(t, err)
+	defer func() { w.Close() }()
+
+	var finished []string
+	w.SetCutHook(func(path string) { finished = append(finished, filepath.Base(path)) })
+
+	require.NoError(t, w.Save(raftpb.HardState{Term: 1}, nil))
+	require.NoError(t, w.cut())
+	require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: 1, Term: 1, Data: []byte{1}}}))
+	require.NoError(t, w.cut())
+	require.Equal(t, []string{walName(0, 0), walName(1, 1)}, finished)
+
+	// the hook and the fsync durations are kept when the WAL is reopened
+	took := w.RecentFsyncDuration(1)
+	w, err = w.Reopen(zaptest.NewLogger(t), walpb.Snapshot{})
+	require.NoError(t, err)
+	require.Equal(t, took, w.RecentFsyncDuration(1))
+	_, _, _, err = w.ReadAll()
+	require.NoError(t, err)
+	require.NoError(t, w.cut())
+	require.Equal(t, []string{walName(0, 0), walName(1, 1), walName(2, 2)}, finished)
+}
+
 func TestSaveWithCut(t *testing.T) {
 	p := t.TempDir()
 
@@ -0,0 +1,267 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package walarchive copies the finished WAL segments and the snapshot files
+// of an etcd member to a sink, so the state of the member can be recovered up
+// to the last archived segment.
+package walarchive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+)
+
+// DefaultScanInterval is the interval between two scans of the WAL and snap
+// directories for files to archive, in addition to the scans triggered by
+// Notify.
+const DefaultScanInterval = 30 * time.Second
+
+// Archiver copies the finished WAL segments and the snapshot files of a member
+// to a sink, and records them in the manifest of the sink. A WAL segment is
+// finished once a later segment exists. Segments are archived in order.
+type Archiver struct {
+	lg      *zap.Logger
+	sink    Sink
+	walDir  string
+	snapDir string
+
+	interval time.Duration
+	notifyc  chan struct{}
+
+	mu       sync.RWMutex
+	manifest *Manifest
+	// archived is the set of the names in the manifest. It is nil until the
+	// manifest is loaded.
+	archived map[string]struct{}
+}
+
+// New returns an archiver of the WAL segments in walDir and the snapshot files
+// in snapDir.
+func New(lg *zap.Logger, sink Sink, walDir, snapDir string) *Archiver {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return &Archiver{
+		lg:       lg,
+		sink:     sink,
+		walDir:   walDir,
+		snapDir:  snapDir,
+		interval: DefaultScanInterval,
+		notifyc:  make(chan struct{}, 1),
+	}
+}
+
+// Notify triggers a scan for files to archive, e.g. after a WAL segment is
+// finished. It does not block.
+func (a *Archiver) Notify() {
+	select {
+	case a.notifyc <- struct{}{}:
+	default:
+	}
+}
+
+// Archived returns true if the file at path may be removed from the member as
+// far as the archiver is concerned: WAL segments and snapshot files once they
+// are archived, and any other file.
+func (a *Archiver) Archived(p string) bool {
+	name := archiveName(p)
+	if name == "" {
+		return true
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.archived[name]
+	return ok
+}
+
+// Run archives files until stop is closed.
+func (a *Archiver) Run(stop <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		if a.loadManifest(ctx) {
+			a.scan(ctx)
+		}
+		select {
+		case <-a.notifyc:
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (a *Archiver) loadManifest(ctx context.Context) bool {
+	a.mu.RLock()
+	loaded := a.archived != nil
+	a.mu.RUnlock()
+	if loaded {
+		return true
+	}
+
+	m, err := ReadManifest(ctx, a.sink)
+	if err != nil {
+		archiveFailures.Inc()
+		a.lg.Warn("failed to read WAL archive manifest", zap.Error(err))
+		return false
+	}
+	archived := make(map[string]struct{}, len(m.Files))
+	for _, f := range m.Files {
+		archived[f.Name] = struct{}{}
+	}
+	a.mu.Lock()
+	a.manifest, a.archived = m, archived
+	a.mu.Unlock()
+	return true
+}
+
+func (a *Archiver) scan(ctx context.Context) {
+	wals, err := readDirSuffix(a.walDir, ".wal")
+	if err != nil {
+		archiveFailures.Inc()
+		a.lg.Warn("failed to read WAL directory", zap.String("dir", a.walDir), zap.Error(err))
+		return
+	}
+	// the last segment is still being written to.
+	if len(wals) > 0 {
+		wals = wals[:len(wals)-1]
+	}
+	for _, w := range wals {
+		p := filepath.Join(a.walDir, w)
+		if a.Archived(p) {
+			continue
+		}
+		if err = a.archive(ctx, p); err != nil {
+			// later segments are archived once this one is.
+			return
+		}
+	}
+
+	snaps, err := readDirSuffix(a.snapDir, ".snap")
+	if err != nil {
+		archiveFailures.Inc()
+		a.lg.Warn("failed to read snap directory", zap.String("dir", a.snapDir), zap.Error(err))
+		return
+	}
+	for _, s := range snaps {
+		p := filepath.Join(a.snapDir, s)
+		if a.Archived(p) {
+			continue
+		}
+		// skip the files being written and the broken files, the snapshotter
+		// renames the latter on its next load.
+		if _, err = snap.Read(a.lg, p); err != nil {
+			continue
+		}
+		a.archive(ctx, p)
+	}
+}
+
+func (a *Archiver) archive(ctx context.Context, p string) error {
+	name := archiveName(p)
+	f, err := os.Open(p)
+	if err != nil {
+		return a.fail(name, err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return a.fail(name, err)
+	}
+
+	h := sha256.New()
+	if err = a.sink.Put(ctx, name, io.TeeReader(f, h), fi.Size()); err != nil {
+		return a.fail(name, err)
+	}
+
+	af := File{
+		Name:        name,
+		Size:        fi.Size(),
+		SHA256:      hex.EncodeToString(h.Sum(nil)),
+		ArchiveTime: time.Now().UTC(),
+	}
+	a.mu.RLock()
+	i := len(a.manifest.Files)
+	a.mu.RUnlock()
+	// the file is archived once it is in the manifest.
+	if err = appendManifest(ctx, a.sink, i, af); err != nil {
+		return a.fail(manifestEntryName(i), err)
+	}
+
+	a.mu.Lock()
+	a.manifest.Files = append(a.manifest.Files, af)
+	a.archived[name] = struct{}{}
+	a.mu.Unlock()
+
+	archivedFiles.WithLabelValues(path.Dir(name)).Inc()
+	a.lg.Info("archived file", zap.String("path", p), zap.String("name", name), zap.Int64("size", fi.Size()))
+	return nil
+}
+
+func (a *Archiver) fail(name string, err error) error {
+	archiveFailures.Inc()
+	a.lg.Warn("failed to archive file", zap.String("name", name), zap.Error(err))
+	return err
+}
+
+// archiveName returns the name in the sink of the file at p, or an empty
+// string if the file is not archived.
+func archiveName(p string) string {
+	base := filepath.Base(p)
+	switch {
+	case strings.HasSuffix(base, ".wal"):
+		return path.Join(WALDir, base)
+	case strings.HasSuffix(base, ".snap"):
+		return path.Join(SnapDir, base)
+	default:
+		return ""
+	}
+}
+
+func readDirSuffix(dir, suffix string) ([]string, error) {
+	names, err := fileutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var matched []string
+	for _, n := range names {
+		if strings.HasSuffix(n, suffix) {
+			matched = append(matched, n)
+		}
+	}
+	return matched, nil
+}
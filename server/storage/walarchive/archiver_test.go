@@ -0,0 +1,123 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walarchive
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func walName(seq uint64) string {
+	return fmt.Sprintf("%016x-%016x.wal", seq, seq*10)
+}
+
+func writeSegment(t *testing.T, dir string, seq uint64) string {
+	p := filepath.Join(dir, walName(seq))
+	require.NoError(t, os.WriteFile(p, []byte(fmt.Sprintf("segment %d", seq)), 0o600))
+	return p
+}
+
+func TestArchiver(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	walDir, snapDir := t.TempDir(), t.TempDir()
+	srv, files := newHTTPStore(t)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	sink := NewHTTPSink(u, srv.Client())
+
+	seg0 := writeSegment(t, walDir, 0)
+	seg1 := writeSegment(t, walDir, 1)
+	require.NoError(t, snap.New(lg, snapDir).SaveSnap(raftpb.Snapshot{
+		Data:     []byte("snapshot"),
+		Metadata: raftpb.SnapshotMetadata{Index: 5, Term: 1},
+	}))
+	snaps, err := readDirSuffix(snapDir, ".snap")
+	require.NoError(t, err)
+	require.Len(t, snaps, 1)
+	snapPath := filepath.Join(snapDir, snaps[0])
+
+	a := New(lg, sink, walDir, snapDir)
+	require.False(t, a.Archived(seg0), "nothing is archived before the manifest is loaded")
+	require.True(t, a.Archived(filepath.Join(snapDir, "0000000000000005.snap.db")))
+
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		a.Run(stop)
+		close(done)
+	}()
+	defer func() {
+		close(stop)
+		<-done
+	}()
+
+	require.Eventually(t, func() bool {
+		return a.Archived(seg0) && a.Archived(snapPath)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.False(t, a.Archived(seg1), "the last segment is not finished")
+
+	seg2 := writeSegment(t, walDir, 2)
+	a.Notify()
+	require.Eventually(t, func() bool { return a.Archived(seg1) }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, a.Archived(seg2))
+
+	ctx := context.Background()
+	m, err := ReadManifest(ctx, sink)
+	require.NoError(t, err)
+	var names []string
+	for _, f := range m.Files {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"wal/" + walName(0), "snap/" + snaps[0], "wal/" + walName(1)}, names)
+	// each archived file is an entry of the manifest of its own.
+	require.Contains(t, files, "/"+manifestEntryName(2))
+	require.NoError(t, Verify(ctx, sink, ""))
+
+	// an archiver restarted on the same sink keeps the archived files.
+	b := New(lg, sink, walDir, snapDir)
+	require.True(t, b.loadManifest(ctx))
+	require.True(t, b.Archived(seg1))
+	require.False(t, b.Archived(seg2))
+}
+
+func TestVerifyChecksumMismatch(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	walDir, archiveDir := t.TempDir(), t.TempDir()
+	sink := NewLocalSink(archiveDir)
+	seg0 := writeSegment(t, walDir, 0)
+	writeSegment(t, walDir, 1)
+
+	a := New(lg, sink, walDir, t.TempDir())
+	ctx := context.Background()
+	require.True(t, a.loadManifest(ctx))
+	a.scan(ctx)
+	require.True(t, a.Archived(seg0))
+	require.NoError(t, Verify(ctx, sink, WALDir+"/"))
+
+	archived := filepath.Join(archiveDir, WALDir, walName(0))
+	require.NoError(t, os.WriteFile(archived, []byte("segment X"), 0o600))
+	require.ErrorIs(t, Verify(ctx, sink, WALDir+"/"), ErrChecksumMismatch)
+	require.NoError(t, Verify(ctx, sink, SnapDir+"/"))
+}
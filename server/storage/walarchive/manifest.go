@@ -0,0 +1,139 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walarchive
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// ManifestDir is the directory of the manifest in the sink. Each archived
+	// file is appended to the manifest as an entry of its own, named by its
+	// position in the manifest, so the earlier entries are never rewritten.
+	ManifestDir = "manifest"
+	// WALDir is the directory of the archived WAL segments in the sink.
+	WALDir = "wal"
+	// SnapDir is the directory of the archived snapshot files in the sink.
+	SnapDir = "snap"
+)
+
+var ErrChecksumMismatch = errors.New("walarchive: checksum mismatch")
+
+// Manifest lists the files stored in a sink, in the order they were archived.
+type Manifest struct {
+	Files []File `json:"files"`
+}
+
+// File is a file stored in a sink.
+type File struct {
+	// Name is the name of the file in the sink, e.g. wal/0000000000000001-0000000000001a2b.wal.
+	Name string `json:"name"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+	// SHA256 is the hex encoded sha256 checksum of the file.
+	SHA256 string `json:"sha256"`
+	// ArchiveTime is the time the file was archived.
+	ArchiveTime time.Time `json:"archive-time"`
+}
+
+// manifestEntryName returns the name in the sink of the i-th manifest entry.
+func manifestEntryName(i int) string {
+	return path.Join(ManifestDir, fmt.Sprintf("%016x.json", i))
+}
+
+// ReadManifest reads the manifest of the sink. It returns an empty manifest if
+// nothing was archived to the sink yet.
+func ReadManifest(ctx context.Context, sink Sink) (*Manifest, error) {
+	m := &Manifest{}
+	for {
+		f, err := readManifestEntry(ctx, sink, len(m.Files))
+		if errors.Is(err, fs.ErrNotExist) {
+			return m, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.Files = append(m.Files, f)
+	}
+}
+
+func readManifestEntry(ctx context.Context, sink Sink, i int) (File, error) {
+	name := manifestEntryName(i)
+	rc, err := sink.Get(ctx, name)
+	if err != nil {
+		return File{}, err
+	}
+	defer rc.Close()
+	var f File
+	if err = json.NewDecoder(rc).Decode(&f); err != nil {
+		return File{}, fmt.Errorf("invalid WAL archive manifest entry %q: %w", name, err)
+	}
+	return f, nil
+}
+
+// appendManifest appends f to the manifest of the sink as its i-th entry.
+func appendManifest(ctx context.Context, sink Sink, i int, f File) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	return sink.Put(ctx, manifestEntryName(i), bytes.NewReader(data), int64(len(data)))
+}
+
+// Verify checks the size and checksum of the files of the manifest of the sink
+// whose name starts with prefix.
+func Verify(ctx context.Context, sink Sink, prefix string) error {
+	m, err := ReadManifest(ctx, sink)
+	if err != nil {
+		return err
+	}
+	for _, f := range m.Files {
+		if !strings.HasPrefix(f.Name, prefix) {
+			continue
+		}
+		if err = verifyFile(ctx, sink, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyFile(ctx context.Context, sink Sink, f File) error {
+	rc, err := sink.Get(ctx, f.Name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, rc)
+	if err != nil {
+		return err
+	}
+	if n != f.Size || hex.EncodeToString(h.Sum(nil)) != f.SHA256 {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, f.Name)
+	}
+	return nil
+}
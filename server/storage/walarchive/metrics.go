@@ -0,0 +1,40 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walarchive
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	archivedFiles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_archive_files_total",
+		Help:      "Total number of files copied to the WAL archive.",
+	},
+		[]string{"type"},
+	)
+
+	archiveFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "wal_archive_failures_total",
+		Help:      "Total number of failed attempts to copy a file to the WAL archive.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(archivedFiles)
+	prometheus.MustRegister(archiveFailures)
+}
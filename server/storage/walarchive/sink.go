@@ -0,0 +1,167 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walarchive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+// Sink stores archived files. File names are slash separated paths.
+type Sink interface {
+	// Put stores the size bytes read from r as the file name, replacing the
+	// file if it exists.
+	Put(ctx context.Context, name string, r io.Reader, size int64) error
+	// Get returns the content of the file name. The returned error wraps
+	// fs.ErrNotExist if the file does not exist.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// NewSink returns the sink for the URL. A file URL or a plain path selects a
+// local directory, an http or https URL selects an HTTP server that accepts
+// PUT requests, each of which must complete within timeout.
+func NewSink(rawURL string, timeout time.Duration) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid WAL archive URL %q: %w", rawURL, err)
+	}
+	switch u.Scheme {
+	case "":
+		return NewLocalSink(rawURL), nil
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("invalid WAL archive URL %q: missing path", rawURL)
+		}
+		return NewLocalSink(u.Path), nil
+	case "http", "https":
+		return NewHTTPSink(u, &http.Client{Timeout: timeout}), nil
+	default:
+		return nil, fmt.Errorf("unsupported WAL archive URL scheme %q", u.Scheme)
+	}
+}
+
+type localSink struct {
+	dir string
+}
+
+// NewLocalSink returns a sink storing the files in the directory dir.
+// Files are written to a temporary file first, and are renamed once synced.
+func NewLocalSink(dir string) Sink {
+	return &localSink{dir: dir}
+}
+
+func (s *localSink) Put(_ context.Context, name string, r io.Reader, size int64) error {
+	path := filepath.Join(s.dir, filepath.FromSlash(name))
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, fileutil.PrivateDirMode); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, r)
+	if err == nil && n != size {
+		err = fmt.Errorf("wrote %d bytes of %q, expected %d", n, name, size)
+	}
+	if err == nil {
+		err = fileutil.Fsync(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return err
+	}
+	df, err := fileutil.OpenDir(dir)
+	if err != nil {
+		return err
+	}
+	defer df.Close()
+	return fileutil.Fsync(df)
+}
+
+func (s *localSink) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+}
+
+type httpSink struct {
+	base   *url.URL
+	client *http.Client
+}
+
+// NewHTTPSink returns a sink storing the files with PUT requests to the URL
+// of the file below base, and reading them with GET requests.
+func NewHTTPSink(base *url.URL, client *http.Client) Sink {
+	return &httpSink{base: base, client: client}
+}
+
+func (s *httpSink) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	u := s.base.JoinPath(name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		// a zero length with a body is taken as an unknown length
+		req.Body = http.NoBody
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to put %q: %s", u.Redacted(), resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	u := s.base.JoinPath(name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %q: %w", u.Redacted(), fs.ErrNotExist)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %q: %s", u.Redacted(), resp.Status)
+	}
+	return resp.Body, nil
+}
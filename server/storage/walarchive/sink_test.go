@@ -0,0 +1,128 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package walarchive
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newHTTPStore returns a server storing the bodies of PUT requests in memory.
+func newHTTPStore(t *testing.T) (*httptest.Server, map[string][]byte) {
+	var mu sync.Mutex
+	files := make(map[string][]byte)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			files[r.URL.Path] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			data, ok := files[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(data)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, files
+}
+
+func TestSinks(t *testing.T) {
+	srv, files := newHTTPStore(t)
+	u, err := url.Parse(srv.URL + "/archive")
+	require.NoError(t, err)
+
+	sinks := map[string]Sink{
+		"local": NewLocalSink(t.TempDir()),
+		"http":  NewHTTPSink(u, srv.Client()),
+	}
+	for name, sink := range sinks {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, err := sink.Get(ctx, "wal/missing.wal")
+			require.ErrorIs(t, err, fs.ErrNotExist)
+
+			for _, content := range []string{"first", "second", ""} {
+				require.NoError(t, sink.Put(ctx, "wal/0.wal", strings.NewReader(content), int64(len(content))))
+				rc, err := sink.Get(ctx, "wal/0.wal")
+				require.NoError(t, err)
+				data, err := io.ReadAll(rc)
+				rc.Close()
+				require.NoError(t, err)
+				require.Equal(t, content, string(data))
+			}
+		})
+	}
+	require.Contains(t, files, "/archive/wal/0.wal")
+}
+
+func TestHTTPSinkTimeout(t *testing.T) {
+	blockc := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blockc
+	}))
+	defer srv.Close()
+	defer close(blockc)
+
+	sink, err := NewSink(srv.URL, 100*time.Millisecond)
+	require.NoError(t, err)
+	err = sink.Put(context.Background(), "wal/0.wal", strings.NewReader("first"), 5)
+	require.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestNewSink(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "/var/lib/etcd-archive"},
+		{url: "file:///var/lib/etcd-archive"},
+		{url: "http://127.0.0.1:8080/archive"},
+		{url: "https://archive.example.com/etcd"},
+		{url: "file://", wantErr: true},
+		{url: "s3://bucket/etcd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			_, err := NewSink(tt.url, time.Minute)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 func TestCtlV3AuthMemberUpdate(t *testing.T) { testCtl(t, authTestMemberUpdate) }
 func TestCtlV3AuthFromKeyPerm(t *testing.T)  { testCtl(t, authTestFromKeyPerm) }
+func TestCtlV3AuthDenyPerm(t *testing.T)     { testCtl(t, authTestDenyPerm) }
 
 // TestCtlV3AuthAndWatch TODO https://github.com/etcd-io/etcd/issues/7988 is the blocker of migration to common/auth_test.go
 func TestCtlV3AuthAndWatch(t *testing.T)    { testCtl(t, authTestWatch) }
@@ -184,6 +185,22 @@ func authTestFromKeyPerm(cx ctlCtx) {
 	}
 }
 
+func authTestDenyPerm(cx ctlCtx) {
+	require.NoError(cx.t, authEnable(cx))
+
+	cx.user, cx.pass = "root", "root"
+	authSetupTestUser(cx)
+	require.NoError(cx.t, ctlV3RoleGrantPermission(cx, "test-role", grantingPerm{true, true, "app/", "", true}))
+
+	// deny permissions are rejected until all members are v3.7, as older
+	// members would store them as allow permissions.
+	cmdArgs := append(cx.PrefixArgs(), "role", "grant-permission", "--prefix", "--deny", "test-role", "write", "app/secrets/")
+	require.NoError(cx.t, e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, expect.ExpectedResponse{Value: "request not supported by the cluster version"}))
+
+	cx.user, cx.pass = "test-user", "pass"
+	require.NoError(cx.t, ctlV3Put(cx, "app/secrets/key", "val", ""))
+}
+
 func authTestWatch(cx ctlCtx) {
 	require.NoError(cx.t, authEnable(cx))
 
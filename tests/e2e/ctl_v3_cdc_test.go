@@ -0,0 +1,103 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/pkg/v3/expect"
+	"go.etcd.io/etcd/tests/v3/framework/config"
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3CDC(t *testing.T) { testCtl(t, cdcTest) }
+
+type cdcRecord struct {
+	Op               string `json:"op"`
+	Revision         int64  `json:"revision"`
+	Key              []byte `json:"key"`
+	GapStartRevision int64  `json:"gap_start_revision"`
+}
+
+func cdcTest(cx ctlCtx) {
+	dir := cx.t.TempDir()
+
+	// key1 is put at revision 2.
+	proc := spawnCDC(cx, dir, "--rev", "2")
+	require.NoError(cx.t, ctlV3Put(cx, "key1", "val1", ""))
+	require.NoError(cx.t, ctlV3Put(cx, "other", "val", ""))
+	waitCDCRecords(cx.t, dir, []cdcRecord{{Op: "PUT", Revision: 2, Key: []byte("key1")}})
+	require.NoError(cx.t, proc.Stop())
+
+	// the changes made while the stream is stopped are compacted, so the
+	// restarted stream records a gap before the remaining changes.
+	require.NoError(cx.t, ctlV3Put(cx, "key2", "val2", ""))
+	require.NoError(cx.t, ctlV3Put(cx, "key3", "val3", ""))
+	_, err := cx.epc.Etcdctl().Compact(context.TODO(), 5, config.CompactOption{})
+	require.NoError(cx.t, err)
+
+	proc = spawnCDC(cx, dir)
+	defer func() {
+		require.NoError(cx.t, proc.Stop())
+	}()
+	require.NoError(cx.t, ctlV3Put(cx, "key4", "val4", ""))
+	waitCDCRecords(cx.t, dir, []cdcRecord{
+		{Op: "PUT", Revision: 2, Key: []byte("key1")},
+		// revision 3 is not in the prefix, but was not checkpointed either.
+		{Op: "GAP", Revision: 4, GapStartRevision: 3},
+		{Op: "PUT", Revision: 5, Key: []byte("key3")},
+		{Op: "PUT", Revision: 6, Key: []byte("key4")},
+	})
+}
+
+func spawnCDC(cx ctlCtx, dir string, flags ...string) *expect.ExpectProcess {
+	cmdArgs := append(cx.PrefixArgs(), "cdc", "--prefix", "key")
+	cmdArgs = append(cmdArgs, flags...)
+	cmdArgs = append(cmdArgs, dir)
+	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
+	require.NoError(cx.t, err)
+	return proc
+}
+
+// waitCDCRecords waits for the segment files of dir to hold the records.
+func waitCDCRecords(t *testing.T, dir string, want []cdcRecord) {
+	var got []cdcRecord
+	require.Eventuallyf(t, func() bool {
+		got = nil
+		segments, err := filepath.Glob(filepath.Join(dir, "*.ndjson*"))
+		require.NoError(t, err)
+		for _, segment := range segments {
+			f, err := os.Open(segment)
+			require.NoError(t, err)
+			s := bufio.NewScanner(f)
+			for s.Scan() {
+				var r cdcRecord
+				require.NoError(t, json.Unmarshal(s.Bytes(), &r))
+				got = append(got, r)
+			}
+			f.Close()
+		}
+		return len(got) >= len(want)
+	}, 10*time.Second, 50*time.Millisecond, "records of %s", dir)
+	require.Equal(t, want, got)
+}
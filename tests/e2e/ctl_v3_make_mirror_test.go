@@ -22,6 +22,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"go.etcd.io/etcd/pkg/v3/expect"
+	"go.etcd.io/etcd/tests/v3/framework/config"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
 )
 
@@ -29,6 +31,11 @@ func TestCtlV3MakeMirror(t *testing.T)                 { testCtl(t, makeMirrorTe
 func TestCtlV3MakeMirrorModifyDestPrefix(t *testing.T) { testCtl(t, makeMirrorModifyDestPrefixTest) }
 func TestCtlV3MakeMirrorNoDestPrefix(t *testing.T)     { testCtl(t, makeMirrorNoDestPrefixTest) }
 func TestCtlV3MakeMirrorWithWatchRev(t *testing.T)     { testCtl(t, makeMirrorWithWatchRev) }
+func TestCtlV3MakeMirrorKeyRules(t *testing.T)         { testCtl(t, makeMirrorKeyRulesTest) }
+func TestCtlV3MakeMirrorResume(t *testing.T)           { testCtl(t, makeMirrorResumeTest) }
+func TestCtlV3MakeMirrorResumeCompacted(t *testing.T)  { testCtl(t, makeMirrorResumeCompactedTest) }
+func TestCtlV3MakeMirrorKeepLeases(t *testing.T)       { testCtl(t, makeMirrorKeepLeasesTest) }
+func TestCtlV3MakeMirrorKeepLeasesResume(t *testing.T) { testCtl(t, makeMirrorKeepLeasesResumeTest) }
 
 func makeMirrorTest(cx ctlCtx) {
 	var (
@@ -75,8 +82,94 @@ func makeMirrorWithWatchRev(cx ctlCtx) {
 	testMirrorCommand(cx, flags, kvs, kvs2, srcprefix, destprefix)
 }
 
-func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvExec, srcprefix, destprefix string) {
-	// set up another cluster to mirror with
+func makeMirrorKeyRulesTest(cx ctlCtx) {
+	var (
+		flags      = []string{"--prefix", "o_", "--no-dest-prefix", "--exclude-regex", "^o_c", "--rename-prefix", "o_b=key_b"}
+		kvs        = []kv{{"o_key1", "val1"}, {"o_b1", "val2"}, {"o_c1", "val3"}, {"o_key4", "val4"}}
+		kvs2       = []kvExec{{key: "key1", val: "val1"}, {key: "key_b1", val: "val2"}, {key: "key4", val: "val4"}}
+		srcprefix  = "o_"
+		destprefix = "key"
+	)
+
+	testMirrorCommand(cx, flags, kvs, kvs2, srcprefix, destprefix)
+}
+
+func makeMirrorResumeTest(cx ctlCtx)          { testMirrorResume(cx, false) }
+func makeMirrorResumeCompactedTest(cx ctlCtx) { testMirrorResume(cx, true) }
+
+func testMirrorResume(cx ctlCtx, compact bool) {
+	mirrorctx := newMirrorCtx(cx)
+	flags := []string{"--prefix", "key", "--resume"}
+
+	proc := spawnMakeMirror(cx, mirrorctx, flags)
+	require.NoError(cx.t, ctlV3Put(cx, "key1", "val1", ""))
+	require.NoError(cx.t, ctlV3Watch(mirrorctx, []string{"key", "--rev", "1", "--prefix"}, kvExec{key: "key1", val: "val1"}))
+	require.NoError(cx.t, proc.Stop())
+
+	// the updates made while the mirror is stopped are mirrored once it
+	// resumes, including the delete a full resync would miss.
+	require.NoError(cx.t, ctlV3Del(cx, []string{"key1"}, 1))
+	require.NoError(cx.t, ctlV3Put(cx, "key2", "val2", ""))
+	want := []kvExec{{key: "key1", val: "val1"}, {key: "DELETE", val: "key1"}, {key: "key2", val: "val2"}}
+	if compact {
+		// the mirror falls back to a full resync, which deletes the stale keys after copying.
+		_, err := cx.epc.Etcdctl().Compact(context.TODO(), 4, config.CompactOption{})
+		require.NoError(cx.t, err)
+		want = []kvExec{{key: "key1", val: "val1"}, {key: "key2", val: "val2"}, {key: "DELETE", val: "key1"}}
+	}
+	proc = spawnMakeMirror(cx, mirrorctx, flags)
+	defer func() {
+		require.NoError(cx.t, proc.Stop())
+	}()
+	require.NoError(cx.t, ctlV3Watch(mirrorctx, []string{"key", "--rev", "1", "--prefix"}, want...))
+}
+
+func makeMirrorKeepLeasesTest(cx ctlCtx) {
+	mirrorctx := newMirrorCtx(cx)
+	proc := spawnMakeMirror(cx, mirrorctx, []string{"--prefix", "key", "--leases", "keep"})
+	defer func() {
+		require.NoError(cx.t, proc.Stop())
+	}()
+
+	leaseID, err := ctlV3LeaseGrant(cx, 60)
+	require.NoError(cx.t, err)
+	require.NoError(cx.t, ctlV3Put(cx, "key1", "val1", leaseID))
+	require.NoError(cx.t, ctlV3Watch(mirrorctx, []string{"key", "--rev", "1", "--prefix"}, kvExec{key: "key1", val: "val1"}))
+	// the mirrored key is attached to a lease of the destination cluster.
+	cmdArgs := append(mirrorctx.PrefixArgs(), "get", "key1", "-w", "json")
+	require.NoError(cx.t, e2e.SpawnWithExpects(cmdArgs, mirrorctx.envMap, expect.ExpectedResponse{Value: `"lease":`}))
+
+	require.NoError(cx.t, ctlV3LeaseRevoke(cx, leaseID))
+	require.NoError(cx.t, ctlV3Watch(mirrorctx, []string{"key", "--rev", "1", "--prefix"},
+		kvExec{key: "key1", val: "val1"}, kvExec{key: "DELETE", val: "key1"}))
+}
+
+func makeMirrorKeepLeasesResumeTest(cx ctlCtx) {
+	mirrorctx := newMirrorCtx(cx)
+	flags := []string{"--prefix", "key", "--resume", "--leases", "keep"}
+	proc := spawnMakeMirror(cx, mirrorctx, flags)
+
+	leaseID, err := ctlV3LeaseGrant(cx, 30)
+	require.NoError(cx.t, err)
+	require.NoError(cx.t, ctlV3Put(cx, "key1", "val1", leaseID))
+	require.NoError(cx.t, ctlV3Watch(mirrorctx, []string{"key", "--rev", "1", "--prefix"}, kvExec{key: "key1", val: "val1"}))
+	require.NoError(cx.t, proc.Stop())
+
+	// the restarted mirror keeps the destination lease granted before, and
+	// revokes it once the source lease expires instead of waiting for its TTL.
+	proc = spawnMakeMirror(cx, mirrorctx, flags)
+	defer func() {
+		require.NoError(cx.t, proc.Stop())
+	}()
+	require.NoError(cx.t, ctlV3LeaseRevoke(cx, leaseID))
+	cmdArgs := append(mirrorctx.PrefixArgs(), "lease", "list")
+	require.Eventually(cx.t, func() bool {
+		return e2e.SpawnWithExpects(cmdArgs, mirrorctx.envMap, expect.ExpectedResponse{Value: "found 0 leases"}) == nil
+	}, 20*time.Second, time.Second)
+}
+
+// newMirrorCtx sets up another cluster to mirror with.
+func newMirrorCtx(cx ctlCtx) ctlCtx {
 	mirrorcfg := e2e.NewConfigAutoTLS()
 	mirrorcfg.ClusterSize = 1
 	mirrorcfg.BasePort = 10000
@@ -92,17 +185,26 @@ func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvEx
 	}
 	mirrorctx.epc = mirrorepc
 
-	defer func() {
+	cx.t.Cleanup(func() {
 		if err = mirrorctx.epc.Close(); err != nil {
 			cx.t.Fatalf("error closing etcd processes (%v)", err)
 		}
-	}()
+	})
+	return mirrorctx
+}
 
+func spawnMakeMirror(cx, mirrorctx ctlCtx, flags []string) *expect.ExpectProcess {
 	cmdArgs := append(cx.PrefixArgs(), "make-mirror")
 	cmdArgs = append(cmdArgs, flags...)
-	cmdArgs = append(cmdArgs, fmt.Sprintf("localhost:%d", mirrorcfg.BasePort))
+	cmdArgs = append(cmdArgs, fmt.Sprintf("localhost:%d", mirrorctx.cfg.BasePort))
 	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
 	require.NoError(cx.t, err)
+	return proc
+}
+
+func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvExec, srcprefix, destprefix string) {
+	mirrorctx := newMirrorCtx(cx)
+	proc := spawnMakeMirror(cx, mirrorctx, flags)
 	defer func() {
 		require.NoError(cx.t, proc.Stop())
 	}()
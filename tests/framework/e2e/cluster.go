@@ -387,6 +387,10 @@ func WithCompactionSleepInterval(time time.Duration) EPClusterOption {
 	return func(c *EtcdProcessClusterConfig) { c.ServerConfig.ExperimentalCompactionSleepInterval = time }
 }
 
+func WithReadIndexMode(mode string) EPClusterOption {
+	return func(c *EtcdProcessClusterConfig) { c.ServerConfig.ReadIndexMode = mode }
+}
+
 func WithWatchProcessNotifyInterval(interval time.Duration) EPClusterOption {
 	return func(c *EtcdProcessClusterConfig) { c.ServerConfig.WatchProgressNotifyInterval = interval }
 }
@@ -52,6 +52,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/featuregate"
 	"go.etcd.io/etcd/pkg/v3/grpctesting"
+	"go.etcd.io/etcd/server/v3/audit"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver"
@@ -172,6 +173,16 @@ type ClusterConfig struct {
 	LeaseCheckpointInterval time.Duration
 	LeaseCheckpointPersist  bool
 
+	EnableLearnerAutoPromotion bool
+	LearnerAutoPromoteDuration time.Duration
+
+	LeaseRead bool
+
+	GRPCRateLimit      float64
+	GRPCRateLimitBurst int
+
+	Auditor *audit.Auditor
+
 	WatchProgressNotifyInterval time.Duration
 	MaxLearners                 int
 	DisableStrictReconfigCheck  bool
@@ -290,6 +301,12 @@ func (c *Cluster) MustNewMember(t testutil.TB) *Member {
 			EnableLeaseCheckpoint:       c.Cfg.EnableLeaseCheckpoint,
 			LeaseCheckpointInterval:     c.Cfg.LeaseCheckpointInterval,
 			LeaseCheckpointPersist:      c.Cfg.LeaseCheckpointPersist,
+			EnableLearnerAutoPromotion:  c.Cfg.EnableLearnerAutoPromotion,
+			LearnerAutoPromoteDuration:  c.Cfg.LearnerAutoPromoteDuration,
+			LeaseRead:                   c.Cfg.LeaseRead,
+			GRPCRateLimit:               c.Cfg.GRPCRateLimit,
+			GRPCRateLimitBurst:          c.Cfg.GRPCRateLimitBurst,
+			Auditor:                     c.Cfg.Auditor,
 			WatchProgressNotifyInterval: c.Cfg.WatchProgressNotifyInterval,
 			MaxLearners:                 c.Cfg.MaxLearners,
 			DisableStrictReconfigCheck:  c.Cfg.DisableStrictReconfigCheck,
@@ -530,7 +547,7 @@ func (c *Cluster) waitVersion() {
 func isMembersEqual(membs []*pb.Member, wmembs []*pb.Member) bool {
 	sort.Sort(SortableMemberSliceByPeerURLs(membs))
 	sort.Sort(SortableMemberSliceByPeerURLs(wmembs))
-	return cmp.Equal(membs, wmembs, cmpopts.IgnoreFields(pb.Member{}, "ID", "PeerURLs", "ClientURLs"))
+	return cmp.Equal(membs, wmembs, cmpopts.IgnoreFields(pb.Member{}, "ID", "PeerURLs", "ClientURLs", "LearnerPromotion"))
 }
 
 func NewLocalListener(t testutil.TB) net.Listener {
@@ -616,6 +633,12 @@ type MemberConfig struct {
 	EnableLeaseCheckpoint       bool
 	LeaseCheckpointInterval     time.Duration
 	LeaseCheckpointPersist      bool
+	EnableLearnerAutoPromotion  bool
+	LearnerAutoPromoteDuration  time.Duration
+	LeaseRead                   bool
+	GRPCRateLimit               float64
+	GRPCRateLimitBurst          int
+	Auditor                     *audit.Auditor
 	WatchProgressNotifyInterval time.Duration
 	MaxLearners                 int
 	DisableStrictReconfigCheck  bool
@@ -720,6 +743,16 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	m.UseBridge = mcfg.UseBridge
 	m.UseTCP = mcfg.UseTCP
 	m.LeaseCheckpointInterval = mcfg.LeaseCheckpointInterval
+	m.LearnerAutoPromoteMaxLag = embed.DefaultLearnerAutoPromoteMaxLag
+	m.LearnerAutoPromoteDuration = embed.DefaultLearnerAutoPromoteDuration
+	if mcfg.LearnerAutoPromoteDuration != 0 {
+		m.LearnerAutoPromoteDuration = mcfg.LearnerAutoPromoteDuration
+	}
+	m.LeaseRead = mcfg.LeaseRead
+	m.LeaseReadMaxClockDrift = framecfg.TickDuration
+	m.GRPCRateLimit = mcfg.GRPCRateLimit
+	m.GRPCRateLimitBurst = mcfg.GRPCRateLimitBurst
+	m.Auditor = mcfg.Auditor
 
 	m.WatchProgressNotifyInterval = mcfg.WatchProgressNotifyInterval
 
@@ -739,7 +772,8 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 
 	m.Logger, m.LogObserver = memberLogger(t, mcfg.Name)
 	m.ServerFeatureGate = features.NewDefaultServerFeatureGate(m.Name, m.Logger)
-	featureGates := fmt.Sprintf("LeaseCheckpoint=%v,LeaseCheckpointPersist=%v", mcfg.EnableLeaseCheckpoint, mcfg.LeaseCheckpointPersist)
+	featureGates := fmt.Sprintf("LeaseCheckpoint=%v,LeaseCheckpointPersist=%v,LearnerAutoPromotion=%v",
+		mcfg.EnableLeaseCheckpoint, mcfg.LeaseCheckpointPersist, mcfg.EnableLearnerAutoPromotion)
 	if err := m.ServerFeatureGate.(featuregate.MutableFeatureGate).Set(featureGates); err != nil {
 		t.Fatalf("Set FeatureGate FAILED: %v", err)
 	}
@@ -1669,6 +1703,8 @@ func (c *Cluster) waitMembersMatch(t testutil.TB) {
 		sort.Sort(SortableProtoMemberSliceByPeerURLs(resp.Members))
 		for _, m := range resp.Members {
 			m.ID = 0
+			// the auto-promotion state is not part of the membership
+			m.LearnerPromotion = nil
 		}
 		if reflect.DeepEqual(resp.Members, wMembers) {
 			return
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap/zapgrpc"
 	"go.uber.org/zap/zaptest"
 
+	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	"go.etcd.io/etcd/client/pkg/v3/verify"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -44,6 +45,7 @@ type testOptions struct {
 	goLeakDetection bool
 	skipInShort     bool
 	failpoint       *failpoint
+	serverVersion   string
 }
 
 type failpoint struct {
@@ -75,6 +77,12 @@ func WithFailpoint(name, payload string) TestOption {
 	return func(opt *testOptions) { opt.failpoint = &failpoint{name: name, payload: payload} }
 }
 
+// WithServerVersion makes the members started by the test report the given
+// server version, e.g. to run a cluster at a cluster version not released yet.
+func WithServerVersion(v string) TestOption {
+	return func(opt *testOptions) { opt.serverVersion = v }
+}
+
 // BeforeTestExternal initializes test context and is targeted for external APIs.
 // In general the `integration` package is not targeted to be used outside of
 // etcd project, but till the dedicated package is developed, this is
@@ -109,6 +117,14 @@ func BeforeTest(t testutil.TB, opts ...TestOption) {
 		})
 	}
 
+	if options.serverVersion != "" {
+		previousVersion := version.Version
+		version.Version = options.serverVersion
+		t.Cleanup(func() {
+			version.Version = previousVersion
+		})
+	}
+
 	previousWD, err := os.Getwd()
 	if err != nil {
 		t.Fatal(err)
@@ -293,6 +293,67 @@ func TestMemberPromote(t *testing.T) {
 	}
 }
 
+// TestMemberAutoPromote ensures that the leader promotes a learner that stays
+// caught up, if the LearnerAutoPromotion feature is enabled.
+func TestMemberAutoPromote(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{
+		Size:                       3,
+		DisableStrictReconfigCheck: true,
+		EnableLearnerAutoPromotion: true,
+		LearnerAutoPromoteDuration: 2 * time.Second,
+	})
+	defer clus.Terminate(t)
+
+	leaderIdx := clus.WaitLeader(t)
+	capi := clus.Client(leaderIdx)
+
+	learnerMember := clus.MustNewMember(t)
+	memberAddResp, err := capi.MemberAddAsLearner(context.Background(), learnerMember.PeerURLs.StringSlice())
+	require.NoError(t, err)
+	learnerID := memberAddResp.Member.ID
+
+	// the learner is not started yet, it is not caught up.
+	listResp, err := capi.MemberList(context.Background())
+	require.NoError(t, err)
+	for _, m := range listResp.Members {
+		if m.ID != learnerID {
+			require.Nil(t, m.LearnerPromotion)
+			continue
+		}
+		require.True(t, m.IsLearner)
+		if m.LearnerPromotion != nil {
+			require.Zero(t, m.LearnerPromotion.CaughtUpSince)
+		}
+	}
+
+	clus.InitializeMemberWithResponse(t, learnerMember, memberAddResp)
+	require.NoError(t, learnerMember.Launch())
+	defer learnerMember.Terminate(t)
+
+	// a follower fetches the promotion state from the leader.
+	fapi := clus.Client((leaderIdx + 1) % 3)
+	var caughtUp bool
+	require.Eventually(t, func() bool {
+		resp, lerr := fapi.MemberList(context.Background())
+		if lerr != nil {
+			return false
+		}
+		for _, m := range resp.Members {
+			if m.ID != learnerID {
+				continue
+			}
+			if m.LearnerPromotion != nil && m.LearnerPromotion.CaughtUpSince != 0 {
+				caughtUp = true
+			}
+			return !m.IsLearner
+		}
+		return false
+	}, 10*time.Second, 100*time.Millisecond)
+	require.True(t, caughtUp, "the learner was never reported as caught up")
+}
+
 // TestMemberPromoteMemberNotLearner ensures that promoting a voting member fails.
 func TestMemberPromoteMemberNotLearner(t *testing.T) {
 	integration2.BeforeTest(t, integration2.WithFailpoint("raftBeforeAdvance", `sleep(100)`))
@@ -0,0 +1,83 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+func mockSemaphoreAcquire() {
+	fmt.Println("acquired semaphore for s1 and s2")
+	fmt.Println("cannot acquire semaphore for s3, as s1 and s2 hold it")
+	fmt.Println("released semaphore for s1")
+	fmt.Println("acquired semaphore for s3")
+}
+
+func ExampleSemaphore_Acquire() {
+	forUnitTestsRunInMockedContext(
+		mockSemaphoreAcquire,
+		func() {
+			cli, err := clientv3.New(clientv3.Config{Endpoints: exampleEndpoints()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer cli.Close()
+
+			// create three sessions sharing a semaphore of capacity 2
+			var sems []*concurrency.Semaphore
+			for i := 0; i < 3; i++ {
+				s, err := concurrency.NewSession(cli)
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer s.Close()
+				sems = append(sems, concurrency.NewSemaphore(s, "/my-semaphore", 2))
+			}
+
+			// acquire the semaphore for s1 and s2
+			for _, sm := range sems[:2] {
+				if err = sm.Acquire(context.TODO(), 1); err != nil {
+					log.Fatal(err)
+				}
+			}
+			fmt.Println("acquired semaphore for s1 and s2")
+
+			if err = sems[2].TryAcquire(context.TODO(), 1); !errors.Is(err, concurrency.ErrSemaphoreFull) {
+				log.Fatal(err)
+			}
+			fmt.Println("cannot acquire semaphore for s3, as s1 and s2 hold it")
+
+			if err = sems[0].Release(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("released semaphore for s1")
+			if err = sems[2].Acquire(context.TODO(), 1); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired semaphore for s3")
+		})
+
+	// Output:
+	// acquired semaphore for s1 and s2
+	// cannot acquire semaphore for s3, as s1 and s2 hold it
+	// released semaphore for s1
+	// acquired semaphore for s3
+}
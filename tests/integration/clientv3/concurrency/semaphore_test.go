@@ -0,0 +1,173 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+func newSemaphoreSession(t *testing.T, cli *clientv3.Client) *concurrency.Session {
+	s, err := concurrency.NewSession(cli)
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// acquireAsync acquires sm in a goroutine, closing the returned channel once
+// it is acquired.
+func acquireAsync(t *testing.T, sm *concurrency.Semaphore, weight int64) <-chan struct{} {
+	acquired := make(chan struct{})
+	go func() {
+		if err := sm.Acquire(context.TODO(), weight); err != nil {
+			t.Error(err)
+			return
+		}
+		close(acquired)
+	}()
+	return acquired
+}
+
+func requireNotAcquired(t *testing.T, acquired <-chan struct{}) {
+	select {
+	case <-acquired:
+		t.Fatal("semaphore acquired without enough capacity")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func requireAcquired(t *testing.T, acquired <-chan struct{}) {
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("semaphore not acquired")
+	}
+}
+
+func TestSemaphoreAcquireRelease(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	sm1 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-release", 3)
+	sm2 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-release", 3)
+	sm3 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-release", 3)
+
+	require.NoError(t, sm1.Acquire(context.TODO(), 2))
+	require.NoError(t, sm2.TryAcquire(context.TODO(), 1))
+	require.ErrorIs(t, sm3.TryAcquire(context.TODO(), 1), concurrency.ErrSemaphoreFull)
+
+	acquired := acquireAsync(t, sm3, 1)
+	requireNotAcquired(t, acquired)
+	require.NoError(t, sm1.Release(context.TODO()))
+	requireAcquired(t, acquired)
+
+	require.NoError(t, sm2.Release(context.TODO()))
+	require.NoError(t, sm3.Release(context.TODO()))
+	require.ErrorIs(t, sm3.Release(context.TODO()), concurrency.ErrSemaphoreReleased)
+	require.ErrorIs(t, sm3.Acquire(context.TODO(), 4), concurrency.ErrInvalidWeight)
+	require.ErrorIs(t, sm3.Acquire(context.TODO(), 0), concurrency.ErrInvalidWeight)
+}
+
+// TestSemaphoreFairness ensures a session waiting for a large weight is not
+// starved by the sessions asking later for a smaller weight.
+func TestSemaphoreFairness(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	sm1 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-fair", 2)
+	sm2 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-fair", 2)
+	sm3 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-fair", 2)
+
+	require.NoError(t, sm1.Acquire(context.TODO(), 1))
+	acquired2 := acquireAsync(t, sm2, 2)
+	requireNotAcquired(t, acquired2)
+	// there is capacity for sm3, but sm2 asked before it.
+	acquired3 := acquireAsync(t, sm3, 1)
+	requireNotAcquired(t, acquired3)
+
+	require.NoError(t, sm1.Release(context.TODO()))
+	requireAcquired(t, acquired2)
+	requireNotAcquired(t, acquired3)
+
+	require.NoError(t, sm2.Release(context.TODO()))
+	requireAcquired(t, acquired3)
+	require.NoError(t, sm3.Release(context.TODO()))
+}
+
+func TestSemaphoreAcquireSessionExpired(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	sm1 := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-expired", 1)
+	s2, err := concurrency.NewSession(cli)
+	require.NoError(t, err)
+	sm2 := concurrency.NewSemaphore(s2, "/my-sem-expired", 1)
+
+	require.NoError(t, sm1.Acquire(context.TODO(), 1))
+	errc := make(chan error, 1)
+	go func() { errc <- sm2.Acquire(context.TODO(), 1) }()
+	time.Sleep(100 * time.Millisecond)
+
+	// revoke the session of sm2 before releasing sm1
+	require.NoError(t, s2.Close())
+	require.NoError(t, sm1.Release(context.TODO()))
+	require.ErrorIs(t, <-errc, concurrency.ErrSessionExpired)
+}
+
+// TestSemaphoreBoundsHolders ensures no more holders than the capacity hold
+// the semaphore at once.
+func TestSemaphoreBoundsHolders(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	const capacity, holders = 2, 6
+	var current, highest atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < holders; i++ {
+		sm := concurrency.NewSemaphore(newSemaphoreSession(t, cli), "/my-sem-bound", capacity)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := sm.Acquire(context.TODO(), 1); err != nil {
+				t.Error(err)
+				return
+			}
+			n := current.Add(1)
+			for h := highest.Load(); n > h && !highest.CompareAndSwap(h, n); h = highest.Load() {
+			}
+			time.Sleep(20 * time.Millisecond)
+			current.Add(-1)
+			if err := sm.Release(context.TODO()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	require.LessOrEqual(t, highest.Load(), int64(capacity))
+	require.Positive(t, highest.Load())
+}
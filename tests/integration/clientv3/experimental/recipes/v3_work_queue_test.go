@@ -0,0 +1,164 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipes_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	recipe "go.etcd.io/etcd/client/v3/experimental/recipes"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestWorkQueueAck confirms the work queue is FIFO and deletes the
+// acknowledged items.
+func TestWorkQueueAck(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	cli := clus.RandClient()
+	q := recipe.NewWorkQueue(cli, "testwq", time.Minute, 0)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, q.Enqueue(fmt.Sprint(i)))
+	}
+	for i := 0; i < 5; i++ {
+		item, err := q.Dequeue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprint(i), item.Value)
+		require.Equal(t, 1, item.Attempts)
+		require.NoError(t, item.Ack(ctx))
+	}
+	resp, err := cli.Get(ctx, "testwq/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	require.Zero(t, resp.Count)
+}
+
+// TestWorkQueueClaimedBatches confirms the items are dequeued in order while
+// the earlier items, over several batches, stay claimed.
+func TestWorkQueueClaimedBatches(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	q := recipe.NewWorkQueue(clus.RandClient(), "testwq", time.Minute, 0)
+	for i := 0; i < 150; i++ {
+		require.NoError(t, q.Enqueue(fmt.Sprint(i)))
+	}
+	for i := 0; i < 150; i++ {
+		item, err := q.Dequeue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprint(i), item.Value)
+	}
+}
+
+// TestWorkQueueNack confirms a negatively acknowledged item is redelivered,
+// until it is moved to the dead-letter prefix after the maximum attempts.
+func TestWorkQueueNack(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	cli := clus.RandClient()
+	q := recipe.NewWorkQueue(cli, "testwq", time.Minute, 2)
+	require.NoError(t, q.Enqueue("a"))
+	require.NoError(t, q.Enqueue("b"))
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		item, err := q.Dequeue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "a", item.Value)
+		require.Equal(t, attempt, item.Attempts)
+		require.NoError(t, item.Nack(ctx))
+	}
+
+	// "a" was delivered the maximum attempts, so it is dead-lettered.
+	item, err := q.Dequeue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "b", item.Value)
+	require.NoError(t, item.Ack(ctx))
+
+	resp, err := cli.Get(ctx, q.DeadLetterPrefix(), clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	require.Equal(t, "a", string(resp.Kvs[0].Value))
+
+	cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = q.Dequeue(cctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestWorkQueueVisibilityTimeout confirms an item not acknowledged within the
+// visibility timeout is redelivered, and can no longer be acknowledged by its
+// first consumer.
+func TestWorkQueueVisibilityTimeout(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	q := recipe.NewWorkQueue(clus.RandClient(), "testwq", time.Second, 0)
+	require.NoError(t, q.Enqueue("a"))
+
+	item, err := q.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, item.Extend(ctx))
+
+	// blocks until the claim of the first consumer expires.
+	redelivered, err := q.Dequeue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", redelivered.Value)
+	require.Equal(t, 2, redelivered.Attempts)
+
+	require.ErrorIs(t, item.Ack(ctx), recipe.ErrClaimLost)
+	require.ErrorIs(t, item.Extend(ctx), recipe.ErrClaimLost)
+	require.NoError(t, redelivered.Ack(ctx))
+}
+
+// TestWorkQueueBlockingDequeue confirms Dequeue waits for items to be enqueued.
+func TestWorkQueueBlockingDequeue(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	q := recipe.NewWorkQueue(clus.RandClient(), "testwq", time.Minute, 0)
+	itemc := make(chan *recipe.WorkItem, 1)
+	go func() {
+		item, err := q.Dequeue(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		itemc <- item
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, q.Enqueue("a"))
+	select {
+	case item := <-itemc:
+		require.Equal(t, "a", item.Value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Dequeue did not return the enqueued item")
+	}
+}
@@ -173,6 +173,79 @@ func TestKVPutWithIgnoreLease(t *testing.T) {
 	}
 }
 
+// TestKVPutWithTTL ensures keys put with a ttl are deleted once the ttl
+// elapses, and that a put without ttl makes the key persistent again.
+func TestKVPutWithTTL(t *testing.T) {
+	integration2.BeforeTest(t, integration2.WithServerVersion("3.7.0"))
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	_, err := kv.Put(ctx, "foo", "bar", clientv3.WithTTL(-1))
+	require.ErrorIs(t, err, rpctypes.ErrInvalidKeyTTL)
+	_, err = kv.Put(ctx, "foo", "bar", clientv3.WithTTL(1), clientv3.WithLease(clientv3.LeaseID(1)))
+	require.ErrorIs(t, err, rpctypes.ErrKeyTTLWithLease)
+
+	wch := kv.Watch(ctx, "foo")
+	presp, err := kv.Put(ctx, "foo", "bar", clientv3.WithTTL(1))
+	require.NoError(t, err)
+	_, err = kv.Put(ctx, "persistent", "bar", clientv3.WithTTL(1))
+	require.NoError(t, err)
+	_, err = kv.Put(ctx, "persistent", "bar")
+	require.NoError(t, err)
+
+	rr, err := kv.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Len(t, rr.Kvs, 1)
+	require.Equal(t, int64(1), rr.Kvs[0].TTL)
+
+	var events []*clientv3.Event
+	timeout := time.After(10 * time.Second)
+	for len(events) < 2 {
+		select {
+		case wresp := <-wch:
+			events = append(events, wresp.Events...)
+		case <-timeout:
+			t.Fatalf("expected the key to expire, got events %v", events)
+		}
+	}
+	require.Equal(t, clientv3.EventTypePut, events[0].Type)
+	require.Equal(t, clientv3.EventTypeDelete, events[1].Type)
+	require.Greater(t, events[1].Kv.ModRevision, presp.Header.Revision)
+
+	rr, err = kv.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Empty(t, rr.Kvs)
+	rr, err = kv.Get(ctx, "persistent")
+	require.NoError(t, err)
+	require.Len(t, rr.Kvs, 1)
+	require.Zero(t, rr.Kvs[0].TTL)
+}
+
+// TestKVPutWithTTLClusterVersionTooLow ensures puts with a ttl are rejected
+// until all members are v3.7, as older members drop the ttl.
+func TestKVPutWithTTLClusterVersionTooLow(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	_, err := kv.Put(ctx, "foo", "bar", clientv3.WithTTL(1))
+	require.ErrorIs(t, err, rpctypes.ErrClusterVersionTooLow)
+	_, err = kv.Txn(ctx).Then(clientv3.OpTxn(nil, []clientv3.Op{clientv3.OpPut("foo", "bar", clientv3.WithTTL(1))}, nil)).Commit()
+	require.ErrorIs(t, err, rpctypes.ErrClusterVersionTooLow)
+
+	rr, err := kv.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Empty(t, rr.Kvs)
+}
+
 func TestKVPutWithRequireLeader(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -368,8 +441,8 @@ func TestKVDeleteRange(t *testing.T) {
 			t.Fatalf("#%d: couldn't get keys (%v)", i, err)
 		}
 		var keys []string
-		for _, kv := range resp.Kvs {
-			keys = append(keys, string(kv.Key))
+		for _, kvp := range resp.Kvs {
+			keys = append(keys, string(kvp.Key))
 		}
 		if !reflect.DeepEqual(tt.wkeys, keys) {
 			t.Errorf("#%d: resp.Kvs got %v, expected %v", i, keys, tt.wkeys)
@@ -377,6 +450,58 @@ func TestKVDeleteRange(t *testing.T) {
 	}
 }
 
+func TestKVRangeWithContinueToken(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	wkeys := []string{"a", "b", "c", "d", "e"}
+	for _, k := range wkeys {
+		_, err := kv.Put(ctx, k, "v")
+		require.NoError(t, err)
+	}
+
+	resp, err := kv.Get(ctx, "a", clientv3.WithRange("z"), clientv3.WithLimit(2))
+	require.NoError(t, err)
+	require.True(t, resp.More)
+	require.NotEmpty(t, resp.ContinueToken)
+	rev := resp.Header.Revision
+	firstToken := resp.ContinueToken
+
+	// keys written after the first page are not visible to the next pages
+	_, err = kv.Put(ctx, "c0", "v")
+	require.NoError(t, err)
+
+	var keys []string
+	for _, kvp := range resp.Kvs {
+		keys = append(keys, string(kvp.Key))
+	}
+	for resp.More {
+		resp, err = kv.Get(ctx, "a", clientv3.WithRange("z"), clientv3.WithLimit(2), clientv3.WithContinueToken(resp.ContinueToken))
+		require.NoError(t, err)
+		for _, kvp := range resp.Kvs {
+			keys = append(keys, string(kvp.Key))
+		}
+	}
+	require.Empty(t, resp.ContinueToken)
+	require.Equal(t, wkeys, keys)
+
+	// the token only resumes the range it was returned for
+	_, err = kv.Get(ctx, "x", clientv3.WithRange("z"), clientv3.WithLimit(2), clientv3.WithContinueToken(firstToken))
+	require.ErrorIs(t, err, rpctypes.ErrInvalidContinueToken)
+	_, err = kv.Get(ctx, "a", clientv3.WithRange("z"), clientv3.WithLimit(2), clientv3.WithContinueToken([]byte("foo")))
+	require.ErrorIs(t, err, rpctypes.ErrInvalidContinueToken)
+
+	_, err = kv.Compact(ctx, rev+1)
+	require.NoError(t, err)
+	_, err = kv.Get(ctx, "a", clientv3.WithRange("z"), clientv3.WithLimit(2), clientv3.WithContinueToken(firstToken))
+	require.ErrorIs(t, err, rpctypes.ErrContinueTokenCompacted)
+}
+
 func TestKVCompactError(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -24,6 +24,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -448,3 +450,36 @@ func TestMaintenanceStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMaintenanceReloadConfig(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ep := clus.Members[0].GRPCURL
+	_, err := cli.ReloadConfig(context.TODO(), ep)
+	require.ErrorIs(t, err, rpctypes.ErrConfigReloadNotSupported)
+
+	srv := clus.Members[0].Server
+	srv.SetConfigReloader(func() (applied, restartRequired []string, err error) {
+		rc := etcdserver.RuntimeConfig{
+			MaxRequestBytes:         1024,
+			WarningApplyDuration:    srv.WarningApplyDuration(),
+			QuotaBackendBytes:       srv.QuotaBackendBytes(),
+			AutoCompactionMode:      srv.Cfg.AutoCompactionMode,
+			AutoCompactionRetention: srv.Cfg.AutoCompactionRetention,
+		}
+		return []string{"max-request-bytes"}, []string{"snapshot-count"}, srv.UpdateRuntimeConfig(rc)
+	})
+	resp, err := cli.ReloadConfig(context.TODO(), ep)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"max-request-bytes"}, resp.AppliedFields)
+	assert.Equal(t, []string{"snapshot-count"}, resp.RestartRequiredFields)
+
+	_, err = cli.Put(context.TODO(), "foo", strings.Repeat("a", 2048))
+	require.ErrorIs(t, err, rpctypes.ErrRequestTooLarge)
+	_, err = cli.Put(context.TODO(), "foo", "bar")
+	require.NoError(t, err)
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/mirror"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+const mirrorCheckpointKey = "mirror-checkpoint"
+
+// startBidirectionalMirror runs a bidirectional mirror until the returned
+// function is called, after both clusters are checkpointed.
+func startBidirectionalMirror(t *testing.T, src, dst *clientv3.Client, cfg mirror.BidirectionalConfig) (stop func()) {
+	cfg.CheckpointKey = mirrorCheckpointKey
+	ctx, cancel := context.WithCancel(context.Background())
+	donec := make(chan error, 1)
+	go func() { donec <- mirror.NewBidirectional(src, dst, cfg).Run(ctx) }()
+	for _, c := range []*clientv3.Client{src, dst} {
+		require.Eventually(t, func() bool {
+			resp, err := c.Get(context.TODO(), mirrorCheckpointKey)
+			return err == nil && len(resp.Kvs) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	return func() {
+		cancel()
+		require.ErrorIs(t, <-donec, context.Canceled)
+	}
+}
+
+func requireMirrored(t *testing.T, c *clientv3.Client, key, val string) {
+	require.Eventually(t, func() bool {
+		resp, err := c.Get(context.TODO(), key)
+		if err != nil {
+			return false
+		}
+		if val == "" {
+			return len(resp.Kvs) == 0
+		}
+		return len(resp.Kvs) == 1 && string(resp.Kvs[0].Value) == val
+	}, 5*time.Second, 10*time.Millisecond, "key %q not mirrored", key)
+}
+
+func TestBidirectionalMirror(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+	defer clus.Terminate(t)
+	dclus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+	defer dclus.Terminate(t)
+	src, dst := clus.Client(0), dclus.Client(0)
+	ctx := context.TODO()
+
+	_, err := src.Put(ctx, "o_initial", "v")
+	require.NoError(t, err)
+	stop := startBidirectionalMirror(t, src, dst, mirror.BidirectionalConfig{Prefix: "o_", DestPrefix: "d_"})
+	defer stop()
+	requireMirrored(t, dst, "d_initial", "v")
+
+	_, err = src.Put(ctx, "o_a", "1")
+	require.NoError(t, err)
+	requireMirrored(t, dst, "d_a", "1")
+	_, err = dst.Put(ctx, "d_b", "2")
+	require.NoError(t, err)
+	requireMirrored(t, src, "o_b", "2")
+	_, err = dst.Delete(ctx, "d_a")
+	require.NoError(t, err)
+	requireMirrored(t, src, "o_a", "")
+	_, err = dst.Put(ctx, "other", "x")
+	require.NoError(t, err)
+
+	// the mirrored writes are not mirrored back.
+	rev := func(c *clientv3.Client) int64 {
+		resp, err := c.Get(ctx, "o_b")
+		require.NoError(t, err)
+		return resp.Header.Revision
+	}
+	srcRev, dstRev := rev(src), rev(dst)
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, srcRev, rev(src))
+	require.Equal(t, dstRev, rev(dst))
+	requireMirrored(t, src, "other", "")
+}
+
+// TestBidirectionalMirrorResume ensures a restarted mirror mirrors the updates
+// made while it was stopped, without copying the source cluster again.
+func TestBidirectionalMirrorResume(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+	defer clus.Terminate(t)
+	dclus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+	defer dclus.Terminate(t)
+	src, dst := clus.Client(0), dclus.Client(0)
+	ctx := context.TODO()
+
+	startBidirectionalMirror(t, src, dst, mirror.BidirectionalConfig{Prefix: "k", DestPrefix: "k"})()
+	_, err := src.Put(ctx, "k1", "v1")
+	require.NoError(t, err)
+	_, err = dst.Put(ctx, "k2", "v2")
+	require.NoError(t, err)
+
+	stop := startBidirectionalMirror(t, src, dst, mirror.BidirectionalConfig{Prefix: "k", DestPrefix: "k"})
+	defer stop()
+	requireMirrored(t, dst, "k1", "v1")
+	requireMirrored(t, src, "k2", "v2")
+}
+
+func TestBidirectionalMirrorConflict(t *testing.T) {
+	tests := []struct {
+		policy mirror.ConflictPolicy
+	}{
+		{policy: mirror.LastWriterWins},
+		{policy: mirror.SkipAndReport},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			integration2.BeforeTest(t)
+			clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+			defer clus.Terminate(t)
+			dclus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, UseTCP: true})
+			defer dclus.Terminate(t)
+			src, dst := clus.Client(0), dclus.Client(0)
+			ctx := context.TODO()
+
+			var (
+				mu        sync.Mutex
+				conflicts []mirror.Conflict
+			)
+			cfg := mirror.BidirectionalConfig{
+				Prefix:         "k",
+				DestPrefix:     "k",
+				ConflictPolicy: tt.policy,
+				OnConflict: func(c mirror.Conflict) {
+					mu.Lock()
+					defer mu.Unlock()
+					conflicts = append(conflicts, c)
+				},
+			}
+
+			// update the key in both clusters while the mirror is stopped.
+			startBidirectionalMirror(t, src, dst, cfg)()
+			_, err := src.Put(ctx, "key", "src")
+			require.NoError(t, err)
+			_, err = dst.Put(ctx, "key", "dst")
+			require.NoError(t, err)
+
+			stop := startBidirectionalMirror(t, src, dst, cfg)
+			defer stop()
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(conflicts) > 0
+			}, 5*time.Second, 10*time.Millisecond)
+
+			get := func(c *clientv3.Client) string {
+				resp, err := c.Get(ctx, "key")
+				require.NoError(t, err)
+				return string(resp.Kvs[0].Value)
+			}
+			if tt.policy == mirror.LastWriterWins {
+				require.Eventually(t, func() bool { return get(src) == get(dst) }, 5*time.Second, 10*time.Millisecond)
+			} else {
+				time.Sleep(200 * time.Millisecond)
+				require.Equal(t, "src", get(src))
+				require.Equal(t, "dst", get(dst))
+			}
+			mu.Lock()
+			require.Len(t, conflicts, 1)
+			mu.Unlock()
+
+			// the key is mirrored again once updated after the conflict.
+			_, err = src.Put(ctx, "key", "new")
+			require.NoError(t, err)
+			requireMirrored(t, dst, "key", "new")
+		})
+	}
+}
@@ -908,6 +908,117 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestWatchWithValueFilter checks that PUT events are filtered by their value
+// on the server, while DELETE events are always sent.
+func TestWatchWithValueFilter(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	cluster := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	wc := client.Watch(ctx, "pods/", clientv3.WithPrefix(), clientv3.WithValueJSONField("spec.nodeName", []byte(`"node-1"`)))
+
+	_, err := client.Put(ctx, "pods/a", `{"spec":{"nodeName":"node-2"}}`)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "pods/b", `{"spec":{"nodeName":"node-1"}}`)
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, "pods/a")
+	require.NoError(t, err)
+
+	var events []*clientv3.Event
+	for len(events) < 2 {
+		resp := <-wc
+		require.NoError(t, resp.Err())
+		events = append(events, resp.Events...)
+	}
+	require.Len(t, events, 2)
+	require.Equal(t, clientv3.EventTypePut, events[0].Type)
+	require.Equal(t, "pods/b", string(events[0].Kv.Key))
+	require.Equal(t, clientv3.EventTypeDelete, events[1].Type)
+	require.Equal(t, "pods/a", string(events[1].Kv.Key))
+
+	// malformed value filters cancel the watch
+	wc = client.Watch(ctx, "pods/", clientv3.WithPrefix(), clientv3.WithValueJSONField("", []byte(`"node-1"`)))
+	resp := <-wc
+	require.True(t, resp.Canceled)
+	require.Error(t, resp.Err())
+}
+
+// TestWatchWithCoalesce checks that the events held by the server are
+// coalesced into the latest event of each key, and that the held events are
+// sent before progress notifications. The grpc-proxy rejects coalescing.
+func TestWatchWithCoalesce(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	cluster := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	wc := client.Watch(ctx, "foo", clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithCoalesce(time.Hour), clientv3.WithCoalesceRevisions(4))
+	if integration2.ThroughProxy {
+		resp := <-wc
+		require.True(t, resp.Canceled)
+		require.ErrorIs(t, resp.Err(), rpctypes.ErrWatchCoalesceNotSupported)
+		return
+	}
+	_, err := client.Put(ctx, "foo1", "a")
+	require.NoError(t, err)
+	for _, v := range []string{"b", "c", "d"} {
+		_, err = client.Put(ctx, "foo2", v)
+		require.NoError(t, err)
+	}
+
+	resp := <-wc
+	require.NoError(t, resp.Err())
+	require.True(t, resp.Coalesced)
+	require.Len(t, resp.Events, 2)
+	require.Equal(t, "foo1", string(resp.Events[0].Kv.Key))
+	require.Equal(t, "foo2", string(resp.Events[1].Kv.Key))
+	require.Equal(t, "d", string(resp.Events[1].Kv.Value))
+	require.Nil(t, resp.Events[1].PrevKv)
+
+	// held events are sent before a progress notification.
+	_, err = client.Put(ctx, "foo1", "b")
+	require.NoError(t, err)
+	presp, err := client.Put(ctx, "foo1", "c")
+	require.NoError(t, err)
+	require.NoError(t, client.RequestProgress(ctx))
+	resp = <-wc
+	require.True(t, resp.Coalesced)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, "c", string(resp.Events[0].Kv.Value))
+	require.Equal(t, "a", string(resp.Events[0].PrevKv.Value))
+	resp = <-wc
+	require.True(t, resp.IsProgressNotify())
+	require.GreaterOrEqual(t, resp.Header.Revision, presp.Header.Revision)
+
+	// held events are sent at the end of the window.
+	wc = client.Watch(ctx, "bar", clientv3.WithCoalesce(100*time.Millisecond))
+	_, err = client.Put(ctx, "bar", "a")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "bar", "b")
+	require.NoError(t, err)
+	select {
+	case resp = <-wc:
+		require.True(t, resp.Coalesced)
+		require.Len(t, resp.Events, 1)
+		require.Equal(t, "b", string(resp.Events[0].Kv.Value))
+	case <-time.After(5 * time.Second):
+		t.Fatal("coalesced events were not sent at the end of the window")
+	}
+
+	// invalid coalescing options cancel the watch
+	wc = client.Watch(ctx, "foo", clientv3.WithCoalesceRevisions(4))
+	resp = <-wc
+	require.True(t, resp.Canceled)
+	require.Error(t, resp.Err())
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
@@ -29,11 +29,16 @@ import (
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
 
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	clientsnapshot "go.etcd.io/etcd/client/v3/snapshot"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cpuutil"
 	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/walarchive"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 	"go.etcd.io/etcd/tests/v3/framework/testutils"
 )
@@ -130,6 +135,311 @@ func TestSnapshotV3RestoreMulti(t *testing.T) {
 	}
 }
 
+// TestSnapshotV3RestoreDelta tests restoring a single node cluster from a
+// snapshot file and a delta of the revisions written after the snapshot.
+func TestSnapshotV3RestoreDelta(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ccfg := clientv3.Config{Endpoints: []string{clus.Members[0].GRPCURL}}
+	cli := clus.Client(0)
+	ctx := context.Background()
+	for _, k := range []string{"foo1", "foo2", "foo3"} {
+		_, err := cli.Put(ctx, k, "bar")
+		require.NoError(t, err)
+	}
+
+	dbPath := filepath.Join(t.TempDir(), "snapshot.db")
+	_, err := snapshot.NewV3(zaptest.NewLogger(t)).Save(ctx, ccfg, dbPath)
+	require.NoError(t, err)
+	status, err := snapshot.NewV3(zaptest.NewLogger(t)).Status(dbPath)
+	require.NoError(t, err)
+
+	_, err = cli.Put(ctx, "foo1", "baz")
+	require.NoError(t, err)
+	_, err = cli.Delete(ctx, "foo2")
+	require.NoError(t, err)
+	presp, err := cli.Put(ctx, "foo4", "bar")
+	require.NoError(t, err)
+
+	deltaPath := filepath.Join(t.TempDir(), "delta")
+	_, err = clientsnapshot.SaveDelta(ctx, zaptest.NewLogger(t), ccfg, deltaPath, status.Revision)
+	require.NoError(t, err)
+
+	clusterN := 1
+	urls := newEmbedURLs(t, clusterN*2)
+	cURLs, pURLs := urls[:clusterN], urls[clusterN:]
+
+	cfg := integration2.NewEmbedConfig(t, "s1")
+	cfg.InitialClusterToken = testClusterTkn
+	cfg.ClusterState = "existing"
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = cURLs, cURLs
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = pURLs, pURLs
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, pURLs[0].String())
+
+	err = snapshot.NewV3(zaptest.NewLogger(t)).Restore(snapshot.RestoreConfig{
+		SnapshotPath:        dbPath,
+		DeltaPaths:          []string{deltaPath},
+		Name:                cfg.Name,
+		OutputDataDir:       cfg.Dir,
+		InitialCluster:      cfg.InitialCluster,
+		InitialClusterToken: cfg.InitialClusterToken,
+		PeerURLs:            []string{pURLs[0].String()},
+	})
+	require.NoError(t, err)
+
+	srv, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start restored etcd member")
+	}
+
+	rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{cfg.AdvertiseClientUrls[0].String()}})
+	require.NoError(t, err)
+	defer rcli.Close()
+	gresp, err := rcli.Get(ctx, "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, gresp.Header.Revision, presp.Header.Revision)
+	got := make(map[string]string)
+	for _, kv := range gresp.Kvs {
+		got[string(kv.Key)] = string(kv.Value)
+	}
+	require.Equal(t, map[string]string{"foo1": "baz", "foo3": "bar", "foo4": "bar"}, got)
+}
+
+// TestSnapshotV3RestoreWALReplay tests restoring a single node cluster from a
+// snapshot file and the WAL replayed up to a revision or a point in time.
+func TestSnapshotV3RestoreWALReplay(t *testing.T) {
+	integration2.BeforeTest(t)
+	urls := newEmbedURLs(t, 2)
+	cURLs, pURLs := urls[:1], urls[1:]
+
+	cfg := integration2.NewEmbedConfig(t, "default")
+	cfg.ClusterState = "new"
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = cURLs, cURLs
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = pURLs, pURLs
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, pURLs[0].String())
+	srv, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	// the server is closed to read the WAL
+	srvClosed := false
+	defer func() {
+		if !srvClosed {
+			srv.Close()
+		}
+	}()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{cfg.AdvertiseClientUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	require.NoError(t, err)
+	defer cli.Close()
+	ctx := context.Background()
+	_, err = cli.Put(ctx, "foo1", "bar")
+	require.NoError(t, err)
+
+	dbPath := filepath.Join(t.TempDir(), "snapshot.db")
+	_, err = snapshot.NewV3(zaptest.NewLogger(t)).Save(ctx, ccfg, dbPath)
+	require.NoError(t, err)
+
+	_, err = cli.Put(ctx, "foo2", "bar")
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	beforeDelete := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	dresp, err := cli.Delete(ctx, "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), dresp.Deleted)
+	_, err = cli.Put(ctx, "foo3", "bar")
+	require.NoError(t, err)
+	// proposals record their time once the cluster version is v3.7.
+	timestamped := !srv.Server.ClusterVersion().LessThan(version.V3_7)
+	srv.Close()
+	srvClosed = true
+
+	walArchiveDir := filepath.Join(cfg.Dir, "member", "wal")
+	tcs := []struct {
+		name       string
+		toRevision int64
+		toTime     time.Time
+		wantKeys   []string
+	}{
+		{name: "to revision", toRevision: dresp.Header.Revision - 1, wantKeys: []string{"foo1", "foo2"}},
+		{name: "to time", toTime: beforeDelete, wantKeys: []string{"foo1", "foo2"}},
+		{name: "to end", wantKeys: []string{"foo3"}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.toTime.IsZero() && !timestamped {
+				t.Skip("proposals are not timestamped below cluster version 3.7")
+			}
+			urls := newEmbedURLs(t, 2)
+			cURLs, pURLs := urls[:1], urls[1:]
+			rcfg := integration2.NewEmbedConfig(t, "s1")
+			rcfg.InitialClusterToken = testClusterTkn
+			rcfg.ClusterState = "existing"
+			rcfg.ListenClientUrls, rcfg.AdvertiseClientUrls = cURLs, cURLs
+			rcfg.ListenPeerUrls, rcfg.AdvertisePeerUrls = pURLs, pURLs
+			rcfg.InitialCluster = fmt.Sprintf("%s=%s", rcfg.Name, pURLs[0].String())
+
+			err := snapshot.NewV3(zaptest.NewLogger(t)).Restore(snapshot.RestoreConfig{
+				SnapshotPath:        dbPath,
+				WALArchiveDir:       walArchiveDir,
+				ToRevision:          tc.toRevision,
+				ToTime:              tc.toTime,
+				Name:                rcfg.Name,
+				OutputDataDir:       rcfg.Dir,
+				InitialCluster:      rcfg.InitialCluster,
+				InitialClusterToken: rcfg.InitialClusterToken,
+				PeerURLs:            []string{pURLs[0].String()},
+			})
+			require.NoError(t, err)
+
+			rsrv, err := embed.StartEtcd(rcfg)
+			require.NoError(t, err)
+			defer rsrv.Close()
+			select {
+			case <-rsrv.Server.ReadyNotify():
+			case <-time.After(3 * time.Second):
+				t.Fatalf("failed to start restored etcd member")
+			}
+
+			rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{rcfg.AdvertiseClientUrls[0].String()}})
+			require.NoError(t, err)
+			defer rcli.Close()
+			gresp, err := rcli.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+			require.NoError(t, err)
+			var keys []string
+			for _, kv := range gresp.Kvs {
+				keys = append(keys, string(kv.Key))
+			}
+			require.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
+
+// TestSnapshotV3RestoreWALArchive tests restoring a snapshot with the WAL
+// segments archived by the member the snapshot was saved from.
+func TestSnapshotV3RestoreWALArchive(t *testing.T) {
+	integration2.BeforeTest(t)
+	// cut a WAL segment every few entries
+	defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+	wal.SegmentSizeBytes = 4 * 1024
+
+	urls := newEmbedURLs(t, 2)
+	cURLs, pURLs := urls[:1], urls[1:]
+
+	archiveDir := t.TempDir()
+	cfg := integration2.NewEmbedConfig(t, "default")
+	cfg.ClusterState = "new"
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = cURLs, cURLs
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = pURLs, pURLs
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, pURLs[0].String())
+	cfg.WALArchiveURL = archiveDir
+	srv, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{cfg.AdvertiseClientUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	require.NoError(t, err)
+	defer cli.Close()
+	ctx := context.Background()
+	_, err = cli.Put(ctx, "foo0", "bar")
+	require.NoError(t, err)
+
+	dbPath := filepath.Join(t.TempDir(), "snapshot.db")
+	_, err = snapshot.NewV3(zaptest.NewLogger(t)).Save(ctx, ccfg, dbPath)
+	require.NoError(t, err)
+
+	value := strings.Repeat("v", 1024)
+	var lastRev int64
+	for i := 1; i <= 10; i++ {
+		presp, perr := cli.Put(ctx, fmt.Sprintf("foo%d", i), value)
+		require.NoError(t, perr)
+		lastRev = presp.Header.Revision
+	}
+	// finish the segment holding the last put
+	_, err = cli.Put(ctx, "bar", strings.Repeat("v", int(wal.SegmentSizeBytes)))
+	require.NoError(t, err)
+
+	walDir := filepath.Join(cfg.Dir, "member", "wal")
+	sink := walarchive.NewLocalSink(archiveDir)
+	require.Eventually(t, func() bool {
+		m, merr := walarchive.ReadManifest(ctx, sink)
+		if merr != nil {
+			return false
+		}
+		archived := make(map[string]bool)
+		for _, f := range m.Files {
+			archived[f.Name] = true
+		}
+		names, derr := fileutil.ReadDir(walDir, fileutil.WithExt(".wal"))
+		if derr != nil || len(names) < 2 {
+			return false
+		}
+		for _, name := range names[:len(names)-1] {
+			if !archived[walarchive.WALDir+"/"+name] {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	urls = newEmbedURLs(t, 2)
+	cURLs, pURLs = urls[:1], urls[1:]
+	rcfg := integration2.NewEmbedConfig(t, "s1")
+	rcfg.InitialClusterToken = testClusterTkn
+	rcfg.ClusterState = "existing"
+	rcfg.ListenClientUrls, rcfg.AdvertiseClientUrls = cURLs, cURLs
+	rcfg.ListenPeerUrls, rcfg.AdvertisePeerUrls = pURLs, pURLs
+	rcfg.InitialCluster = fmt.Sprintf("%s=%s", rcfg.Name, pURLs[0].String())
+
+	err = snapshot.NewV3(zaptest.NewLogger(t)).Restore(snapshot.RestoreConfig{
+		SnapshotPath:        dbPath,
+		WALArchiveDir:       archiveDir,
+		ToRevision:          lastRev,
+		Name:                rcfg.Name,
+		OutputDataDir:       rcfg.Dir,
+		InitialCluster:      rcfg.InitialCluster,
+		InitialClusterToken: rcfg.InitialClusterToken,
+		PeerURLs:            []string{pURLs[0].String()},
+	})
+	require.NoError(t, err)
+
+	rsrv, err := embed.StartEtcd(rcfg)
+	require.NoError(t, err)
+	defer rsrv.Close()
+	select {
+	case <-rsrv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start restored etcd member")
+	}
+
+	rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{rcfg.AdvertiseClientUrls[0].String()}})
+	require.NoError(t, err)
+	defer rcli.Close()
+	gresp, err := rcli.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	require.Equal(t, int64(11), gresp.Count)
+	gresp, err = rcli.Get(ctx, "bar")
+	require.NoError(t, err)
+	require.Empty(t, gresp.Kvs)
+}
+
 // TestCorruptedBackupFileCheck tests if we can correctly identify a corrupted backup file.
 func TestCorruptedBackupFileCheck(t *testing.T) {
 	if cpuutil.ByteOrder() == binary.BigEndian {
@@ -71,6 +71,16 @@ func TestEtcdVersionFromWAL(t *testing.T) {
 		t.Fatalf("failed to get auth status: %v", err)
 	}
 
+	// Proposals only record their time once the cluster version is v3.7, so
+	// they do not raise the WAL version above the cluster version.
+	ctx, cancel = context.WithTimeout(context.Background(), testutil.RequestTimeout)
+	_, err = cli.Put(ctx, "foo", "bar")
+	cancel()
+	if err != nil {
+		srv.Close()
+		t.Fatalf("failed to put: %v", err)
+	}
+
 	cli.Close()
 	srv.Close()
 
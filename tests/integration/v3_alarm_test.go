@@ -130,6 +130,45 @@ func TestV3StorageQuotaApply(t *testing.T) {
 }
 
 // TestV3AlarmDeactivate ensures that space alarms can be deactivated so puts go through.
+// TestV3PrefixQuota ensures puts exceeding the quota of their prefix are
+// rejected.
+func TestV3PrefixQuota(t *testing.T) {
+	integration.BeforeTest(t, integration.WithServerVersion("3.7.0"))
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+	_, err := cli.PrefixQuotaPut(ctx, "tenant/", 0, 1)
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "tenant/a", "v")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "tenant/b", "v")
+	require.ErrorIs(t, err, rpctypes.ErrPrefixQuotaExceeded)
+	_, err = cli.Put(ctx, "other", "v")
+	require.NoError(t, err)
+
+	resp, err := cli.PrefixQuotaList(ctx)
+	require.NoError(t, err)
+	require.Len(t, resp.Quotas, 1)
+	require.Equal(t, []byte("tenant/"), resp.Quotas[0].Prefix)
+}
+
+// TestV3PrefixQuotaClusterVersionTooLow ensures prefix quotas are rejected
+// until all members are v3.7, as older members can not apply them.
+func TestV3PrefixQuotaClusterVersionTooLow(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+	_, err := cli.PrefixQuotaPut(ctx, "tenant/", 0, 1)
+	require.ErrorIs(t, err, rpctypes.ErrClusterVersionTooLow)
+	_, err = cli.PrefixQuotaList(ctx)
+	require.ErrorIs(t, err, rpctypes.ErrClusterVersionTooLow)
+}
+
 func TestV3AlarmDeactivate(t *testing.T) {
 	integration.BeforeTest(t)
 
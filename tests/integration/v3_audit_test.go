@@ -0,0 +1,121 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/audit"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+type recordingAuditWriter struct {
+	mu     sync.Mutex
+	events []audit.Event
+}
+
+func (w *recordingAuditWriter) Write(ev *audit.Event) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.events = append(w.events, *ev)
+	return nil
+}
+
+func (w *recordingAuditWriter) find(method, user string) []audit.Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var evs []audit.Event
+	for _, ev := range w.events {
+		if ev.Method == method && ev.User == user {
+			evs = append(evs, ev)
+		}
+	}
+	return evs
+}
+
+// TestV3Audit ensures the mutating and auth requests are audited with their
+// user, keys, outcome and revision, following the per-prefix policy.
+func TestV3Audit(t *testing.T) {
+	integration.BeforeTest(t)
+
+	policy, err := audit.ParsePolicy("metadata", "/app/config/=request,/app/cache/=none")
+	require.NoError(t, err)
+	w := &recordingAuditWriter{}
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1, Auditor: audit.New(zaptest.NewLogger(t), policy, w)})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	for _, req := range []func() error{
+		func() error { _, err := cli.RoleAdd(ctx, "app"); return err },
+		func() error {
+			_, err := cli.RoleGrantPermission(ctx, "app", "/app/", "/app0", clientv3.PermissionType(clientv3.PermReadWrite))
+			return err
+		},
+		func() error { _, err := cli.UserAdd(ctx, "alice", "alice-123"); return err },
+		func() error { _, err := cli.UserGrantRole(ctx, "alice", "app"); return err },
+		func() error { _, err := cli.UserAdd(ctx, "root", "123"); return err },
+		func() error { _, err := cli.UserGrantRole(ctx, "root", "root"); return err },
+		func() error { _, err := cli.AuthEnable(ctx); return err },
+	} {
+		require.NoError(t, req())
+	}
+
+	alicec, err := integration.NewClient(t, clientv3.Config{Endpoints: cli.Endpoints(), Username: "alice", Password: "alice-123"})
+	require.NoError(t, err)
+	defer alicec.Close()
+
+	presp, err := alicec.Put(ctx, "/app/k", "v")
+	require.NoError(t, err)
+	_, err = alicec.Put(ctx, "/app/config/k", "secret-value")
+	require.NoError(t, err)
+	_, err = alicec.Put(ctx, "/app/cache/k", "v")
+	require.NoError(t, err)
+	_, err = alicec.Put(ctx, "/other", "v")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	_, err = alicec.Get(ctx, "/app/k")
+	require.NoError(t, err)
+
+	puts := w.find("/etcdserverpb.KV/Put", "alice")
+	require.Len(t, puts, 3)
+	assert.Equal(t, "/app/k", puts[0].Key)
+	assert.Equal(t, audit.OutcomeSuccess, puts[0].Outcome)
+	assert.Equal(t, presp.Header.Revision, puts[0].Revision)
+	assert.Nil(t, puts[0].Value)
+	assert.NotEmpty(t, puts[0].RemoteAddr)
+
+	assert.Equal(t, "/app/config/k", puts[1].Key)
+	assert.Equal(t, audit.LevelRequest, puts[1].Level)
+	assert.Equal(t, []byte("secret-value"), puts[1].Value)
+
+	assert.Equal(t, "/other", puts[2].Key)
+	assert.Equal(t, audit.OutcomeFailure, puts[2].Outcome)
+	assert.Equal(t, rpctypes.ErrGRPCPermissionDenied.Error(), puts[2].Error)
+
+	assert.Empty(t, w.find("/etcdserverpb.KV/Range", "alice"))
+	assert.Len(t, w.find("/etcdserverpb.Auth/Authenticate", "alice"), 1)
+	grants := w.find("/etcdserverpb.Auth/UserGrantRole", "")
+	require.Len(t, grants, 2)
+	assert.Equal(t, "alice", grants[0].TargetUser)
+	assert.Equal(t, "app", grants[0].TargetRole)
+}
@@ -16,13 +16,20 @@ package integration
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
 	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -286,6 +293,157 @@ func TestV3AuthWithLeaseAttach(t *testing.T) {
 	}
 }
 
+// TestV3AuthDelegatedAdmin ensures a user with an ADMIN permission can set up
+// the access of other users within the key range of the permission.
+func TestV3AuthDelegatedAdmin(t *testing.T) {
+	integration.BeforeTest(t, integration.WithServerVersion("3.7.0"))
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "tenant-admin",
+			password: "admin-123",
+			role:     "tenant-admin",
+		},
+	}
+	authSetupUsers(t, integration.ToGRPC(clus.Client(0)).Auth, users)
+	_, err := integration.ToGRPC(clus.Client(0)).Auth.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{
+		Name: "tenant-admin",
+		Perm: &authpb.Permission{PermType: authpb.ADMIN, Key: []byte("tenant/"), RangeEnd: []byte("tenant0")},
+	})
+	require.NoError(t, err)
+	_, err = integration.ToGRPC(clus.Client(0)).Auth.UserAdd(context.TODO(), &pb.AuthUserAddRequest{Name: "other", Password: "other-123"})
+	require.NoError(t, err)
+	authSetupRoot(t, integration.ToGRPC(clus.Client(0)).Auth)
+
+	adminc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "tenant-admin", Password: "admin-123"})
+	require.NoError(t, cerr)
+	defer adminc.Close()
+
+	ctx := context.TODO()
+	_, err = adminc.UserAdd(ctx, "reader", "reader-123")
+	require.NoError(t, err)
+	_, err = adminc.RoleAdd(ctx, "reader")
+	require.NoError(t, err)
+	_, err = adminc.RoleGrantPermission(ctx, "reader", "tenant/", "tenant0", clientv3.PermissionType(clientv3.PermRead))
+	require.NoError(t, err)
+	_, err = adminc.UserGrantRole(ctx, "reader", "reader")
+	require.NoError(t, err)
+	_, err = adminc.Put(ctx, "tenant/k", "v")
+	require.NoError(t, err)
+
+	// permissions outside the range can't be granted.
+	_, err = adminc.RoleGrantPermission(ctx, "reader", "other", "", clientv3.PermissionType(clientv3.PermRead))
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	_, err = adminc.UserGrantRole(ctx, "reader", "root")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	_, err = adminc.AuthDisable(ctx)
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	// the users added by root are not managed until they have managed roles.
+	_, err = adminc.UserGrantRole(ctx, "other", "reader")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	_, err = adminc.UserChangePassword(ctx, "other", "taken-over")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+
+	readerc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "reader", Password: "reader-123"})
+	require.NoError(t, cerr)
+	defer readerc.Close()
+	resp, err := readerc.Get(ctx, "tenant/k")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	_, err = readerc.Put(ctx, "tenant/k", "v2")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+}
+
+// TestV3AuthDelegatedAdminClusterVersionTooLow ensures ADMIN permissions are
+// rejected until all members are v3.7, as older members don't know the
+// permission type.
+func TestV3AuthDelegatedAdminClusterVersionTooLow(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	authc := integration.ToGRPC(clus.Client(0)).Auth
+	_, err := authc.RoleAdd(context.TODO(), &pb.AuthRoleAddRequest{Name: "tenant-admin"})
+	require.NoError(t, err)
+	_, err = authc.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{
+		Name: "tenant-admin",
+		Perm: &authpb.Permission{PermType: authpb.ADMIN, Key: []byte("tenant/"), RangeEnd: []byte("tenant0")},
+	})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCClusterVersionTooLow)
+
+	resp, err := authc.RoleGet(context.TODO(), &pb.AuthRoleGetRequest{Role: "tenant-admin"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Perm)
+}
+
+// TestV3AuthOIDCToken ensures that tokens of an external issuer are verified
+// with its JWKS document and grant the roles of their claims.
+func TestV3AuthOIDCToken(t *testing.T) {
+	integration.BeforeTest(t)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"k1","x":%q}]}`,
+		base64.RawURLEncoding.EncodeToString(key.Public().(ed25519.PublicKey)))
+	issuer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(jwks))
+	}))
+	defer issuer.Close()
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:      1,
+		AuthToken: "oidc,jwks-url=" + issuer.URL + ",audience=etcd,roles-claim=groups",
+	})
+	defer clus.Terminate(t)
+
+	authc := integration.ToGRPC(clus.Client(0)).Auth
+	_, err = authc.RoleAdd(context.TODO(), &pb.AuthRoleAddRequest{Name: "readers"})
+	require.NoError(t, err)
+	_, err = authc.RoleGrantPermission(context.TODO(), &pb.AuthRoleGrantPermissionRequest{
+		Name: "readers",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("app/"), RangeEnd: []byte("app0")},
+	})
+	require.NoError(t, err)
+	authSetupRoot(t, authc)
+
+	rootc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	require.NoError(t, cerr)
+	defer rootc.Close()
+	_, err = rootc.Put(context.TODO(), "app/k", "v")
+	require.NoError(t, err)
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+		token.Header["kid"] = "k1"
+		signed, serr := token.SignedString(key)
+		require.NoError(t, serr)
+		return signed
+	}
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.TODO(), rpctypes.TokenFieldNameGRPC, token)
+	}
+
+	// the external user doesn't exist in etcd, and is authorized by the roles of its token.
+	kv := integration.ToGRPC(clus.Client(0)).KV
+	reader := withToken(sign(jwt.MapClaims{"sub": "alice", "aud": "etcd", "groups": []string{"readers"}, "exp": time.Now().Add(time.Hour).Unix()}))
+	resp, err := kv.Range(reader, &pb.RangeRequest{Key: []byte("app/k")})
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	// the roles of the token are only proposed once all members are v3.7.
+	_, err = kv.Put(reader, &pb.PutRequest{Key: []byte("app/k"), Value: []byte("v2")})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCClusterVersionTooLow)
+
+	noRoles := withToken(sign(jwt.MapClaims{"sub": "alice", "aud": "etcd", "exp": time.Now().Add(time.Hour).Unix()}))
+	_, err = kv.Range(noRoles, &pb.RangeRequest{Key: []byte("app/k")})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCPermissionDenied)
+
+	expired := withToken(sign(jwt.MapClaims{"sub": "alice", "aud": "etcd", "groups": []string{"readers"}, "exp": time.Now().Add(-time.Minute).Unix()}))
+	_, err = kv.Range(expired, &pb.RangeRequest{Key: []byte("app/k")})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCInvalidAuthToken)
+}
+
 func authSetupUsers(t *testing.T, auth pb.AuthClient, users []user) {
 	for _, user := range users {
 		_, err := auth.UserAdd(context.TODO(), &pb.AuthUserAddRequest{Name: user.name, Password: user.password, Options: &authpb.UserAddOptions{NoPassword: false}})
@@ -0,0 +1,56 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3GRPCRateLimit ensures the requests of a client exceeding its rate are
+// rejected, while the lease requests are admitted from a larger bucket.
+func TestV3GRPCRateLimit(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1, GRPCRateLimit: 0.01, GRPCRateLimitBurst: 10})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	cli := clus.Client(0)
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		_, err = cli.Put(ctx, "foo", "bar")
+	}
+	require.ErrorIs(t, err, rpctypes.ErrRateLimited)
+
+	_, err = cli.Get(ctx, "foo", clientv3.WithPrefix())
+	require.ErrorIs(t, err, rpctypes.ErrRateLimited)
+
+	lresp, err := cli.Grant(ctx, 10)
+	require.NoError(t, err)
+	_, err = cli.KeepAliveOnce(ctx, lresp.ID)
+	require.NoError(t, err)
+
+	for _, priority := range []string{"normal", "low"} {
+		v, err := clus.Members[0].Metric("etcd_server_client_requests_rate_limited_total", `priority="`+priority+`"`)
+		require.NoError(t, err)
+		require.Equal(t, "1", v)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3LeaseRead checks that linearizable reads are confirmed by the leader
+// lease, on the leader and forwarded by the followers, and that they observe
+// the writes of a new leader after a leadership transfer.
+func TestV3LeaseRead(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, LeaseRead: true})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	lead := clus.WaitLeader(t)
+	local := leaseReads(t, clus.Members[lead], "local")
+	forwarded := leaseReads(t, clus.Members[lead], "forwarded")
+
+	for i := 0; i < 3; i++ {
+		_, err := clus.Client(lead).Put(ctx, "foo", fmt.Sprint(i))
+		require.NoError(t, err)
+		for j := range clus.Members {
+			for k := 0; k < 10; k++ {
+				resp, err := clus.Client(j).Get(ctx, "foo")
+				require.NoError(t, err)
+				require.Len(t, resp.Kvs, 1)
+				require.Equal(t, fmt.Sprint(i), string(resp.Kvs[0].Value))
+			}
+		}
+	}
+	require.Greater(t, leaseReads(t, clus.Members[lead], "local"), local)
+	require.Greater(t, leaseReads(t, clus.Members[lead], "forwarded"), forwarded)
+
+	transferee := (lead + 1) % len(clus.Members)
+	_, err := clus.Client(lead).MoveLeader(ctx, uint64(clus.Members[transferee].ID()))
+	require.NoError(t, err)
+	_, err = clus.Client(transferee).Put(ctx, "foo", "moved")
+	require.NoError(t, err)
+	resp, err := clus.Client(lead).Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, "moved", string(resp.Kvs[0].Value))
+}
+
+func leaseReads(t *testing.T, m *integration.Member, result string) int {
+	v, err := m.Metric("etcd_server_lease_reads_total", fmt.Sprintf("result=%q", result))
+	require.NoError(t, err)
+	if v == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(v)
+	require.NoError(t, err)
+	return n
+}
@@ -1081,3 +1081,37 @@ func leaseExist(t *testing.T, clus *integration.Cluster, leaseID int64) bool {
 
 	return true
 }
+
+// TestV3PutTTLExpiresAfterLeaderChange ensures keys put with a ttl are expired
+// by a new leader, and after a restart of the member that put them.
+func TestV3PutTTLExpiresAfterLeaderChange(t *testing.T) {
+	integration.BeforeTest(t, integration.WithServerVersion("3.7.0"))
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leaderID := clus.WaitLeader(t)
+	leader := clus.Members[leaderID]
+	kvc := integration.ToGRPC(leader.Client).KV
+	_, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar"), TTL: 3})
+	require.NoError(t, err)
+
+	// Force a leader election
+	leader.Stop(t)
+	time.Sleep(time.Duration(3*integration.ElectionTicks) * framecfg.TickDuration)
+	leader.Restart(t)
+
+	newLeaderID := clus.WaitLeader(t)
+	kvc = integration.ToGRPC(clus.Client(newLeaderID)).KV
+	for i := 0; i < 100; i++ {
+		rresp, err := kvc.Range(ctx, &pb.RangeRequest{Key: []byte("foo")})
+		require.NoError(t, err)
+		if len(rresp.Kvs) == 0 {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("expected key to expire after leader change")
+}
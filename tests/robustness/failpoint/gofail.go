@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
 	"go.etcd.io/etcd/tests/v3/robustness/identity"
 	"go.etcd.io/etcd/tests/v3/robustness/report"
@@ -62,6 +63,8 @@ var (
 	RaftBeforeSaveSleep                       Failpoint = gofailSleepAndDeactivate{"raftBeforeSave", time.Second}
 	RaftAfterSaveSleep                        Failpoint = gofailSleepAndDeactivate{"raftAfterSave", time.Second}
 	SleepBeforeSendWatchResponse              Failpoint = gofailSleepAndDeactivate{"beforeSendWatchResponse", time.Second}
+	LeaseReadTickDelay                        Failpoint = leaseReadTickDelay{delay: 50 * time.Millisecond, duration: time.Second}
+	LeaseReadTickDelayBeyondDrift             Failpoint = leaseReadTickDelay{delay: 500 * time.Millisecond, duration: time.Second}
 )
 
 type goPanicFailpoint struct {
@@ -247,3 +250,46 @@ func (f gofailSleepAndDeactivate) Available(config e2e.EtcdProcessClusterConfig,
 	}
 	return memberFailpoints.Available(f.failpoint)
 }
+
+// leaseReadTickDelay delays the ticks of the leader, which counts the election
+// timeout of its leader lease in ticks, as if its clock ran slow. The delay is
+// either within the tick delay tolerated by the lease read index mode, or
+// beyond it, as the tolerated delay is configured by the operator and can be
+// exceeded.
+type leaseReadTickDelay struct {
+	delay    time.Duration
+	duration time.Duration
+}
+
+func (f leaseReadTickDelay) Inject(ctx context.Context, t *testing.T, lg *zap.Logger, clus *e2e.EtcdProcessCluster, baseTime time.Time, ids identity.Provider) ([]report.ClientReport, error) {
+	member := clus.Procs[clus.WaitLeader(t)]
+	lg.Info("Setting up gofailpoint", zap.String("failpoint", f.Name()))
+	err := member.Failpoints().SetupHTTP(ctx, "raftBeforeTick", fmt.Sprintf(`sleep(%q)`, f.delay))
+	if err != nil {
+		lg.Info("goFailpoint setup failed", zap.String("failpoint", f.Name()), zap.Error(err))
+		return nil, fmt.Errorf("goFailpoint %s setup failed, err:%w", f.Name(), err)
+	}
+	time.Sleep(f.duration)
+	lg.Info("Deactivating gofailpoint", zap.String("failpoint", f.Name()))
+	err = member.Failpoints().DeactivateHTTP(ctx, "raftBeforeTick")
+	if err != nil {
+		lg.Info("goFailpoint deactivate failed", zap.String("failpoint", f.Name()), zap.Error(err))
+		return nil, fmt.Errorf("goFailpoint %s deactivate failed, err: %w", f.Name(), err)
+	}
+	return nil, nil
+}
+
+func (f leaseReadTickDelay) Name() string {
+	return fmt.Sprintf("raftBeforeTick=sleep(%s)", f.delay)
+}
+
+func (f leaseReadTickDelay) Available(config e2e.EtcdProcessClusterConfig, member e2e.EtcdProcess, profile traffic.Profile) bool {
+	if config.ServerConfig.ReadIndexMode != embed.ReadIndexModeLease {
+		return false
+	}
+	memberFailpoints := member.Failpoints()
+	if memberFailpoints == nil {
+		return false
+	}
+	return memberFailpoints.Available("raftBeforeTick")
+}
@@ -23,6 +23,7 @@ import (
 
 	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
 	"go.etcd.io/etcd/tests/v3/robustness/client"
@@ -132,6 +133,26 @@ func Exploratory(_ *testing.T) []TestScenario {
 			Cluster: *e2e.NewConfig(clusterOfSize3Options...),
 		})
 	}
+	// The lease read index mode is not supported by the last release, so it
+	// is not run with mixed versions.
+	leaseReadOptions := baseOptions
+	leaseReadOptions = append(leaseReadOptions, e2e.WithIsPeerTLS(true), e2e.WithPeerProxy(true), e2e.WithReadIndexMode(embed.ReadIndexModeLease))
+	// the ticks of the leader are delayed within and beyond the tolerated delay.
+	for _, fp := range []struct {
+		name      string
+		failpoint failpoint.Failpoint
+	}{
+		{"LeaseReadTickDelay", failpoint.LeaseReadTickDelay},
+		{"LeaseReadTickDelayBeyondDrift", failpoint.LeaseReadTickDelayBeyondDrift},
+	} {
+		scenarios = append(scenarios, TestScenario{
+			Name:      filepath.Join("KubernetesHighTraffic", "ClusterOfSize3", fp.name),
+			Failpoint: fp.failpoint,
+			Traffic:   traffic.Kubernetes,
+			Profile:   traffic.HighTrafficProfile,
+			Cluster:   *e2e.NewConfig(leaseReadOptions...),
+		})
+	}
 	if e2e.BinPath.LazyFSAvailable() {
 		newScenarios := scenarios
 		for _, s := range scenarios {